@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/agent"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	log.SetFormatter(&log.TextFormatter{
+		FullTimestamp:   true,
+		TimestampFormat: time.StampMilli,
+	})
+
+	token := os.Getenv("SUPERPLANE_AGENT_TOKEN")
+	if token == "" {
+		log.Fatal("SUPERPLANE_AGENT_TOKEN must be set")
+	}
+
+	address := os.Getenv("SUPERPLANE_GRPC_ADDR")
+	if address == "" {
+		address = "localhost:50051"
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if os.Getenv("SUPERPLANE_GRPC_TLS") == "yes" {
+		transportCredentials = credentials.NewTLS(&tls.Config{})
+	}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		log.Fatalf("failed to create client: %v", err)
+	}
+
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Infof("Superplane agent pulling jobs from %s", address)
+
+	err = agent.NewAgent(pb.NewAgentsClient(conn), token).Run(ctx)
+	if err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+}
@@ -1,24 +1,33 @@
 package main
 
 import (
+	"context"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/bus"
 	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/executors"
 	grpc "github.com/superplanehq/superplane/pkg/grpc"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/public"
 	"github.com/superplanehq/superplane/pkg/workers"
 )
 
-func startWorkers(jwtSigner *jwt.Signer, encryptor crypto.Encryptor) {
+// Kubernetes waits 30s by default before killing the pod,
+// so we give up waiting for workers a bit before that.
+const shutdownTimeout = 25 * time.Second
+
+func startWorkers(manager *lifecycle.Manager, jwtSigner *jwt.Signer, encryptor crypto.Encryptor) {
 	log.Println("Starting Workers")
 
-	rabbitMQURL, err := config.RabbitMQURL()
+	messageBus, err := bus.Get()
 	if err != nil {
 		panic(err)
 	}
@@ -26,17 +35,17 @@ func startWorkers(jwtSigner *jwt.Signer, encryptor crypto.Encryptor) {
 	if os.Getenv("START_PENDING_EVENTS_WORKER") == "yes" {
 		log.Println("Starting Pending Events Worker")
 		w := workers.PendingEventsWorker{}
-		go w.Start()
+		manager.Go("pending-events-worker", w.Start)
 	}
 
 	if os.Getenv("START_PENDING_STAGE_EVENTS_WORKER") == "yes" {
 		log.Println("Starting Pending Stage Events Worker")
-		w, err := workers.NewPendingStageEventsWorker(time.Now)
+		w, err := workers.NewPendingStageEventsWorker(time.Now, encryptor)
 		if err != nil {
 			panic(err)
 		}
 
-		go w.Start()
+		manager.Go("pending-stage-events-worker", w.Start)
 	}
 
 	if os.Getenv("START_TIME_WINDOW_WORKER") == "yes" {
@@ -46,24 +55,44 @@ func startWorkers(jwtSigner *jwt.Signer, encryptor crypto.Encryptor) {
 			panic(err)
 		}
 
-		go w.Start()
+		manager.Go("time-window-worker", w.Start)
+	}
+
+	if os.Getenv("START_SCHEDULE_WORKER") == "yes" {
+		log.Println("Starting Schedule Worker")
+		w, err := workers.NewScheduleWorker(time.Now)
+		if err != nil {
+			panic(err)
+		}
+
+		manager.Go("schedule-worker", w.Start)
 	}
 
 	if os.Getenv("START_STAGE_EVENT_APPROVED_CONSUMER") == "yes" {
 		log.Println("Starting Stage Event Approved Consumer")
-		w := workers.NewStageEventApprovedConsumer(rabbitMQURL)
-		go w.Start()
+		w := workers.NewStageEventApprovedConsumer(messageBus)
+		manager.Go("stage-event-approved-consumer", w.Start)
 	}
 
 	if os.Getenv("START_EXECUTIONS_POLLER") == "yes" {
 		log.Println("Starting Executions Poller")
 
 		w := workers.NewExecutionPoller(encryptor)
-		go w.Start()
+		manager.Go("executions-poller", w.Start)
+	}
+
+	if os.Getenv("START_EXECUTION_REAPER") == "yes" {
+		log.Println("Starting Execution Reaper")
+		w, err := workers.NewExecutionReaper(time.Now, encryptor)
+		if err != nil {
+			panic(err)
+		}
+
+		manager.Go("execution-reaper", w.Start)
 	}
 
 	if os.Getenv("START_PENDING_EXECUTIONS_WORKER") == "yes" {
-		log.Println("Starting Pending Stage Events Worker")
+		log.Println("Starting Pending Executions Worker")
 
 		w := workers.PendingExecutionsWorker{
 			JwtSigner:   jwtSigner,
@@ -71,16 +100,30 @@ func startWorkers(jwtSigner *jwt.Signer, encryptor crypto.Encryptor) {
 			SpecBuilder: executors.SpecBuilder{},
 		}
 
-		go w.Start()
+		manager.Go("pending-executions-worker", w.Start)
+	}
+
+	if os.Getenv("START_OUTBOX_RELAY") == "yes" {
+		log.Println("Starting Outbox Relay")
+		w, err := workers.NewOutboxRelay(time.Now)
+		if err != nil {
+			panic(err)
+		}
+
+		manager.Go("outbox-relay", w.Start)
 	}
 }
 
-func startInternalAPI(encryptor crypto.Encryptor, authService authorization.Authorization) {
+func startInternalAPI(manager *lifecycle.Manager, encryptor crypto.Encryptor, authService authorization.Authorization) {
 	log.Println("Starting Internal API")
-	grpc.RunServer(encryptor, authService, 50051)
+	server := grpc.NewServer(encryptor, authService, 50051)
+	manager.AddServer(server)
+	manager.Go("internal-api", func(ctx context.Context) error {
+		return server.Serve()
+	})
 }
 
-func startPublicAPI(encryptor crypto.Encryptor, jwtSigner *jwt.Signer) {
+func startPublicAPI(manager *lifecycle.Manager, encryptor crypto.Encryptor, jwtSigner *jwt.Signer) {
 	log.Println("Starting Public API with integrated Web Server")
 
 	basePath := os.Getenv("PUBLIC_API_BASE_PATH")
@@ -95,11 +138,18 @@ func startPublicAPI(encryptor crypto.Encryptor, jwtSigner *jwt.Signer) {
 		log.Panicf("Error creating public API server: %v", err)
 	}
 
+	server.SetHealthChecker(manager)
+
 	// Start the EventDistributer worker if enabled
 	if os.Getenv("START_EVENT_DISTRIBUTER") == "yes" {
 		log.Println("Starting Event Distributer Worker")
-		eventDistributer := workers.NewEventDistributer(server.WebsocketHub())
-		go eventDistributer.Start()
+		messageBus, err := bus.Get()
+		if err != nil {
+			panic(err)
+		}
+
+		eventDistributer := workers.NewEventDistributer(messageBus, server.WebsocketHub())
+		manager.Go("event-distributer", eventDistributer.Start)
 	} else {
 		log.Println("Event Distributer not started (START_EVENT_DISTRIBUTER != yes)")
 	}
@@ -133,10 +183,10 @@ func startPublicAPI(encryptor crypto.Encryptor, jwtSigner *jwt.Signer) {
 		server.RegisterOpenAPIHandler()
 	}
 
-	err = server.Serve("0.0.0.0", 8000)
-	if err != nil {
-		log.Fatal(err)
-	}
+	manager.AddServer(server)
+	manager.Go("public-api", func(ctx context.Context) error {
+		return server.Serve("0.0.0.0", 8000)
+	})
 }
 
 func main() {
@@ -172,17 +222,51 @@ func main() {
 
 	jwtSigner := jwt.NewSigner(jwtSecret)
 
+	executorPlugins, err := config.ExecutorPlugins()
+	if err != nil {
+		log.Fatalf("failed to read executor plugins: %v", err)
+	}
+
+	err = executors.LoadPlugins(executorPlugins)
+	if err != nil {
+		log.Fatalf("failed to load executor plugins: %v", err)
+	}
+
+	manager := lifecycle.NewManager(time.Now)
+	manager.DrainDelay, err = config.ShutdownDrainDelay(lifecycle.DefaultDrainDelay)
+	if err != nil {
+		log.Fatalf("failed to read shutdown drain delay: %v", err)
+	}
+
 	if os.Getenv("START_PUBLIC_API") == "yes" {
-		go startPublicAPI(encryptorInstance, jwtSigner)
+		startPublicAPI(manager, encryptorInstance, jwtSigner)
 	}
 
 	if os.Getenv("START_INTERNAL_API") == "yes" {
-		go startInternalAPI(encryptorInstance, authService)
+		startInternalAPI(manager, encryptorInstance, authService)
 	}
 
-	startWorkers(jwtSigner, encryptorInstance)
+	startWorkers(manager, jwtSigner, encryptorInstance)
 
 	log.Println("Superplane is UP.")
 
-	select {}
+	//
+	// On SIGTERM, we stop being ready, servers stop accepting requests after the drain delay,
+	// and workers finish what they are doing before we exit.
+	//
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	<-ctx.Done()
+
+	log.Println("Shutting down Superplane")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := manager.Shutdown(shutdownCtx); err != nil {
+		log.Errorf("Error shutting down: %v", err)
+		return
+	}
+
+	log.Println("Superplane is DOWN.")
 }
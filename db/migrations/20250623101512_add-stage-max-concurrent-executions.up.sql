@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE stages ADD COLUMN max_concurrent_executions integer NOT NULL DEFAULT 1;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE stages ADD COLUMN queue_policy character varying(64) NOT NULL DEFAULT 'fifo';
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE stages ADD COLUMN execution_timeout integer NOT NULL DEFAULT 0;
+ALTER TABLE stage_executions ADD COLUMN result_reason character varying(64) NOT NULL DEFAULT '';
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+ALTER TABLE stages ADD COLUMN retry_policy jsonb NOT NULL DEFAULT '{}';
+
+ALTER TABLE stage_executions ADD COLUMN attempt integer NOT NULL DEFAULT 1;
+ALTER TABLE stage_executions ADD COLUMN previous_execution_id uuid;
+ALTER TABLE stage_executions ADD COLUMN scheduled_at TIMESTAMP;
+ALTER TABLE stage_executions ADD FOREIGN KEY (previous_execution_id) REFERENCES stage_executions(id);
+
+commit;
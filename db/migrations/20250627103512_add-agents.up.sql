@@ -0,0 +1,39 @@
+begin;
+
+CREATE TABLE agents (
+  id           uuid NOT NULL DEFAULT uuid_generate_v4(),
+  canvas_id    uuid NOT NULL,
+  name         CHARACTER VARYING(128) NOT NULL,
+  token_hash   CHARACTER VARYING(64) NOT NULL,
+  created_at   TIMESTAMP NOT NULL,
+  created_by   uuid NOT NULL,
+  last_seen_at TIMESTAMP,
+
+  PRIMARY KEY (id),
+  UNIQUE (canvas_id, name),
+  UNIQUE (token_hash),
+  FOREIGN KEY (canvas_id) REFERENCES canvases(id)
+);
+
+CREATE TABLE agent_jobs (
+  id           uuid NOT NULL DEFAULT uuid_generate_v4(),
+  canvas_id    uuid NOT NULL,
+  execution_id uuid NOT NULL,
+  agent_name   CHARACTER VARYING(128) NOT NULL,
+  agent_id     uuid,
+  state        CHARACTER VARYING(64) NOT NULL,
+  spec         bytea NOT NULL,
+  exit_code    integer,
+  created_at   TIMESTAMP NOT NULL,
+  started_at   TIMESTAMP,
+  finished_at  TIMESTAMP,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (canvas_id) REFERENCES canvases(id),
+  FOREIGN KEY (execution_id) REFERENCES stage_executions(id),
+  FOREIGN KEY (agent_id) REFERENCES agents(id)
+);
+
+CREATE INDEX uix_agent_jobs_agent ON agent_jobs USING btree (canvas_id, agent_name, state);
+
+commit;
@@ -0,0 +1,16 @@
+begin;
+
+CREATE TABLE execution_logs (
+  id           bigserial,
+  execution_id uuid NOT NULL,
+  stream       CHARACTER VARYING(16) NOT NULL,
+  content      text NOT NULL,
+  created_at   TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (execution_id) REFERENCES stage_executions(id)
+);
+
+CREATE INDEX uix_execution_logs_execution ON execution_logs USING btree (execution_id, id);
+
+commit;
@@ -0,0 +1,19 @@
+begin;
+
+CREATE TABLE outbox_messages (
+  id              uuid NOT NULL DEFAULT uuid_generate_v4(),
+  exchange        CHARACTER VARYING(128) NOT NULL,
+  routing_key     CHARACTER VARYING(128) NOT NULL,
+  body            bytea NOT NULL,
+  attempts        integer NOT NULL DEFAULT 0,
+  last_error      text NOT NULL DEFAULT '',
+  created_at      TIMESTAMP NOT NULL,
+  next_attempt_at TIMESTAMP NOT NULL,
+  sent_at         TIMESTAMP,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX uix_outbox_messages_unsent ON outbox_messages USING btree (next_attempt_at) WHERE (sent_at IS NULL);
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE event_sources ADD COLUMN spec jsonb NOT NULL DEFAULT '{}';
+
+commit;
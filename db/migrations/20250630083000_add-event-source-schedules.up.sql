@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE event_sources ADD COLUMN last_fired_at TIMESTAMP;
+ALTER TABLE event_sources ADD COLUMN next_fire_at TIMESTAMP;
+
+CREATE INDEX uix_event_sources_next_fire_at ON event_sources USING btree (next_fire_at) WHERE next_fire_at IS NOT NULL;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE events ADD COLUMN triggered_by uuid;
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE events ADD COLUMN replay_of uuid;
+ALTER TABLE events ADD COLUMN replay_stage_id uuid;
+ALTER TABLE events ADD COLUMN replay_with_current_spec boolean NOT NULL DEFAULT false;
+ALTER TABLE events ADD FOREIGN KEY (replay_of) REFERENCES events(id) ON DELETE SET NULL;
+
+commit;
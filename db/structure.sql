@@ -56,6 +56,40 @@ CREATE TABLE public.account_providers (
 );
 
 
+--
+-- Name: agent_jobs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.agent_jobs (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    canvas_id uuid NOT NULL,
+    execution_id uuid NOT NULL,
+    agent_name character varying(128) NOT NULL,
+    agent_id uuid,
+    state character varying(64) NOT NULL,
+    spec bytea NOT NULL,
+    exit_code integer,
+    created_at timestamp without time zone NOT NULL,
+    started_at timestamp without time zone,
+    finished_at timestamp without time zone
+);
+
+
+--
+-- Name: agents; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.agents (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    canvas_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    token_hash character varying(64) NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    created_by uuid NOT NULL,
+    last_seen_at timestamp without time zone
+);
+
+
 --
 -- Name: canvases; Type: TABLE; Schema: public; Owner: -
 --
@@ -116,7 +150,10 @@ CREATE TABLE public.event_sources (
     name character varying(128) NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    key bytea NOT NULL
+    key bytea NOT NULL,
+    spec jsonb DEFAULT '{}'::jsonb NOT NULL,
+    last_fired_at timestamp without time zone,
+    next_fire_at timestamp without time zone
 );
 
 
@@ -132,10 +169,46 @@ CREATE TABLE public.events (
     received_at timestamp without time zone NOT NULL,
     raw jsonb NOT NULL,
     state character varying(64) NOT NULL,
-    headers jsonb DEFAULT '{}'::jsonb NOT NULL
+    headers jsonb DEFAULT '{}'::jsonb NOT NULL,
+    triggered_by uuid,
+    replay_of uuid,
+    replay_stage_id uuid,
+    replay_with_current_spec boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.execution_logs (
+    id bigint NOT NULL,
+    execution_id uuid NOT NULL,
+    stream character varying(16) NOT NULL,
+    content text NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
+--
+-- Name: execution_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.execution_logs_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: execution_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.execution_logs_id_seq OWNED BY public.execution_logs.id;
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -151,6 +224,23 @@ CREATE TABLE public.organizations (
 );
 
 
+--
+-- Name: outbox_messages; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.outbox_messages (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    exchange character varying(128) NOT NULL,
+    routing_key character varying(128) NOT NULL,
+    body bytea NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    last_error text DEFAULT ''::text NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    next_attempt_at timestamp without time zone NOT NULL,
+    sent_at timestamp without time zone
+);
+
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -237,7 +327,11 @@ CREATE TABLE public.stage_executions (
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
     started_at timestamp without time zone,
-    finished_at timestamp without time zone
+    finished_at timestamp without time zone,
+    result_reason character varying(64) DEFAULT ''::character varying NOT NULL,
+    attempt integer DEFAULT 1 NOT NULL,
+    previous_execution_id uuid,
+    scheduled_at timestamp without time zone
 );
 
 
@@ -258,7 +352,11 @@ CREATE TABLE public.stages (
     inputs jsonb DEFAULT '[]'::jsonb NOT NULL,
     outputs jsonb DEFAULT '[]'::jsonb NOT NULL,
     input_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
-    secrets jsonb DEFAULT '[]'::jsonb NOT NULL
+    secrets jsonb DEFAULT '[]'::jsonb NOT NULL,
+    max_concurrent_executions integer DEFAULT 1 NOT NULL,
+    queue_policy character varying(64) DEFAULT 'fifo'::character varying NOT NULL,
+    execution_timeout integer DEFAULT 0 NOT NULL,
+    retry_policy jsonb DEFAULT '{}'::jsonb NOT NULL
 );
 
 
@@ -281,6 +379,13 @@ CREATE TABLE public.users (
 ALTER TABLE ONLY public.casbin_rule ALTER COLUMN id SET DEFAULT nextval('public.casbin_rule_id_seq'::regclass);
 
 
+--
+-- Name: execution_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.execution_logs ALTER COLUMN id SET DEFAULT nextval('public.execution_logs_id_seq'::regclass);
+
+
 --
 -- Name: account_providers account_providers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -305,6 +410,38 @@ ALTER TABLE ONLY public.account_providers
     ADD CONSTRAINT account_providers_user_id_provider_key UNIQUE (user_id, provider);
 
 
+--
+-- Name: agent_jobs agent_jobs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agent_jobs
+    ADD CONSTRAINT agent_jobs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: agents agents_canvas_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agents
+    ADD CONSTRAINT agents_canvas_id_name_key UNIQUE (canvas_id, name);
+
+
+--
+-- Name: agents agents_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agents
+    ADD CONSTRAINT agents_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: agents agents_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agents
+    ADD CONSTRAINT agents_token_hash_key UNIQUE (token_hash);
+
+
 --
 -- Name: canvases canvases_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -353,6 +490,14 @@ ALTER TABLE ONLY public.events
     ADD CONSTRAINT events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: execution_logs execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.execution_logs
+    ADD CONSTRAINT execution_logs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -369,6 +514,14 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: outbox_messages outbox_messages_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.outbox_messages
+    ADD CONSTRAINT outbox_messages_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -514,6 +667,13 @@ CREATE INDEX idx_casbin_rule_v2 ON public.casbin_rule USING btree (v2);
 CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (deleted_at);
 
 
+--
+-- Name: uix_agent_jobs_agent; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX uix_agent_jobs_agent ON public.agent_jobs USING btree (canvas_id, agent_name, state);
+
+
 --
 -- Name: uix_event_sources_canvas; Type: INDEX; Schema: public; Owner: -
 --
@@ -521,6 +681,13 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX uix_event_sources_canvas ON public.event_sources USING btree (canvas_id);
 
 
+--
+-- Name: uix_event_sources_next_fire_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX uix_event_sources_next_fire_at ON public.event_sources USING btree (next_fire_at) WHERE (next_fire_at IS NOT NULL);
+
+
 --
 -- Name: uix_events_source; Type: INDEX; Schema: public; Owner: -
 --
@@ -528,6 +695,20 @@ CREATE INDEX uix_event_sources_canvas ON public.event_sources USING btree (canva
 CREATE INDEX uix_events_source ON public.events USING btree (source_id);
 
 
+--
+-- Name: uix_execution_logs_execution; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX uix_execution_logs_execution ON public.execution_logs USING btree (execution_id, id);
+
+
+--
+-- Name: uix_outbox_messages_unsent; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX uix_outbox_messages_unsent ON public.outbox_messages USING btree (next_attempt_at) WHERE (sent_at IS NULL);
+
+
 --
 -- Name: uix_stage_connections_stage; Type: INDEX; Schema: public; Owner: -
 --
@@ -585,6 +766,38 @@ ALTER TABLE ONLY public.account_providers
     ADD CONSTRAINT account_providers_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
 
 
+--
+-- Name: agent_jobs agent_jobs_agent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agent_jobs
+    ADD CONSTRAINT agent_jobs_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES public.agents(id);
+
+
+--
+-- Name: agent_jobs agent_jobs_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agent_jobs
+    ADD CONSTRAINT agent_jobs_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.canvases(id);
+
+
+--
+-- Name: agent_jobs agent_jobs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agent_jobs
+    ADD CONSTRAINT agent_jobs_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.stage_executions(id);
+
+
+--
+-- Name: agents agents_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agents
+    ADD CONSTRAINT agents_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.canvases(id);
+
+
 --
 -- Name: canvases canvases_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -601,6 +814,22 @@ ALTER TABLE ONLY public.event_sources
     ADD CONSTRAINT event_sources_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.canvases(id);
 
 
+--
+-- Name: events events_replay_of_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.events
+    ADD CONSTRAINT events_replay_of_fkey FOREIGN KEY (replay_of) REFERENCES public.events(id) ON DELETE SET NULL;
+
+
+--
+-- Name: execution_logs execution_logs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.execution_logs
+    ADD CONSTRAINT execution_logs_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.stage_executions(id);
+
+
 --
 -- Name: secrets secrets_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -633,6 +862,14 @@ ALTER TABLE ONLY public.stage_events
     ADD CONSTRAINT stage_events_stage_id_fkey FOREIGN KEY (stage_id) REFERENCES public.stages(id);
 
 
+--
+-- Name: stage_executions stage_executions_previous_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.stage_executions
+    ADD CONSTRAINT stage_executions_previous_execution_id_fkey FOREIGN KEY (previous_execution_id) REFERENCES public.stage_executions(id);
+
+
 --
 -- Name: stage_executions stage_executions_stage_event_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -685,7 +922,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20250618171050	f
+20250702101500	f
 \.
 
 
@@ -0,0 +1,222 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultLogInterval  = time.Second
+
+	// Exit code reported when the command can't even be started.
+	StartFailureExitCode = -1
+)
+
+// Agent pulls jobs from Superplane and runs them as local processes.
+// It only makes outbound requests, so it can run behind a firewall.
+type Agent struct {
+	Client       pb.AgentsClient
+	Token        string
+	PollInterval time.Duration
+	LogInterval  time.Duration
+}
+
+func NewAgent(client pb.AgentsClient, token string) *Agent {
+	return &Agent{
+		Client:       client,
+		Token:        token,
+		PollInterval: DefaultPollInterval,
+		LogInterval:  DefaultLogInterval,
+	}
+}
+
+func (a *Agent) Run(ctx context.Context) error {
+	for {
+		res, err := a.Client.PullJob(a.withToken(ctx), &pb.PullJobRequest{})
+		if err != nil {
+			log.Errorf("Error pulling job: %v", err)
+		}
+
+		if err == nil && res.Job != nil {
+			err = a.RunJob(ctx, res.Job)
+			if err != nil {
+				log.Errorf("Error running job %s: %v", res.Job.Id, err)
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(a.PollInterval):
+		}
+	}
+}
+
+// RunJob runs the job command, pushing its output while it runs.
+// If Superplane reports the job as cancelled, the process is killed
+// and no exit code is reported.
+func (a *Agent) RunJob(ctx context.Context, job *pb.Job) error {
+	log.Infof("Running job %s for execution %s", job.Id, job.ExecutionId)
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logs := &logBuffer{}
+	cmd := exec.CommandContext(jobCtx, job.Command, job.Args...)
+	cmd.Dir = job.WorkingDirectory
+	cmd.WaitDelay = 5 * time.Second
+	cmd.Stdout = logs.writer(pb.LogChunk_STREAM_STDOUT)
+	cmd.Stderr = logs.writer(pb.LogChunk_STREAM_STDERR)
+	cmd.Env = os.Environ()
+	for key, value := range job.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	exitCode, cancelled := a.runAndStream(jobCtx, cancel, cmd, job, logs)
+
+	//
+	// Whatever is left in the buffer is pushed before finishing,
+	// so the logs are complete when the execution finishes.
+	//
+	if a.flush(ctx, job, logs) {
+		cancelled = true
+	}
+
+	if cancelled {
+		log.Infof("Job %s was cancelled", job.Id)
+		return nil
+	}
+
+	_, err := a.Client.FinishJob(a.withToken(ctx), &pb.FinishJobRequest{
+		JobId:    job.Id,
+		ExitCode: int32(exitCode),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error finishing job: %v", err)
+	}
+
+	log.Infof("Job %s finished with exit code %d", job.Id, exitCode)
+	return nil
+}
+
+func (a *Agent) runAndStream(ctx context.Context, cancel context.CancelFunc, cmd *exec.Cmd, job *pb.Job, logs *logBuffer) (int, bool) {
+	err := cmd.Start()
+	if err != nil {
+		logs.append(pb.LogChunk_STREAM_STDERR, fmt.Sprintf("error starting command: %v\n", err))
+		return StartFailureExitCode, false
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	cancelled := false
+	ticker := time.NewTicker(a.LogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			return exitCodeFrom(err), cancelled
+
+		case <-ticker.C:
+			if a.flush(ctx, job, logs) && !cancelled {
+				cancelled = true
+				cancel()
+			}
+		}
+	}
+}
+
+// flush pushes the buffered logs and returns true if the job was cancelled.
+func (a *Agent) flush(ctx context.Context, job *pb.Job, logs *logBuffer) bool {
+	chunks := logs.drain()
+	res, err := a.Client.PushLogs(a.withToken(ctx), &pb.PushLogsRequest{
+		JobId:  job.Id,
+		Chunks: chunks,
+	})
+
+	if err != nil {
+		log.Errorf("Error pushing logs for job %s: %v", job.Id, err)
+		logs.prepend(chunks)
+		return false
+	}
+
+	return res.Cancelled
+}
+
+func (a *Agent) withToken(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+a.Token)
+}
+
+func exitCodeFrom(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return StartFailureExitCode
+}
+
+type logBuffer struct {
+	chunks []*pb.LogChunk
+	mu     sync.Mutex
+}
+
+func (b *logBuffer) writer(stream pb.LogChunk_Stream) *streamWriter {
+	return &streamWriter{buffer: b, stream: stream}
+}
+
+// Consecutive writes to the same stream are merged into one chunk.
+func (b *logBuffer) append(stream pb.LogChunk_Stream, content string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if n := len(b.chunks); n > 0 && b.chunks[n-1].Stream == stream {
+		b.chunks[n-1].Content += content
+		return
+	}
+
+	b.chunks = append(b.chunks, &pb.LogChunk{Stream: stream, Content: content})
+}
+
+func (b *logBuffer) prepend(chunks []*pb.LogChunk) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.chunks = append(chunks, b.chunks...)
+}
+
+func (b *logBuffer) drain() []*pb.LogChunk {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	chunks := b.chunks
+	b.chunks = nil
+	return chunks
+}
+
+type streamWriter struct {
+	buffer *logBuffer
+	stream pb.LogChunk_Stream
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.buffer.append(w.stream, string(p))
+	return len(p), nil
+}
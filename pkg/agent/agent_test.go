@@ -0,0 +1,156 @@
+package agent
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeClient struct {
+	Jobs      []*pb.Job
+	Logs      map[pb.LogChunk_Stream]string
+	ExitCodes map[string]int32
+	Cancelled bool
+	Tokens    []string
+
+	mu sync.Mutex
+}
+
+func newFakeClient(jobs ...*pb.Job) *fakeClient {
+	return &fakeClient{
+		Jobs:      jobs,
+		Logs:      map[pb.LogChunk_Stream]string{},
+		ExitCodes: map[string]int32{},
+	}
+}
+
+func (c *fakeClient) recordToken(ctx context.Context) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.Tokens = append(c.Tokens, md.Get("authorization")...)
+}
+
+func (c *fakeClient) PullJob(ctx context.Context, in *pb.PullJobRequest, opts ...grpc.CallOption) (*pb.PullJobResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordToken(ctx)
+
+	if len(c.Jobs) == 0 {
+		return &pb.PullJobResponse{}, nil
+	}
+
+	job := c.Jobs[0]
+	c.Jobs = c.Jobs[1:]
+	return &pb.PullJobResponse{Job: job}, nil
+}
+
+func (c *fakeClient) PushLogs(ctx context.Context, in *pb.PushLogsRequest, opts ...grpc.CallOption) (*pb.PushLogsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordToken(ctx)
+
+	for _, chunk := range in.Chunks {
+		c.Logs[chunk.Stream] += chunk.Content
+	}
+
+	return &pb.PushLogsResponse{Cancelled: c.Cancelled}, nil
+}
+
+func (c *fakeClient) FinishJob(ctx context.Context, in *pb.FinishJobRequest, opts ...grpc.CallOption) (*pb.FinishJobResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordToken(ctx)
+
+	c.ExitCodes[in.JobId] = in.ExitCode
+	return &pb.FinishJobResponse{}, nil
+}
+
+func (c *fakeClient) cancel() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Cancelled = true
+}
+
+func Test__Agent(t *testing.T) {
+	t.Run("job output and exit code are reported", func(t *testing.T) {
+		client := newFakeClient()
+		agent := NewAgent(client, "token")
+		agent.LogInterval = 10 * time.Millisecond
+
+		err := agent.RunJob(context.Background(), &pb.Job{
+			Id:      "job-1",
+			Command: "sh",
+			Args:    []string{"-c", "echo $VERSION; echo oops 1>&2; exit 3"},
+			Env:     map[string]string{"VERSION": "v1"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "v1\n", client.Logs[pb.LogChunk_STREAM_STDOUT])
+		assert.Equal(t, "oops\n", client.Logs[pb.LogChunk_STREAM_STDERR])
+		assert.Equal(t, int32(3), client.ExitCodes["job-1"])
+		assert.Contains(t, client.Tokens, "Bearer token")
+	})
+
+	t.Run("command that does not exist -> failure is reported", func(t *testing.T) {
+		client := newFakeClient()
+		agent := NewAgent(client, "token")
+
+		err := agent.RunJob(context.Background(), &pb.Job{
+			Id:      "job-2",
+			Command: "this-command-does-not-exist",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(StartFailureExitCode), client.ExitCodes["job-2"])
+		assert.Contains(t, client.Logs[pb.LogChunk_STREAM_STDERR], "error starting command")
+	})
+
+	t.Run("cancelled job -> process is killed and no exit code is reported", func(t *testing.T) {
+		client := newFakeClient()
+		agent := NewAgent(client, "token")
+		agent.LogInterval = 10 * time.Millisecond
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			client.cancel()
+		}()
+
+		start := time.Now()
+		err := agent.RunJob(context.Background(), &pb.Job{
+			Id:      "job-3",
+			Command: "sleep",
+			Args:    []string{"30"},
+		})
+
+		require.NoError(t, err)
+		assert.Less(t, time.Since(start), 10*time.Second)
+		assert.NotContains(t, client.ExitCodes, "job-3")
+	})
+
+	t.Run("agent pulls and runs jobs until stopped", func(t *testing.T) {
+		client := newFakeClient(
+			&pb.Job{Id: "job-4", Command: "true"},
+			&pb.Job{Id: "job-5", Command: "false"},
+		)
+
+		agent := NewAgent(client, "token")
+		agent.PollInterval = 10 * time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		err := agent.Run(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, map[string]int32{"job-4": 0, "job-5": 1}, client.ExitCodes)
+		for _, token := range client.Tokens {
+			assert.True(t, strings.HasPrefix(token, "Bearer "))
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const DefaultURL = "https://api.github.com"
+
+type GitHub struct {
+	URL   string
+	Token string
+}
+
+func NewGitHubAPI(URL, token string) *GitHub {
+	if URL == "" {
+		URL = DefaultURL
+	}
+
+	return &GitHub{
+		URL:   URL,
+		Token: token,
+	}
+}
+
+type WorkflowDispatch struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+const (
+	WorkflowRunStatusCompleted    = "completed"
+	WorkflowRunConclusionSuccess  = "success"
+	WorkflowRunConclusionFailure  = "failure"
+	WorkflowRunConclusionCanceled = "cancelled"
+)
+
+type WorkflowRun struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	Event        string    `json:"event"`
+	HeadBranch   string    `json:"head_branch"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HTMLURL      string    `json:"html_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type WorkflowRunsResponse struct {
+	TotalCount   int           `json:"total_count"`
+	WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+}
+
+// Workflow runs are listed newest first, in pages of this size.
+const WorkflowRunsPerPage = 50
+
+type ListWorkflowRunsOptions struct {
+	Event        string
+	CreatedAfter time.Time
+	Page         int
+}
+
+func (g *GitHub) DispatchWorkflow(owner, repository, workflow string, dispatch WorkflowDispatch) error {
+	URL := fmt.Sprintf("%s/repos/%s/%s/actions/workflows/%s/dispatches", g.URL, owner, repository, url.PathEscape(workflow))
+	body, err := json.Marshal(&dispatch)
+	if err != nil {
+		return fmt.Errorf("error marshaling workflow dispatch: %v", err)
+	}
+
+	res, err := g.do(http.MethodPost, URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		responseBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("request got %d code: %s", res.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+func (g *GitHub) ListWorkflowRuns(owner, repository, workflow string, options ListWorkflowRunsOptions) ([]WorkflowRun, error) {
+	query := url.Values{}
+	query.Set("per_page", strconv.Itoa(WorkflowRunsPerPage))
+	if options.Event != "" {
+		query.Set("event", options.Event)
+	}
+
+	if !options.CreatedAfter.IsZero() {
+		query.Set("created", ">="+options.CreatedAfter.UTC().Format(time.RFC3339))
+	}
+
+	if options.Page > 0 {
+		query.Set("page", strconv.Itoa(options.Page))
+	}
+
+	URL := fmt.Sprintf("%s/repos/%s/%s/actions/workflows/%s/runs?%s", g.URL, owner, repository, url.PathEscape(workflow), query.Encode())
+	res, err := g.do(http.MethodGet, URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request got %d code", res.StatusCode)
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading body: %v", err)
+	}
+
+	var response WorkflowRunsResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+
+	return response.WorkflowRuns, nil
+}
+
+func (g *GitHub) CancelWorkflowRun(owner, repository string, runID int64) error {
+	URL := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/cancel", g.URL, owner, repository, runID)
+	res, err := g.do(http.MethodPost, URL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("request got %d code", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (g *GitHub) do(method, URL string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.Token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %v", err)
+	}
+
+	return res, nil
+}
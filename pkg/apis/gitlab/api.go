@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const DefaultURL = "https://gitlab.com"
+
+type GitLab struct {
+	URL   string
+	Token string
+}
+
+func NewGitLabAPI(URL, token string) *GitLab {
+	if URL == "" {
+		URL = DefaultURL
+	}
+
+	return &GitLab{
+		URL:   URL,
+		Token: token,
+	}
+}
+
+type CreatePipelineRequest struct {
+	Ref       string             `json:"ref"`
+	Variables []PipelineVariable `json:"variables,omitempty"`
+}
+
+type PipelineVariable struct {
+	Key          string `json:"key"`
+	Value        string `json:"value"`
+	VariableType string `json:"variable_type"`
+}
+
+const (
+	VariableTypeEnvVar = "env_var"
+
+	PipelineStatusSuccess  = "success"
+	PipelineStatusFailed   = "failed"
+	PipelineStatusCanceled = "canceled"
+	PipelineStatusSkipped  = "skipped"
+	PipelineStatusRunning  = "running"
+	PipelineStatusPending  = "pending"
+	PipelineStatusCreated  = "created"
+)
+
+type Pipeline struct {
+	ID     int64  `json:"id"`
+	Ref    string `json:"ref"`
+	Status string `json:"status"`
+	WebURL string `json:"web_url"`
+}
+
+// Finished pipelines do not change status anymore.
+func (p *Pipeline) Finished() bool {
+	switch p.Status {
+	case PipelineStatusSuccess, PipelineStatusFailed, PipelineStatusCanceled, PipelineStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *GitLab) CreatePipeline(projectID string, request CreatePipelineRequest) (*Pipeline, error) {
+	URL := fmt.Sprintf("%s/api/v4/projects/%s/pipeline", g.URL, url.PathEscape(projectID))
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling pipeline request: %v", err)
+	}
+
+	return g.pipelineRequest(http.MethodPost, URL, bytes.NewReader(body), http.StatusCreated)
+}
+
+func (g *GitLab) GetPipeline(projectID string, pipelineID string) (*Pipeline, error) {
+	URL := fmt.Sprintf("%s/api/v4/projects/%s/pipelines/%s", g.URL, url.PathEscape(projectID), pipelineID)
+	return g.pipelineRequest(http.MethodGet, URL, nil, http.StatusOK)
+}
+
+func (g *GitLab) CancelPipeline(projectID string, pipelineID string) (*Pipeline, error) {
+	URL := fmt.Sprintf("%s/api/v4/projects/%s/pipelines/%s/cancel", g.URL, url.PathEscape(projectID), pipelineID)
+	return g.pipelineRequest(http.MethodPost, URL, nil, http.StatusOK)
+}
+
+func (g *GitLab) pipelineRequest(method, URL string, body io.Reader, expectedStatus int) (*Pipeline, error) {
+	req, err := http.NewRequest(method, URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("PRIVATE-TOKEN", g.Token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %v", err)
+	}
+
+	defer res.Body.Close()
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading body: %v", err)
+	}
+
+	if res.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("request got %d code: %s", res.StatusCode, string(responseBody))
+	}
+
+	var pipeline Pipeline
+	err = json.Unmarshal(responseBody, &pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+
+	return &pipeline, nil
+}
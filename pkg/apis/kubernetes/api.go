@@ -0,0 +1,236 @@
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+)
+
+const (
+	InClusterTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	InClusterCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	JobConditionComplete = "Complete"
+	JobConditionFailed   = "Failed"
+	ConditionStatusTrue  = "True"
+)
+
+var ErrNotFound = fmt.Errorf("not found")
+
+// Client is the subset of the Kubernetes API used by the executor.
+// Keeping it small makes it easy to replace it with a fake in tests.
+type Client interface {
+	CreateJob(namespace string, job *Job) (*Job, error)
+	GetJob(namespace, name string) (*Job, error)
+	DeleteJob(namespace, name string) error
+}
+
+type ObjectMeta struct {
+	Name        string            `json:"name,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Job keeps the spec untyped, so any field supported
+// by the cluster can be used in the manifest.
+type Job struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Metadata   ObjectMeta     `json:"metadata"`
+	Spec       map[string]any `json:"spec"`
+	Status     JobStatus      `json:"status,omitempty"`
+}
+
+type JobStatus struct {
+	Active     int32          `json:"active,omitempty"`
+	Succeeded  int32          `json:"succeeded,omitempty"`
+	Failed     int32          `json:"failed,omitempty"`
+	Conditions []JobCondition `json:"conditions,omitempty"`
+}
+
+type JobCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (j *Job) HasCondition(conditionType string) bool {
+	for _, condition := range j.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == ConditionStatusTrue {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Finished jobs do not run any more pods.
+func (j *Job) Finished() bool {
+	return j.HasCondition(JobConditionComplete) || j.HasCondition(JobConditionFailed)
+}
+
+func (j *Job) Successful() bool {
+	return j.HasCondition(JobConditionComplete)
+}
+
+type Kubernetes struct {
+	URL        string
+	Token      string
+	HttpClient *http.Client
+}
+
+// NewKubernetesAPI creates a client for the API server at URL.
+// If no URL is given, the in-cluster service account configuration is used.
+func NewKubernetesAPI(URL, token, caCertificate string) (*Kubernetes, error) {
+	if URL == "" {
+		return newInClusterAPI()
+	}
+
+	httpClient, err := newHTTPClient([]byte(caCertificate))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Kubernetes{
+		URL:        URL,
+		Token:      token,
+		HttpClient: httpClient,
+	}, nil
+}
+
+func newInClusterAPI() (*Kubernetes, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("no URL given and not running inside a cluster")
+	}
+
+	token, err := os.ReadFile(InClusterTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account token: %v", err)
+	}
+
+	caCertificate, err := os.ReadFile(InClusterCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account CA: %v", err)
+	}
+
+	httpClient, err := newHTTPClient(caCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Kubernetes{
+		URL:        "https://" + net.JoinHostPort(host, port),
+		Token:      string(token),
+		HttpClient: httpClient,
+	}, nil
+}
+
+func newHTTPClient(caCertificate []byte) (*http.Client, error) {
+	if len(caCertificate) == 0 {
+		return &http.Client{Timeout: 30 * time.Second}, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCertificate) {
+		return nil, fmt.Errorf("invalid CA certificate")
+	}
+
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}, nil
+}
+
+func (k *Kubernetes) CreateJob(namespace string, job *Job) (*Job, error) {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling job: %v", err)
+	}
+
+	var created Job
+	err = k.request(http.MethodPost, k.jobsURL(namespace), bytes.NewReader(body), []int{http.StatusCreated}, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (k *Kubernetes) GetJob(namespace, name string) (*Job, error) {
+	var job Job
+	err := k.request(http.MethodGet, k.jobsURL(namespace)+"/"+url.PathEscape(name), nil, []int{http.StatusOK}, &job)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// DeleteJob also removes the pods created by the job.
+// The API server answers with 202 if the deletion is still in progress.
+func (k *Kubernetes) DeleteJob(namespace, name string) error {
+	body := []byte(`{"kind":"DeleteOptions","apiVersion":"v1","propagationPolicy":"Background"}`)
+	URL := k.jobsURL(namespace) + "/" + url.PathEscape(name)
+	return k.request(http.MethodDelete, URL, bytes.NewReader(body), []int{http.StatusOK, http.StatusAccepted}, nil)
+}
+
+func (k *Kubernetes) jobsURL(namespace string) string {
+	return fmt.Sprintf("%s/apis/batch/v1/namespaces/%s/jobs", k.URL, url.PathEscape(namespace))
+}
+
+func (k *Kubernetes) request(method, URL string, body io.Reader, expectedStatus []int, result any) error {
+	req, err := http.NewRequest(method, URL, body)
+	if err != nil {
+		return fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if k.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+k.Token)
+	}
+
+	res, err := k.HttpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error executing request: %v", err)
+	}
+
+	defer res.Body.Close()
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error reading body: %v", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if !slices.Contains(expectedStatus, res.StatusCode) {
+		return fmt.Errorf("request got %d code: %s", res.StatusCode, string(responseBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(responseBody, result)
+	if err != nil {
+		return fmt.Errorf("error unmarshaling response: %v", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
 type Semaphore struct {
@@ -47,6 +48,27 @@ type Workflow struct {
 	InitialPplID string `json:"initial_ppl_id"`
 }
 
+type RunWorkflowRequest struct {
+	ProjectID    string            `json:"project_id"`
+	Reference    string            `json:"reference"`
+	PipelineFile string            `json:"pipeline_file,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+}
+
+type RunWorkflowResponse struct {
+	WorkflowID string `json:"workflow_id"`
+	PipelineID string `json:"pipeline_id"`
+}
+
+type Promotion struct {
+	Name                string            `json:"name"`
+	PipelineID          string            `json:"pipeline_id"`
+	ScheduledPipelineID string            `json:"scheduled_pipeline_id"`
+	Status              string            `json:"status"`
+	TriggeredAt         string            `json:"triggered_at"`
+	Parameters          map[string]string `json:"parameters"`
+}
+
 const (
 	PipelineStateDone    = "done"
 	PipelineResultPassed = "passed"
@@ -131,6 +153,28 @@ func (s *Semaphore) DescribePipeline(pipelineID string) (*Pipeline, error) {
 	return pipelineResponse.Pipeline, nil
 }
 
+func (s *Semaphore) StopWorkflow(workflowID string) error {
+	URL := fmt.Sprintf("%s/api/v1alpha/plumber-workflows/%s/terminate", s.URL, workflowID)
+	req, err := http.NewRequest(http.MethodPost, URL, nil)
+	if err != nil {
+		return fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Token "+s.Token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error executing request: %v", err)
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("request got %d code", res.StatusCode)
+	}
+
+	return nil
+}
+
 func (s *Semaphore) TriggerTask(projectID, taskID string, spec TaskTriggerSpec) (string, error) {
 	URL := fmt.Sprintf("%s/api/v2/projects/%s/tasks/%s/triggers", s.URL, projectID, taskID)
 
@@ -177,3 +221,108 @@ func (s *Semaphore) TriggerTask(projectID, taskID string, spec TaskTriggerSpec)
 
 	return trigger.Metadata.WorkflowID, nil
 }
+
+func (s *Semaphore) RunWorkflow(request RunWorkflowRequest) (*RunWorkflowResponse, error) {
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling workflow request: %v", err)
+	}
+
+	var response RunWorkflowResponse
+	URL := fmt.Sprintf("%s/api/v1alpha/plumber-workflows", s.URL)
+	err = s.do(http.MethodPost, URL, body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.WorkflowID == "" {
+		return nil, fmt.Errorf("no workflow ID in response")
+	}
+
+	return &response, nil
+}
+
+// Promotion parameters are sent as additional fields in the request.
+// The API does not return the pipeline created by the promotion,
+// so ListPromotions is used to find it.
+func (s *Semaphore) Promote(pipelineID, name string, parameters map[string]string) error {
+	request := map[string]any{}
+	for k, v := range parameters {
+		request[k] = v
+	}
+
+	request["pipeline_id"] = pipelineID
+	request["name"] = name
+	request["override"] = true
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error marshaling promotion request: %v", err)
+	}
+
+	URL := fmt.Sprintf("%s/api/v1alpha/promotions", s.URL)
+	return s.do(http.MethodPost, URL, body, nil)
+}
+
+// ListPromotions returns the promotions of a pipeline, most recent first.
+func (s *Semaphore) ListPromotions(pipelineID, name string) ([]Promotion, error) {
+	query := url.Values{}
+	query.Set("pipeline_id", pipelineID)
+	query.Set("name", name)
+
+	var promotions []Promotion
+	URL := fmt.Sprintf("%s/api/v1alpha/promotions?%s", s.URL, query.Encode())
+	err := s.do(http.MethodGet, URL, nil, &promotions)
+	if err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// StopPipeline only stops the pipeline, not the whole workflow,
+// since other pipelines in the workflow might not be related to the execution.
+func (s *Semaphore) StopPipeline(pipelineID string) error {
+	URL := fmt.Sprintf("%s/api/v1alpha/pipelines/%s", s.URL, pipelineID)
+	return s.do(http.MethodPatch, URL, []byte(`{"terminate_request": true}`), nil)
+}
+
+func (s *Semaphore) do(method, URL string, body []byte, result any) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, URL, reader)
+	if err != nil {
+		return fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Token "+s.Token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error executing request: %v", err)
+	}
+
+	defer res.Body.Close()
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error reading body: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("request got %d code: %s", res.StatusCode, string(responseBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(responseBody, result)
+	if err != nil {
+		return fmt.Errorf("error unmarshaling response: %v", err)
+	}
+
+	return nil
+}
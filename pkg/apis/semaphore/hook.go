@@ -0,0 +1,20 @@
+package semaphore
+
+// Hook is the payload of the notifications Semaphore sends
+// when a pipeline finishes. Only the fields we use are included.
+type Hook struct {
+	Workflow HookWorkflow `json:"workflow"`
+	Pipeline HookPipeline `json:"pipeline"`
+}
+
+type HookWorkflow struct {
+	ID                string `json:"id"`
+	InitialPipelineID string `json:"initial_pipeline_id"`
+}
+
+type HookPipeline struct {
+	ID           string `json:"id"`
+	State        string `json:"state"`
+	Result       string `json:"result"`
+	ResultReason string `json:"result_reason"`
+}
@@ -37,6 +37,9 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		"/Superplane.Superplane/DescribeStage":       {Resource: "stage", Action: "read", DomainType: "canvas"},
 		"/Superplane.Superplane/UpdateStage":         {Resource: "stage", Action: "update", DomainType: "canvas"},
 		"/Superplane.Superplane/ListStages":          {Resource: "stage", Action: "read", DomainType: "canvas"},
+		"/Superplane.Superplane/CancelExecution":     {Resource: "stage", Action: "update", DomainType: "canvas"},
+		"/Superplane.Superplane/RetryExecution":      {Resource: "stage", Action: "update", DomainType: "canvas"},
+		"/Superplane.Superplane/GetExecutionLogs":    {Resource: "stage", Action: "read", DomainType: "canvas"},
 		"/Superplane.Superplane/CreateSecret":        {Resource: "secret", Action: "create", DomainType: "canvas"},
 		"/Superplane.Superplane/UpdateSecret":        {Resource: "secret", Action: "update", DomainType: "canvas"},
 		"/Superplane.Superplane/DescribeSecret":      {Resource: "secret", Action: "read", DomainType: "canvas"},
@@ -44,6 +47,9 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		"/Superplane.Superplane/DeleteSecret":        {Resource: "secret", Action: "delete", DomainType: "canvas"},
 		"/Superplane.Superplane/ApproveStageEvent":   {Resource: "stageevent", Action: "approve", DomainType: "canvas"},
 		"/Superplane.Superplane/ListStageEvents":     {Resource: "stageevent", Action: "read", DomainType: "canvas"},
+		"/Superplane.Superplane/CreateEvent":         {Resource: "event", Action: "create", DomainType: "canvas"},
+		"/Superplane.Superplane/ReplayEvent":         {Resource: "event", Action: "replay", DomainType: "canvas"},
+		"/Superplane.Superplane/CreateAgent":         {Resource: "agent", Action: "create", DomainType: "canvas"},
 
 		// Organization rules
 		"/Superplane.Organizations.Organizations/DescribeOrganization": {Resource: "org", Action: "read", DomainType: "org"},
@@ -0,0 +1,91 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+const (
+	TypeRabbitMQ = "rabbitmq"
+	TypeMemory   = "memory"
+	TypePostgres = "postgres"
+)
+
+// Handler processes the body of a message received from the bus.
+type Handler func(body []byte) error
+
+type Subscription struct {
+	Exchange   string
+	RoutingKey string
+
+	// Subscribers using the same queue share its messages,
+	// if the bus supports it. Otherwise, every subscriber gets every message.
+	Queue string
+}
+
+// Bus delivers the messages about canvas changes
+// from the place where they happen to the workers that react to them.
+type Bus interface {
+	Publish(exchange, routingKey string, body []byte) error
+
+	// Subscribe calls the handler for every message published
+	// to the exchange with the routing key, until the context is done.
+	Subscribe(ctx context.Context, subscription Subscription, handler Handler) error
+}
+
+var current = struct {
+	sync.Mutex
+	bus Bus
+}{}
+
+// Get returns the bus configured with MESSAGE_BUS,
+// creating it the first time it is used.
+func Get() (Bus, error) {
+	current.Lock()
+	defer current.Unlock()
+
+	if current.bus != nil {
+		return current.bus, nil
+	}
+
+	b, err := New(config.MessageBus())
+	if err != nil {
+		return nil, err
+	}
+
+	current.bus = b
+	return b, nil
+}
+
+// Set replaces the bus returned by Get.
+// Setting it to nil creates a new one from MESSAGE_BUS on the next Get.
+func Set(b Bus) {
+	current.Lock()
+	defer current.Unlock()
+	current.bus = b
+}
+
+func New(busType string) (Bus, error) {
+	switch busType {
+	case "", TypeRabbitMQ:
+		URL, err := config.RabbitMQURL()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewRabbitMQBus(URL), nil
+
+	case TypeMemory:
+		return NewMemoryBus(), nil
+
+	case TypePostgres:
+		return NewPostgresBus(database.DSN()), nil
+
+	default:
+		return nil, fmt.Errorf("unknown message bus %s", busType)
+	}
+}
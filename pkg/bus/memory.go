@@ -0,0 +1,114 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const MemoryQueueSize = 1024
+
+// MemoryBus delivers messages inside a single process,
+// for single-binary deployments and tests.
+// Messages published while nobody is subscribed are not accepted.
+type MemoryBus struct {
+	mu     sync.Mutex
+	queues map[string]map[string]*memoryQueue
+}
+
+// Subscribers using the same queue read from the same channel,
+// so each message is handled by only one of them.
+type memoryQueue struct {
+	messages    chan []byte
+	subscribers int
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{queues: map[string]map[string]*memoryQueue{}}
+}
+
+// If nobody is subscribed, or any queue is full, Publish fails
+// without delivering the message anywhere, and the outbox relay tries again later.
+func (b *MemoryBus) Publish(exchange, routingKey string, body []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := memoryKey(exchange, routingKey)
+	queues := b.queues[key]
+	if len(queues) == 0 {
+		return fmt.Errorf("no subscribers for %s", key)
+	}
+
+	//
+	// Only Publish sends to the queues, and it holds the lock,
+	// so queues with room left now still have it below.
+	//
+	for name, queue := range queues {
+		if len(queue.messages) == cap(queue.messages) {
+			return fmt.Errorf("queue %s is full", name)
+		}
+	}
+
+	for _, queue := range queues {
+		queue.messages <- body
+	}
+
+	return nil
+}
+
+func (b *MemoryBus) Subscribe(ctx context.Context, subscription Subscription, handler Handler) error {
+	queue := b.subscribe(subscription)
+	defer b.unsubscribe(subscription)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case body := <-queue.messages:
+			if err := handler(body); err != nil {
+				log.Errorf("Error handling %s message from queue %s: %v", subscription.RoutingKey, subscription.Queue, err)
+			}
+		}
+	}
+}
+
+func (b *MemoryBus) subscribe(subscription Subscription) *memoryQueue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := memoryKey(subscription.Exchange, subscription.RoutingKey)
+	if b.queues[key] == nil {
+		b.queues[key] = map[string]*memoryQueue{}
+	}
+
+	queue, ok := b.queues[key][subscription.Queue]
+	if !ok {
+		queue = &memoryQueue{messages: make(chan []byte, MemoryQueueSize)}
+		b.queues[key][subscription.Queue] = queue
+	}
+
+	queue.subscribers++
+	return queue
+}
+
+func (b *MemoryBus) unsubscribe(subscription Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := memoryKey(subscription.Exchange, subscription.RoutingKey)
+	queue, ok := b.queues[key][subscription.Queue]
+	if !ok {
+		return
+	}
+
+	queue.subscribers--
+	if queue.subscribers == 0 {
+		delete(b.queues[key], subscription.Queue)
+	}
+}
+
+func memoryKey(exchange, routingKey string) string {
+	return exchange + "/" + routingKey
+}
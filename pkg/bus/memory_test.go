@@ -0,0 +1,141 @@
+package bus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__MemoryBus(t *testing.T) {
+	t.Run("message is delivered to subscribers of its routing key", func(t *testing.T) {
+		b := NewMemoryBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		received := make(chan []byte, 10)
+		go b.Subscribe(ctx, Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "a"}, func(body []byte) error {
+			received <- body
+			return nil
+		})
+
+		go b.Subscribe(ctx, Subscription{Exchange: "canvas", RoutingKey: "deleted", Queue: "b"}, func(body []byte) error {
+			received <- body
+			return nil
+		})
+
+		waitForQueues(t, b, 2)
+		require.NoError(t, b.Publish("canvas", "created", []byte("hello")))
+
+		select {
+		case body := <-received:
+			assert.Equal(t, []byte("hello"), body)
+		case <-time.After(time.Second):
+			t.Fatal("message not received")
+		}
+
+		assert.Never(t, func() bool { return len(received) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("subscribers on the same queue -> message is handled once", func(t *testing.T) {
+		b := NewMemoryBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var mu sync.Mutex
+		handled := 0
+		handler := func(body []byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			handled++
+			return nil
+		}
+
+		subscription := Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "shared"}
+		go b.Subscribe(ctx, subscription, handler)
+		go b.Subscribe(ctx, subscription, handler)
+
+		waitForQueues(t, b, 1)
+		require.NoError(t, b.Publish("canvas", "created", []byte("hello")))
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return handled == 1
+		}, time.Second, 10*time.Millisecond)
+
+		assert.Never(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return handled > 1
+		}, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("no subscribers -> error", func(t *testing.T) {
+		b := NewMemoryBus()
+		require.ErrorContains(t, b.Publish("canvas", "created", []byte("hello")), "no subscribers for canvas/created")
+	})
+
+	t.Run("subscription is removed when its context is done", func(t *testing.T) {
+		b := NewMemoryBus()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		done := make(chan struct{})
+		go func() {
+			b.Subscribe(ctx, Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "a"}, func(body []byte) error {
+				return nil
+			})
+
+			close(done)
+		}()
+
+		waitForQueues(t, b, 1)
+		cancel()
+		<-done
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		assert.Empty(t, b.queues[memoryKey("canvas", "created")])
+	})
+
+	t.Run("queue is full -> error", func(t *testing.T) {
+		b := NewMemoryBus()
+		queue := b.subscribe(Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "a"})
+
+		for i := 0; i < cap(queue.messages); i++ {
+			require.NoError(t, b.Publish("canvas", "created", []byte("hello")))
+		}
+
+		require.ErrorContains(t, b.Publish("canvas", "created", []byte("hello")), "queue a is full")
+	})
+
+	t.Run("one of the queues is full -> message is not delivered to any", func(t *testing.T) {
+		b := NewMemoryBus()
+		full := b.subscribe(Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "a"})
+		other := b.subscribe(Subscription{Exchange: "canvas", RoutingKey: "created", Queue: "b"})
+
+		for i := 0; i < cap(full.messages); i++ {
+			full.messages <- []byte("hello")
+		}
+
+		require.ErrorContains(t, b.Publish("canvas", "created", []byte("hello")), "queue a is full")
+		assert.Empty(t, other.messages)
+	})
+}
+
+func waitForQueues(t *testing.T, b *MemoryBus, count int) {
+	require.Eventually(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		queues := 0
+		for _, q := range b.queues {
+			queues += len(q)
+		}
+
+		return queues == count
+	}, time.Second, 10*time.Millisecond)
+}
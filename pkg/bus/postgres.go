@@ -0,0 +1,136 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	PostgresChannel = "superplane_messages"
+
+	// NOTIFY payloads must be shorter than 8000 bytes.
+	PostgresMaxPayloadSize = 7999
+)
+
+// PostgresBus uses LISTEN/NOTIFY, so deployments with many Superplane nodes
+// do not need anything other than the database.
+// Every subscriber gets every message, even if they use the same queue,
+// and messages sent while a subscriber is reconnecting are lost.
+type PostgresBus struct {
+	DSN string
+
+	// Publishing uses its own connection, since it happens
+	// while the outbox relay holds a connection from the pool.
+	mu   sync.Mutex
+	conn *pgx.Conn
+}
+
+type postgresMessage struct {
+	Exchange   string `json:"exchange"`
+	RoutingKey string `json:"routing_key"`
+	Body       []byte `json:"body"`
+}
+
+func NewPostgresBus(DSN string) *PostgresBus {
+	return &PostgresBus{DSN: DSN}
+}
+
+func (b *PostgresBus) Publish(exchange, routingKey string, body []byte) error {
+	payload, err := json.Marshal(postgresMessage{
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+		Body:       body,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > PostgresMaxPayloadSize {
+		return fmt.Errorf("message is too big: %d bytes", len(payload))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if b.conn == nil || b.conn.IsClosed() {
+		b.conn, err = pgx.Connect(ctx, b.DSN)
+		if err != nil {
+			return fmt.Errorf("error connecting to database: %v", err)
+		}
+	}
+
+	_, err = b.conn.Exec(ctx, "SELECT pg_notify($1, $2)", PostgresChannel, string(payload))
+	if err != nil {
+		b.conn.Close(ctx)
+		b.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+// If the connection to the database is lost, we reconnect
+// until the context is done.
+func (b *PostgresBus) Subscribe(ctx context.Context, subscription Subscription, handler Handler) error {
+	for {
+		err := b.listen(ctx, subscription, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		log.Errorf("Error listening for %s messages: %v", subscription.RoutingKey, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (b *PostgresBus) listen(ctx context.Context, subscription Subscription, handler Handler) error {
+	conn, err := pgx.Connect(ctx, b.DSN)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{PostgresChannel}.Sanitize())
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Listening for %s messages", subscription.RoutingKey)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var message postgresMessage
+		if err := json.Unmarshal([]byte(notification.Payload), &message); err != nil {
+			log.Errorf("Invalid message on %s: %v", PostgresChannel, err)
+			continue
+		}
+
+		if message.Exchange != subscription.Exchange || message.RoutingKey != subscription.RoutingKey {
+			continue
+		}
+
+		if err := handler(message.Body); err != nil {
+			log.Errorf("Error handling %s message: %v", subscription.RoutingKey, err)
+		}
+	}
+}
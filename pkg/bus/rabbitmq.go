@@ -0,0 +1,82 @@
+package bus
+
+import (
+	"context"
+	"time"
+
+	"github.com/renderedtext/go-tackle"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/logging"
+)
+
+// RabbitMQBus is the bus for deployments with many Superplane nodes.
+// Every queue is consumed by one subscriber at a time,
+// so subscribers on different nodes with the same queue share its messages.
+type RabbitMQBus struct {
+	URL string
+}
+
+func NewRabbitMQBus(URL string) *RabbitMQBus {
+	return &RabbitMQBus{URL: URL}
+}
+
+func (b *RabbitMQBus) Publish(exchange, routingKey string, body []byte) error {
+	return tackle.PublishMessage(&tackle.PublishParams{
+		Body:       body,
+		AmqpURL:    b.URL,
+		RoutingKey: routingKey,
+		Exchange:   exchange,
+	})
+}
+
+// If the connection to RabbitMQ is lost, we reconnect
+// until the context is done.
+func (b *RabbitMQBus) Subscribe(ctx context.Context, subscription Subscription, handler Handler) error {
+	options := tackle.Options{
+		URL:            b.URL,
+		ConnectionName: "superplane",
+		RemoteExchange: subscription.Exchange,
+		Service:        subscription.Queue,
+		RoutingKey:     subscription.RoutingKey,
+	}
+
+	for {
+		log.Infof("Connecting to RabbitMQ queue %s for %s messages", subscription.Queue, subscription.RoutingKey)
+
+		consumer := tackle.NewConsumer()
+		consumer.SetLogger(logging.NewTackleLogger(log.StandardLogger().WithFields(log.Fields{
+			"queue":       subscription.Queue,
+			"routing_key": subscription.RoutingKey,
+		})))
+
+		stopped := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				consumer.Stop()
+			case <-stopped:
+			}
+		}()
+
+		err := consumer.Start(&options, func(delivery tackle.Delivery) error {
+			return handler(delivery.Body())
+		})
+
+		close(stopped)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			log.Errorf("Error consuming messages from %s: %v", subscription.RoutingKey, err)
+		} else {
+			log.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", subscription.RoutingKey)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
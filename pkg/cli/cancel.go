@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var cancelExecutionCmd = &cobra.Command{
+	Use:     "execution [EXECUTION_ID]",
+	Short:   "Cancel a stage execution",
+	Long:    `Cancel a pending or running stage execution, stopping it in the executor if possible.`,
+	Aliases: []string{"executions"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		executionID := args[0]
+
+		canvasIDOrName := getOneOrAnotherFlag(cmd, "canvas-id", "canvas-name")
+		stageIDOrName := getOneOrAnotherFlag(cmd, "stage-id", "stage-name")
+
+		c := DefaultClient()
+
+		request := openapi_client.NewSuperplaneCancelExecutionBody()
+		request.SetRequesterId(uuid.NewString())
+
+		response, _, err := c.StageAPI.SuperplaneCancelExecution(
+			context.Background(),
+			canvasIDOrName,
+			stageIDOrName,
+			executionID,
+		).Body(*request).Execute()
+		Check(err)
+
+		fmt.Printf("Execution '%s' cancelled successfully.\n", *response.Execution.Id)
+	},
+}
+
+// Root cancel command
+var cancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel running resources",
+	Long:  `Cancel executions or other resources that are in progress.`,
+}
+
+func init() {
+	cancelExecutionCmd.Flags().String("canvas-id", "", "Canvas ID")
+	cancelExecutionCmd.Flags().String("canvas-name", "", "Canvas name")
+	cancelExecutionCmd.Flags().String("stage-id", "", "Stage ID")
+	cancelExecutionCmd.Flags().String("stage-name", "", "Stage name")
+
+	RootCmd.AddCommand(cancelCmd)
+	cancelCmd.AddCommand(cancelExecutionCmd)
+}
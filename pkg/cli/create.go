@@ -201,9 +201,17 @@ var createCmd = &cobra.Command{
 			esMeta.SetCanvasId(canvasIDOrName)
 			eventSource.SetMetadata(*esMeta)
 
-			// Create an empty spec for the EventSource
-			emptySpec := make(map[string]interface{})
-			eventSource.SetSpec(emptySpec)
+			// The spec is optional for event sources
+			var eventSourceSpec openapi_client.SuperplaneEventSourceSpec
+			if spec, ok := yamlData["spec"]; ok {
+				specData, err := json.Marshal(spec)
+				Check(err)
+
+				err = json.Unmarshal(specData, &eventSourceSpec)
+				Check(err)
+			}
+
+			eventSource.SetSpec(eventSourceSpec)
 
 			// Set in request
 			request.SetEventSource(*eventSource)
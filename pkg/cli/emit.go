@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var emitEventCmd = &cobra.Command{
+	Use:     "event",
+	Short:   "Emit an event from an event source",
+	Long:    `Emit an event from an event source, as if it was received through its webhook. The payload is read from a JSON file.`,
+	Aliases: []string{"events"},
+	Args:    cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		canvasIDOrName := getOneOrAnotherFlag(cmd, "canvas-id", "canvas-name")
+		source, _ := cmd.Flags().GetString("source")
+		if source == "" {
+			Fail("--source is required")
+		}
+
+		path, _ := cmd.Flags().GetString("file")
+		if path == "" {
+			Fail("--file is required")
+		}
+
+		data, err := os.ReadFile(path)
+		Check(err)
+
+		var payload map[string]interface{}
+		err = json.Unmarshal(data, &payload)
+		Check(err)
+
+		rawHeaders, _ := cmd.Flags().GetStringArray("header")
+		headers, err := parseEventHeaders(rawHeaders)
+		Check(err)
+
+		request := openapi_client.NewSuperplaneCreateEventBody()
+		request.SetPayload(payload)
+		request.SetHeaders(headers)
+
+		c := DefaultClient()
+		response, _, err := c.EventAPI.SuperplaneCreateEvent(context.Background(), canvasIDOrName, source).Body(*request).Execute()
+		Check(err)
+
+		event := response.GetEvent()
+		fmt.Printf("Event '%s' emitted from '%s'.\n", event.GetId(), event.GetSourceName())
+	},
+}
+
+func parseEventHeaders(rawHeaders []string) (map[string]string, error) {
+	headers := make(map[string]string, len(rawHeaders))
+	for _, h := range rawHeaders {
+		key, value, found := strings.Cut(h, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid header %q - must be KEY=VALUE", h)
+		}
+
+		headers[key] = value
+	}
+
+	return headers, nil
+}
+
+// Root emit command
+var emitCmd = &cobra.Command{
+	Use:   "emit",
+	Short: "Emit events",
+	Long:  `Emit events from event sources, without sending webhooks to them.`,
+}
+
+func init() {
+	emitEventCmd.Flags().String("canvas-id", "", "Canvas ID")
+	emitEventCmd.Flags().String("canvas-name", "", "Canvas name")
+	emitEventCmd.Flags().String("source", "", "Event source ID or name")
+	emitEventCmd.Flags().StringP("file", "f", "", "JSON file with the event payload")
+	emitEventCmd.Flags().StringArray("header", []string{}, "Event header, as KEY=VALUE (can be repeated)")
+
+	RootCmd.AddCommand(emitCmd)
+	emitCmd.AddCommand(emitEventCmd)
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs [EXECUTION_ID]",
+	Short: "Show the logs of a stage execution",
+	Long:  `Show the logs of a stage execution. With --follow, keeps printing new logs until the execution finishes.`,
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		executionID := args[0]
+
+		canvasIDOrName := getOneOrAnotherFlag(cmd, "canvas-id", "canvas-name")
+		stageIDOrName := getOneOrAnotherFlag(cmd, "stage-id", "stage-name")
+		follow, _ := cmd.Flags().GetBool("follow")
+
+		c := DefaultClient()
+		lastID := "0"
+
+		for {
+			response, _, err := c.StageAPI.SuperplaneGetExecutionLogs(
+				context.Background(),
+				canvasIDOrName,
+				stageIDOrName,
+				executionID,
+			).AfterId(lastID).Follow(follow).Execute()
+			Check(err)
+
+			for _, log := range response.GetLogs() {
+				if log.GetStream() == openapi_client.EXECUTIONLOGSTREAM_STREAM_STDERR {
+					fmt.Fprint(os.Stderr, log.GetContent())
+				} else {
+					fmt.Print(log.GetContent())
+				}
+			}
+
+			lastID = response.GetLastId()
+
+			//
+			// Without --follow, we stop once we have read everything stored so far.
+			//
+			if response.GetFinished() || (!follow && len(response.GetLogs()) == 0) {
+				return
+			}
+		}
+	},
+}
+
+func init() {
+	logsCmd.Flags().String("canvas-id", "", "Canvas ID")
+	logsCmd.Flags().String("canvas-name", "", "Canvas name")
+	logsCmd.Flags().String("stage-id", "", "Stage ID")
+	logsCmd.Flags().String("stage-name", "", "Stage name")
+	logsCmd.Flags().BoolP("follow", "f", false, "Keep printing new logs until the execution finishes")
+
+	RootCmd.AddCommand(logsCmd)
+}
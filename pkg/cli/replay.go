@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var replayEventCmd = &cobra.Command{
+	Use:     "event [EVENT_ID]",
+	Short:   "Replay an event",
+	Long:    `Process a stored event again. By default, the event is re-delivered to the stages that received it, with the same inputs. Use --current-spec to go through the current connection filters and input mappings instead.`,
+	Aliases: []string{"events"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		eventID := args[0]
+
+		canvasIDOrName := getOneOrAnotherFlag(cmd, "canvas-id", "canvas-name")
+		stage, _ := cmd.Flags().GetString("stage")
+		currentSpec, _ := cmd.Flags().GetBool("current-spec")
+
+		request := openapi_client.NewSuperplaneReplayEventBody()
+		request.SetStageIdOrName(stage)
+		request.SetUseCurrentSpec(currentSpec)
+
+		c := DefaultClient()
+		response, _, err := c.EventAPI.SuperplaneReplayEvent(context.Background(), canvasIDOrName, eventID).Body(*request).Execute()
+		Check(err)
+
+		event := response.GetEvent()
+		fmt.Printf("Event '%s' replayed as '%s'.\n", eventID, event.GetId())
+	},
+}
+
+// Root replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Replay events",
+	Long:  `Send stored events through their connections again.`,
+}
+
+func init() {
+	replayEventCmd.Flags().String("canvas-id", "", "Canvas ID")
+	replayEventCmd.Flags().String("canvas-name", "", "Canvas name")
+	replayEventCmd.Flags().String("stage", "", "Only replay the event for this stage ID or name")
+	replayEventCmd.Flags().Bool("current-spec", false, "Use the current connection filters and input mappings")
+
+	RootCmd.AddCommand(replayCmd)
+	replayCmd.AddCommand(replayEventCmd)
+}
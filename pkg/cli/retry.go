@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var retryExecutionCmd = &cobra.Command{
+	Use:     "execution [EXECUTION_ID]",
+	Short:   "Retry a stage execution",
+	Long:    `Retry a failed or cancelled stage execution, creating a new attempt for the same event and inputs.`,
+	Aliases: []string{"executions"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		executionID := args[0]
+
+		canvasIDOrName := getOneOrAnotherFlag(cmd, "canvas-id", "canvas-name")
+		stageIDOrName := getOneOrAnotherFlag(cmd, "stage-id", "stage-name")
+
+		c := DefaultClient()
+
+		request := openapi_client.NewSuperplaneRetryExecutionBody()
+		request.SetRequesterId(uuid.NewString())
+
+		response, _, err := c.StageAPI.SuperplaneRetryExecution(
+			context.Background(),
+			canvasIDOrName,
+			stageIDOrName,
+			executionID,
+		).Body(*request).Execute()
+		Check(err)
+
+		fmt.Printf("Execution '%s' retried successfully - attempt %d is '%s'.\n", executionID, response.Execution.GetAttempt(), response.Execution.GetId())
+	},
+}
+
+// Root retry command
+var retryCmd = &cobra.Command{
+	Use:   "retry",
+	Short: "Retry resources that failed",
+	Long:  `Retry executions or other resources that failed.`,
+}
+
+func init() {
+	retryExecutionCmd.Flags().String("canvas-id", "", "Canvas ID")
+	retryExecutionCmd.Flags().String("canvas-name", "", "Canvas name")
+	retryExecutionCmd.Flags().String("stage-id", "", "Stage ID")
+	retryExecutionCmd.Flags().String("stage-name", "", "Stage name")
+
+	RootCmd.AddCommand(retryCmd)
+	retryCmd.AddCommand(retryExecutionCmd)
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 )
 
 func RabbitMQURL() (string, error) {
@@ -13,3 +15,53 @@ func RabbitMQURL() (string, error) {
 
 	return URL, nil
 }
+
+// MessageBus is the type of message bus to use, from MESSAGE_BUS.
+// RabbitMQ is used if it is not set.
+func MessageBus() string {
+	return strings.TrimSpace(os.Getenv("MESSAGE_BUS"))
+}
+
+// ShutdownDrainDelay is how long servers keep accepting requests
+// after shutdown starts, from SHUTDOWN_DRAIN_DELAY, e.g. "10s".
+// The default delay is used if it is not set.
+func ShutdownDrainDelay(defaultDelay time.Duration) (time.Duration, error) {
+	value := strings.TrimSpace(os.Getenv("SHUTDOWN_DRAIN_DELAY"))
+	if value == "" {
+		return defaultDelay, nil
+	}
+
+	delay, err := time.ParseDuration(value)
+	if err != nil || delay < 0 {
+		return 0, fmt.Errorf("invalid SHUTDOWN_DRAIN_DELAY %q", value)
+	}
+
+	return delay, nil
+}
+
+// ExecutorPlugins reads the executor plugins from EXECUTOR_PLUGINS,
+// which is a comma-separated list of name=address pairs,
+// where addresses can start with tls:// or insecure://,
+// e.g. "deployer=deployer:50051,tickets=tickets.internal:50051".
+func ExecutorPlugins() (map[string]string, error) {
+	plugins := map[string]string{}
+	value := strings.TrimSpace(os.Getenv("EXECUTOR_PLUGINS"))
+	if value == "" {
+		return plugins, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		name, address, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || name == "" || address == "" {
+			return nil, fmt.Errorf("invalid executor plugin %q in EXECUTOR_PLUGINS", entry)
+		}
+
+		if _, ok := plugins[name]; ok {
+			return nil, fmt.Errorf("executor plugin %s registered more than once", name)
+		}
+
+		plugins[name] = address
+	}
+
+	return plugins, nil
+}
@@ -0,0 +1,253 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression with the standard five fields:
+// minute, hour, day of month, month and day of week.
+type Schedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekDays uint64
+
+	//
+	// Like in cron, if both the day of month and the day of week are restricted,
+	// a day matches if any of them matches.
+	//
+	anyDay     bool
+	anyWeekDay bool
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField  = field{name: "minute", min: 0, max: 59}
+	hourField    = field{name: "hour", min: 0, max: 23}
+	dayField     = field{name: "day of month", min: 1, max: 31}
+	monthField   = field{name: "month", min: 1, max: 12, names: monthNames}
+	weekDayField = field{name: "day of week", min: 0, max: 7, names: weekDayNames}
+)
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekDayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedules that do not match any time in this many years never run,
+// e.g. "0 0 30 2 *".
+const maxYearsAhead = 5
+
+func Parse(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if d, ok := descriptors[expression]; ok {
+		expression = d
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	s := &Schedule{
+		anyDay:     fields[2] == "*",
+		anyWeekDay: fields[4] == "*",
+	}
+
+	var err error
+	if s.minutes, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+
+	if s.hours, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+
+	if s.days, err = parseField(fields[2], dayField); err != nil {
+		return nil, err
+	}
+
+	if s.months, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+
+	if s.weekDays, err = parseField(fields[4], weekDayField); err != nil {
+		return nil, err
+	}
+
+	// Sunday can be 0 or 7.
+	if s.weekDays&(1<<7) != 0 {
+		s.weekDays |= 1
+	}
+
+	return s, nil
+}
+
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		b, err := parsePart(part, f)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= b
+	}
+
+	return bits, nil
+}
+
+func parsePart(part string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		s, err := strconv.Atoi(stepPart)
+		if err != nil || s <= 0 {
+			return 0, fmt.Errorf("invalid step %q for %s", stepPart, f.name)
+		}
+
+		step = s
+	}
+
+	start, end := f.min, f.max
+	if rangePart != "*" {
+		from, to, isRange := strings.Cut(rangePart, "-")
+
+		var err error
+		if start, err = f.parseValue(from); err != nil {
+			return 0, err
+		}
+
+		end = start
+		if isRange {
+			if end, err = f.parseValue(to); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			end = f.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid range %q for %s", rangePart, f.name)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+
+	return bits, nil
+}
+
+func (f field) parseValue(value string) (int, error) {
+	if v, ok := f.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q for %s", value, f.name)
+	}
+
+	return v, nil
+}
+
+// Next returns the first time after the one given that matches the schedule,
+// in the location of the time given, or the zero time if there is none.
+// When clocks are turned back, the repeated hour only matches once,
+// and when they are turned forward, the skipped hour never matches.
+func (s *Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxYearsAhead, 0, 0)
+
+	for t.Before(limit) {
+		if s.months&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		//
+		// Moving to the next hour by adding minutes, instead of using time.Date(),
+		// avoids going back in time when the next hour is skipped or repeated
+		// because clocks are turned forward or back.
+		//
+		if s.hours&(1<<t.Hour()) == 0 {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+
+		if s.minutes&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		if repeatsWallClock(t) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// repeatsWallClock reports whether the wall clock time of t
+// already happened earlier, because clocks were turned back.
+func repeatsWallClock(t time.Time) bool {
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return false
+	}
+
+	_, offset := t.Zone()
+	_, previousOffset := start.Add(-time.Second).Zone()
+	if previousOffset <= offset {
+		return false
+	}
+
+	return t.Before(start.Add(time.Duration(previousOffset-offset) * time.Second))
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<t.Day()) != 0
+	weekDay := s.weekDays&(1<<int(t.Weekday())) != 0
+
+	if s.anyDay || s.anyWeekDay {
+		return day && weekDay
+	}
+
+	return day || weekDay
+}
@@ -0,0 +1,127 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Parse(t *testing.T) {
+	t.Run("valid expressions -> no error", func(t *testing.T) {
+		for _, expression := range []string{
+			"* * * * *",
+			"*/15 * * * *",
+			"0 2 * * mon-fri",
+			"30 8,12,18 1-15 jan,jul *",
+			"0 0 * * 7",
+			"@daily",
+		} {
+			_, err := Parse(expression)
+			assert.NoError(t, err, expression)
+		}
+	})
+
+	t.Run("invalid expressions -> error", func(t *testing.T) {
+		for _, expression := range []string{
+			"",
+			"* * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"*/0 * * * *",
+			"5-1 * * * *",
+			"* * * * funday",
+		} {
+			_, err := Parse(expression)
+			assert.Error(t, err, expression)
+		}
+	})
+}
+
+func Test__Next(t *testing.T) {
+	t.Run("every 15 minutes", func(t *testing.T) {
+		s, err := Parse("*/15 * * * *")
+		require.NoError(t, err)
+
+		after := time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC), s.Next(after))
+	})
+
+	t.Run("time that matches is not returned again", func(t *testing.T) {
+		s, err := Parse("0 2 * * *")
+		require.NoError(t, err)
+
+		after := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC), s.Next(after))
+	})
+
+	t.Run("week days only", func(t *testing.T) {
+		s, err := Parse("0 2 * * mon-fri")
+		require.NoError(t, err)
+
+		// Friday, 3rd of January of 2025
+		after := time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC), s.Next(after))
+	})
+
+	t.Run("day of month and day of week restricted -> any of them matches", func(t *testing.T) {
+		s, err := Parse("0 0 15 * sun")
+		require.NoError(t, err)
+
+		// Sunday, 5th of January of 2025
+		after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), s.Next(after))
+
+		after = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), s.Next(after))
+	})
+
+	t.Run("uses the location of the time given", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		s, err := Parse("0 2 * * *")
+		require.NoError(t, err)
+
+		after := time.Date(2025, 1, 1, 3, 0, 0, 0, loc)
+		next := s.Next(after)
+		assert.Equal(t, time.Date(2025, 1, 2, 2, 0, 0, 0, loc), next)
+		assert.Equal(t, time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC), next.UTC())
+	})
+
+	t.Run("clocks turned back -> repeated hour matches once", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		s, err := Parse("30 1 * * *")
+		require.NoError(t, err)
+
+		// Clocks are turned back from 2:00 EDT to 1:00 EST on the 2nd of November of 2025.
+		first := s.Next(time.Date(2025, 11, 2, 0, 0, 0, 0, loc))
+		assert.Equal(t, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), first.UTC())
+
+		second := s.Next(first)
+		assert.Equal(t, time.Date(2025, 11, 3, 6, 30, 0, 0, time.UTC), second.UTC())
+	})
+
+	t.Run("clocks turned forward -> skipped hour does not match", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		s, err := Parse("30 2 * * *")
+		require.NoError(t, err)
+
+		// Clocks are turned forward from 2:00 EST to 3:00 EDT on the 9th of March of 2025.
+		next := s.Next(time.Date(2025, 3, 9, 0, 0, 0, 0, loc))
+		assert.Equal(t, time.Date(2025, 3, 10, 6, 30, 0, 0, time.UTC), next.UTC())
+	})
+
+	t.Run("schedule that never matches -> zero time", func(t *testing.T) {
+		s, err := Parse("0 0 30 2 *")
+		require.NoError(t, err)
+		assert.True(t, s.Next(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero())
+	})
+}
@@ -43,7 +43,9 @@ func dbPoolSize() int {
 	return size
 }
 
-func connect() *gorm.DB {
+// DSN is the connection string for the database,
+// for the places that need a connection outside of gorm.
+func DSN() string {
 	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
 	sslMode := "disable"
 	if postgresDbSSL == "true" {
@@ -61,8 +63,10 @@ func connect() *gorm.DB {
 	}
 
 	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
-	dsn := fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+	return fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+}
 
+func connect() *gorm.DB {
 	logger := gormLogger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), gormLogger.Config{
 		SlowThreshold:             200 * time.Millisecond,
 		LogLevel:                  gormLogger.Warn,
@@ -70,7 +74,7 @@ func connect() *gorm.DB {
 		IgnoreRecordNotFoundError: true,
 	})
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger})
+	db, err := gorm.Open(postgres.Open(DSN()), &gorm.Config{Logger: logger})
 	if err != nil {
 		panic(err)
 	}
@@ -93,6 +97,7 @@ func TruncateTables() error {
 		stage_events, stage_event_approvals,
 		stage_connections, stage_executions,
 		secrets, account_providers, users, organizations,
-		casbin_rule;
+		casbin_rule, agents, agent_jobs, execution_logs,
+		outbox_messages;
 	`).Error
 }
@@ -0,0 +1,49 @@
+package executors
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// Cancel stops the execution in the executor that is running it.
+// If the execution was not started in the executor yet,
+// or the executor does not support cancellation, this is a no-op.
+func Cancel(encryptor crypto.Encryptor, stage *models.Stage, execution *models.StageExecution) error {
+	if execution.ReferenceID == "" {
+		return nil
+	}
+
+	inputMap, err := execution.GetInputs()
+	if err != nil {
+		return fmt.Errorf("error finding inputs for execution: %v", err)
+	}
+
+	secrets, err := stage.FindSecrets(encryptor)
+	if err != nil {
+		return fmt.Errorf("error finding secrets for execution: %v", err)
+	}
+
+	builder := SpecBuilder{}
+	spec, err := builder.Build(stage.ExecutorSpec.Data(), inputMap, secrets)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Cancelling never needs an execution token or to encrypt anything,
+	// so the executor is created without a JWT signer or encryptor.
+	//
+	executor, err := NewExecutor(spec.Type, *execution, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating executor: %v", err)
+	}
+
+	cancellable, ok := executor.(CancellableExecutor)
+	if !ok {
+		return nil
+	}
+
+	return cancellable.Cancel(*spec, execution.ReferenceID)
+}
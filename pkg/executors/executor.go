@@ -4,18 +4,36 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
 )
 
 var expressionRegex = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
 
+// Variables sent to the pipelines and workflows started by executors,
+// so they can identify the execution and push outputs for it.
+const (
+	StageIDVariable        = "SUPERPLANE_STAGE_ID"
+	ExecutionIDVariable    = "SUPERPLANE_EXECUTION_ID"
+	ExecutionTokenVariable = "SUPERPLANE_EXECUTION_TOKEN"
+)
+
 type Executor interface {
 	Name() string
 	Execute(models.ExecutorSpec) (Response, error)
 	Check(models.ExecutorSpec, string) (Response, error)
 }
 
+// Executors that can stop a running execution
+// on their side implement this interface too.
+// Executors used for cancelling are created without a JWT signer,
+// so Cancel must never need an execution token.
+type CancellableExecutor interface {
+	Executor
+	Cancel(models.ExecutorSpec, string) error
+}
+
 type Response interface {
 	Finished() bool
 	Successful() bool
@@ -23,12 +41,31 @@ type Response interface {
 	Id() string
 }
 
-func NewExecutor(specType string, execution models.StageExecution, jwtSigner *jwt.Signer) (Executor, error) {
+// Responses that describe what the executor did
+// implement this interface too. The logs are stored for the execution.
+type LoggingResponse interface {
+	Response
+	Logs() []models.ExecutionLog
+}
+
+func NewExecutor(specType string, execution models.StageExecution, jwtSigner *jwt.Signer, encryptor crypto.Encryptor) (Executor, error) {
 	switch specType {
 	case models.ExecutorSpecTypeSemaphore:
 		return NewSemaphoreExecutor(execution, jwtSigner)
 	case models.ExecutorSpecTypeHTTP:
 		return NewHTTPExecutor(execution, jwtSigner)
+	case models.ExecutorSpecTypeGitHub:
+		return NewGitHubExecutor(execution, jwtSigner)
+	case models.ExecutorSpecTypeGitLab:
+		return NewGitLabExecutor(execution, jwtSigner)
+	case models.ExecutorSpecTypeKubernetes:
+		return NewKubernetesExecutor(execution, jwtSigner, NewKubernetesClient)
+	case models.ExecutorSpecTypePlugin:
+		return NewPluginExecutor(execution, jwtSigner)
+	case models.ExecutorSpecTypeProcess:
+		return NewProcessExecutor(execution, jwtSigner, encryptor)
+	case models.ExecutorSpecTypeNoop:
+		return NewNoopExecutor(execution)
 	default:
 		return nil, fmt.Errorf("executor type %s not supported", specType)
 	}
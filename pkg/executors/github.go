@@ -0,0 +1,265 @@
+package executors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/apis/github"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+type GitHubExecutor struct {
+	execution models.StageExecution
+	jwtSigner *jwt.Signer
+}
+
+// Runs not found after this long, since the workflow was dispatched,
+// are considered failed, e.g. if the workflow run-name is wrong.
+const GitHubRunNotFoundTimeout = 10 * time.Minute
+
+// GitHub and Superplane clocks might not agree,
+// so runs are looked up a bit before the time they should be created.
+const gitHubClockSkew = 10 * time.Second
+
+// Workflow runs are not looked up in more pages than this.
+const gitHubMaxRunPages = 5
+
+type GitHubResponse struct {
+	id       string
+	run      *github.WorkflowRun
+	notFound bool
+}
+
+// The workflow dispatch API does not return the run it creates,
+// so the run might not be known yet when we check for it.
+func (r *GitHubResponse) Finished() bool {
+	if r.notFound {
+		return true
+	}
+
+	if r.run == nil {
+		return false
+	}
+
+	return r.run.Status == github.WorkflowRunStatusCompleted
+}
+
+// Since the workflow dispatch API does not return anything,
+// we use the execution ID as the unique identifier, if it is sent to the workflow,
+// and the ID of the last run before the workflow was dispatched otherwise.
+func (r *GitHubResponse) Id() string {
+	return r.id
+}
+
+func (r *GitHubResponse) Successful() bool {
+	if r.run == nil {
+		return false
+	}
+
+	return r.run.Conclusion == github.WorkflowRunConclusionSuccess
+}
+
+// Outputs for GitHub executions are sent via the /outputs API.
+func (r *GitHubResponse) Outputs() map[string]any {
+	return nil
+}
+
+func NewGitHubExecutor(execution models.StageExecution, jwtSigner *jwt.Signer) (*GitHubExecutor, error) {
+	return &GitHubExecutor{
+		execution: execution,
+		jwtSigner: jwtSigner,
+	}, nil
+}
+
+func (e *GitHubExecutor) Name() string {
+	return models.ExecutorSpecTypeGitHub
+}
+
+func (e *GitHubExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	inputs, err := e.buildInputs(spec.GitHub)
+	if err != nil {
+		return nil, fmt.Errorf("error building inputs: %v", err)
+	}
+
+	api := github.NewGitHubAPI(spec.GitHub.URL, spec.GitHub.Token)
+	lastRunID, err := e.lastRunID(api, spec.GitHub)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.DispatchWorkflow(spec.GitHub.Owner, spec.GitHub.Repository, spec.GitHub.Workflow, github.WorkflowDispatch{
+		Ref:    spec.GitHub.Ref,
+		Inputs: inputs,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.GitHub.ExecutionInputs {
+		return &GitHubResponse{id: e.execution.ID.String()}, nil
+	}
+
+	return &GitHubResponse{id: strconv.FormatInt(lastRunID, 10)}, nil
+}
+
+func (e *GitHubExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	api := github.NewGitHubAPI(spec.GitHub.URL, spec.GitHub.Token)
+	run, err := e.findRun(api, spec.GitHub, id)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Runs not found for too long, e.g. because the workflow does not
+	// include the execution ID in its run-name, fail the execution.
+	//
+	startedAt := e.execution.StartedAt
+	if run == nil && startedAt != nil && time.Since(*startedAt) > GitHubRunNotFoundTimeout {
+		return &GitHubResponse{id: id, notFound: true}, nil
+	}
+
+	return &GitHubResponse{id: id, run: run}, nil
+}
+
+func (e *GitHubExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	api := github.NewGitHubAPI(spec.GitHub.URL, spec.GitHub.Token)
+	run, err := e.findRun(api, spec.GitHub, id)
+	if err != nil {
+		return err
+	}
+
+	//
+	// If the run is not there yet, or is already finished,
+	// there is nothing for us to stop.
+	//
+	if run == nil || run.Status == github.WorkflowRunStatusCompleted {
+		return nil
+	}
+
+	err = api.CancelWorkflowRun(spec.GitHub.Owner, spec.GitHub.Repository, run.ID)
+	if err != nil {
+		return fmt.Errorf("error cancelling workflow run %d: %v", run.ID, err)
+	}
+
+	return nil
+}
+
+// If the execution inputs are sent, the workflow run for an execution
+// is found by its title, which must include the SUPERPLANE_EXECUTION_ID input,
+// through the `run-name` field in the workflow file. Otherwise, it is the first run
+// for the ref after the last run that existed when the workflow was dispatched.
+func (e *GitHubExecutor) findRun(api *github.GitHub, spec *models.GitHubExecutorSpec, id string) (*github.WorkflowRun, error) {
+	var lastRunID int64
+	if !spec.ExecutionInputs {
+		var err error
+		lastRunID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workflow run reference %s: %v", id, err)
+		}
+	}
+
+	//
+	// The execution is created before the workflow is dispatched,
+	// so no run for it can be created before that.
+	//
+	var createdAfter time.Time
+	if e.execution.CreatedAt != nil {
+		createdAfter = e.execution.CreatedAt.Add(-gitHubClockSkew)
+	}
+
+	var found *github.WorkflowRun
+	for page := 1; page <= gitHubMaxRunPages; page++ {
+		runs, err := api.ListWorkflowRuns(spec.Owner, spec.Repository, spec.Workflow, github.ListWorkflowRunsOptions{
+			Event:        "workflow_dispatch",
+			CreatedAfter: createdAfter,
+			Page:         page,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing workflow runs: %v", err)
+		}
+
+		for _, run := range runs {
+			r := run
+			if spec.ExecutionInputs {
+				if strings.Contains(r.DisplayTitle, id) {
+					return &r, nil
+				}
+
+				continue
+			}
+
+			//
+			// Runs are listed newest first,
+			// so we keep going until we reach the runs from before the dispatch.
+			//
+			if r.ID <= lastRunID {
+				return found, nil
+			}
+
+			if r.HeadBranch == gitHubRefName(spec.Ref) {
+				found = &r
+			}
+		}
+
+		if len(runs) < github.WorkflowRunsPerPage {
+			break
+		}
+	}
+
+	return found, nil
+}
+
+func (e *GitHubExecutor) lastRunID(api *github.GitHub, spec *models.GitHubExecutorSpec) (int64, error) {
+	if spec.ExecutionInputs {
+		return 0, nil
+	}
+
+	runs, err := api.ListWorkflowRuns(spec.Owner, spec.Repository, spec.Workflow, github.ListWorkflowRunsOptions{
+		Event: "workflow_dispatch",
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("error listing workflow runs: %v", err)
+	}
+
+	var lastRunID int64
+	for _, run := range runs {
+		lastRunID = max(lastRunID, run.ID)
+	}
+
+	return lastRunID, nil
+}
+
+func gitHubRefName(ref string) string {
+	if name, ok := strings.CutPrefix(ref, "refs/heads/"); ok {
+		return name
+	}
+
+	return strings.TrimPrefix(ref, "refs/tags/")
+}
+
+func (e *GitHubExecutor) buildInputs(spec *models.GitHubExecutorSpec) (map[string]string, error) {
+	values := map[string]string{}
+	for key, value := range spec.Inputs {
+		values[key] = value
+	}
+
+	if !spec.ExecutionInputs {
+		return values, nil
+	}
+
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	values[StageIDVariable] = e.execution.StageID.String()
+	values[ExecutionIDVariable] = e.execution.ID.String()
+	values[ExecutionTokenVariable] = token
+	return values, nil
+}
@@ -0,0 +1,226 @@
+package executors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/github"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	githubmock "github.com/superplanehq/superplane/test/github"
+)
+
+func Test_GitHub(t *testing.T) {
+	mock := githubmock.NewGitHubAPIMock()
+	mock.Init()
+	defer mock.Close()
+
+	signer := jwt.NewSigner("test")
+	spec := models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeGitHub,
+		GitHub: &models.GitHubExecutorSpec{
+			URL:             mock.Server.URL,
+			Token:           "token",
+			Owner:           "superplanehq",
+			Repository:      "superplane",
+			Workflow:        "deploy.yml",
+			Ref:             "main",
+			Inputs:          map[string]string{"VERSION": "v1"},
+			ExecutionInputs: true,
+		},
+	}
+
+	t.Run("workflow is dispatched with inputs and execution token", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.Equal(t, execution.ID.String(), response.Id())
+
+		require.NotNil(t, mock.LastDispatch)
+		assert.Equal(t, "main", mock.LastDispatch.Ref)
+		assert.Equal(t, "v1", mock.LastDispatch.Inputs["VERSION"])
+		assert.Equal(t, execution.StageID.String(), mock.LastDispatch.Inputs[StageIDVariable])
+		assert.Equal(t, execution.ID.String(), mock.LastDispatch.Inputs[ExecutionIDVariable])
+		require.NoError(t, signer.Validate(mock.LastDispatch.Inputs[ExecutionTokenVariable], execution.ID.String()))
+	})
+
+	t.Run("run not completed -> not finished", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("run not found -> not finished", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Check(spec, execution.ID.String())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("successful run -> finished and successful", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		mock.FinishRun(execution.ID.String(), github.WorkflowRunConclusionSuccess)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+
+	t.Run("failed run -> finished and not successful", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		mock.FinishRun(execution.ID.String(), github.WorkflowRunConclusionFailure)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("running run is cancelled", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		require.NoError(t, executor.Cancel(spec, response.Id()))
+		assert.Len(t, mock.CancelledRuns, 1)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+
+		//
+		// Cancelling a completed run does nothing.
+		//
+		require.NoError(t, executor.Cancel(spec, response.Id()))
+		assert.Len(t, mock.CancelledRuns, 1)
+	})
+	t.Run("run is found in later pages", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		mock.FinishRun(execution.ID.String(), github.WorkflowRunConclusionSuccess)
+
+		for i := 0; i < github.WorkflowRunsPerPage; i++ {
+			mock.AddRun(github.WorkflowRun{
+				DisplayTitle: fmt.Sprintf("Execution %s", uuid.NewString()),
+				Event:        "workflow_dispatch",
+				HeadBranch:   "main",
+				Status:       "queued",
+				CreatedAt:    time.Now().UTC(),
+			})
+		}
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+
+	t.Run("run not found for too long -> finished and not successful", func(t *testing.T) {
+		startedAt := time.Now().Add(-GitHubRunNotFoundTimeout - time.Minute)
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New(), StartedAt: &startedAt}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Check(spec, execution.ID.String())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("no execution inputs -> only configured inputs are sent", func(t *testing.T) {
+		spec := *spec.GitHub
+		spec.ExecutionInputs = false
+		executorSpec := models.ExecutorSpec{Type: models.ExecutorSpecTypeGitHub, GitHub: &spec}
+
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		_, err = executor.Execute(executorSpec)
+		require.NoError(t, err)
+		require.NotNil(t, mock.LastDispatch)
+		assert.Equal(t, map[string]string{"VERSION": "v1"}, mock.LastDispatch.Inputs)
+	})
+
+	t.Run("no execution inputs -> first run for the ref after the dispatch is used", func(t *testing.T) {
+		spec := *spec.GitHub
+		spec.ExecutionInputs = false
+		executorSpec := models.ExecutorSpec{Type: models.ExecutorSpecTypeGitHub, GitHub: &spec}
+
+		//
+		// A run created before the dispatch is not used.
+		//
+		mock.AddRun(github.WorkflowRun{
+			Event:      "workflow_dispatch",
+			HeadBranch: "main",
+			Status:     "queued",
+			CreatedAt:  time.Now().UTC().Add(-time.Hour),
+		})
+
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitHubExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(executorSpec)
+		require.NoError(t, err)
+		mock.FinishLastRun(github.WorkflowRunConclusionSuccess)
+
+		//
+		// Runs for other refs, or created after ours, are not used.
+		//
+		mock.AddRun(github.WorkflowRun{
+			Event:      "workflow_dispatch",
+			HeadBranch: "other",
+			Status:     "queued",
+			CreatedAt:  time.Now().UTC(),
+		})
+
+		mock.AddRun(github.WorkflowRun{
+			Event:      "workflow_dispatch",
+			HeadBranch: "main",
+			Status:     "queued",
+			CreatedAt:  time.Now().UTC(),
+		})
+
+		response, err = executor.Check(executorSpec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+}
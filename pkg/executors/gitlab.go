@@ -0,0 +1,130 @@
+package executors
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/apis/gitlab"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+type GitLabExecutor struct {
+	execution models.StageExecution
+	jwtSigner *jwt.Signer
+}
+
+type GitLabResponse struct {
+	pipeline *gitlab.Pipeline
+}
+
+// The pipeline is not finished after the API call that creates it,
+// so we need to monitor its status.
+func (r *GitLabResponse) Finished() bool {
+	return r.pipeline.Finished()
+}
+
+// The API call to create a pipeline gives back the pipeline ID,
+// so we use that ID as the unique identifier here.
+func (r *GitLabResponse) Id() string {
+	return strconv.FormatInt(r.pipeline.ID, 10)
+}
+
+func (r *GitLabResponse) Successful() bool {
+	return r.pipeline.Status == gitlab.PipelineStatusSuccess
+}
+
+// Outputs for GitLab executions are sent via the /outputs API.
+func (r *GitLabResponse) Outputs() map[string]any {
+	return nil
+}
+
+func NewGitLabExecutor(execution models.StageExecution, jwtSigner *jwt.Signer) (*GitLabExecutor, error) {
+	return &GitLabExecutor{
+		execution: execution,
+		jwtSigner: jwtSigner,
+	}, nil
+}
+
+func (e *GitLabExecutor) Name() string {
+	return models.ExecutorSpecTypeGitLab
+}
+
+func (e *GitLabExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	variables, err := e.buildVariables(spec.GitLab.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("error building variables: %v", err)
+	}
+
+	api := gitlab.NewGitLabAPI(spec.GitLab.URL, spec.GitLab.Token)
+	pipeline, err := api.CreatePipeline(spec.GitLab.ProjectID, gitlab.CreatePipelineRequest{
+		Ref:       spec.GitLab.Ref,
+		Variables: variables,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitLabResponse{pipeline: pipeline}, nil
+}
+
+func (e *GitLabExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	api := gitlab.NewGitLabAPI(spec.GitLab.URL, spec.GitLab.Token)
+	pipeline, err := api.GetPipeline(spec.GitLab.ProjectID, id)
+	if err != nil {
+		return nil, fmt.Errorf("pipeline %s not found: %v", id, err)
+	}
+
+	return &GitLabResponse{pipeline: pipeline}, nil
+}
+
+func (e *GitLabExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	api := gitlab.NewGitLabAPI(spec.GitLab.URL, spec.GitLab.Token)
+	_, err := api.CancelPipeline(spec.GitLab.ProjectID, id)
+	if err != nil {
+		return fmt.Errorf("error cancelling pipeline %s: %v", id, err)
+	}
+
+	return nil
+}
+
+func (e *GitLabExecutor) buildVariables(variables map[string]string) ([]gitlab.PipelineVariable, error) {
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	values := map[string]string{
+		StageIDVariable:        e.execution.StageID.String(),
+		ExecutionIDVariable:    e.execution.ID.String(),
+		ExecutionTokenVariable: token,
+	}
+
+	for key, value := range variables {
+		values[key] = value
+	}
+
+	//
+	// Sorting the variables keeps the request body stable.
+	//
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pipelineVariables := make([]gitlab.PipelineVariable, 0, len(keys))
+	for _, key := range keys {
+		pipelineVariables = append(pipelineVariables, gitlab.PipelineVariable{
+			Key:          key,
+			Value:        values[key],
+			VariableType: gitlab.VariableTypeEnvVar,
+		})
+	}
+
+	return pipelineVariables, nil
+}
@@ -0,0 +1,119 @@
+package executors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/gitlab"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	gitlabmock "github.com/superplanehq/superplane/test/gitlab"
+)
+
+func Test_GitLab(t *testing.T) {
+	mock := gitlabmock.NewGitLabAPIMock()
+	mock.Init()
+	defer mock.Close()
+
+	signer := jwt.NewSigner("test")
+	spec := models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeGitLab,
+		GitLab: &models.GitLabExecutorSpec{
+			URL:       mock.Server.URL,
+			Token:     "token",
+			ProjectID: "superplanehq/superplane",
+			Ref:       "main",
+			Variables: map[string]string{"VERSION": "v1"},
+		},
+	}
+
+	t.Run("pipeline is created with variables and execution token", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewGitLabExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.NotEmpty(t, response.Id())
+
+		require.NotNil(t, mock.LastPipelineRequest)
+		assert.Equal(t, "main", mock.LastPipelineRequest.Ref)
+		assert.Contains(t, mock.LastProjectPath, "superplanehq%2Fsuperplane")
+
+		variables := map[string]string{}
+		for _, v := range mock.LastPipelineRequest.Variables {
+			variables[v.Key] = v.Value
+		}
+
+		assert.Equal(t, "v1", variables["VERSION"])
+		assert.Equal(t, execution.StageID.String(), variables[StageIDVariable])
+		assert.Equal(t, execution.ID.String(), variables[ExecutionIDVariable])
+		require.NoError(t, signer.Validate(variables[ExecutionTokenVariable], execution.ID.String()))
+	})
+
+	t.Run("running pipeline -> not finished", func(t *testing.T) {
+		executor, err := NewGitLabExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("successful pipeline -> finished and successful", func(t *testing.T) {
+		executor, err := NewGitLabExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		mock.SetPipelineStatus(response.(*GitLabResponse).pipeline.ID, gitlab.PipelineStatusSuccess)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+
+	t.Run("failed pipeline -> finished and not successful", func(t *testing.T) {
+		executor, err := NewGitLabExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		mock.SetPipelineStatus(response.(*GitLabResponse).pipeline.ID, gitlab.PipelineStatusFailed)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("pipeline that does not exist -> error", func(t *testing.T) {
+		executor, err := NewGitLabExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		_, err = executor.Check(spec, "1000")
+		require.ErrorContains(t, err, "pipeline 1000 not found")
+	})
+
+	t.Run("pipeline is cancelled", func(t *testing.T) {
+		executor, err := NewGitLabExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		require.NoError(t, executor.Cancel(spec, response.Id()))
+		assert.Contains(t, mock.CancelledPipelines, response.(*GitLabResponse).pipeline.ID)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+}
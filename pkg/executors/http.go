@@ -2,17 +2,43 @@ package executors
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"net/url"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
 )
 
-const MaxHTTPResponseSize = 8 * 1024
+const MaxHTTPResponseSize = 64 * 1024
+
+// Only the beginning of the response body goes into the execution logs.
+const MaxHTTPLogBodySize = 1024
+
+// Placeholder for the execution reference ID in the status URL.
+const HTTPStatusURLIDPlaceholder = "{id}"
+
+// Headers used to send the execution ID and token in callback mode.
+const (
+	HTTPExecutionIDHeader    = "X-Superplane-Execution-Id"
+	HTTPExecutionTokenHeader = "X-Superplane-Execution-Token"
+)
+
+var HTTPMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
 
 type HTTPExecutor struct {
 	execution models.StageExecution
@@ -23,6 +49,8 @@ type HTTPResponse struct {
 	statusCode   int
 	body         []byte
 	allowedCodes []uint32
+	outputs      map[string]any
+	logs         []models.ExecutionLog
 }
 
 func (r *HTTPResponse) Finished() bool {
@@ -37,20 +65,79 @@ func (r *HTTPResponse) Id() string {
 	return ""
 }
 
+func (r *HTTPResponse) Logs() []models.ExecutionLog {
+	return r.logs
+}
+
+// If the spec defines output expressions, the outputs are extracted with them.
+// Otherwise, we use the top-level outputs field from the JSON response body.
 func (r *HTTPResponse) Outputs() map[string]any {
+	if r.outputs != nil {
+		return r.outputs
+	}
+
 	var response map[string]any
 	err := json.Unmarshal(r.body, &response)
 	if err != nil {
 		return map[string]any{}
 	}
 
-	if v, ok := response["outputs"]; ok {
-		if outputs, ok := v.(map[string]any); ok {
-			return outputs
+	v, ok := response["outputs"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	outputs := make(map[string]any, len(v))
+	for name, value := range v {
+		if value == nil {
+			continue
+		}
+
+		output, err := httpOutputValue(value)
+		if err == nil {
+			outputs[name] = output
 		}
 	}
 
-	return nil
+	return outputs
+}
+
+// HTTPAsyncResponse is used when the HTTP executor is in polling or callback mode.
+// The initial request only starts the execution, so the response
+// is not finished unless the request itself was not accepted.
+type HTTPAsyncResponse struct {
+	id         string
+	finished   bool
+	successful bool
+	outputs    map[string]any
+	logs       []models.ExecutionLog
+}
+
+func (r *HTTPAsyncResponse) Finished() bool {
+	return r.finished
+}
+
+func (r *HTTPAsyncResponse) Successful() bool {
+	return r.successful
+}
+
+func (r *HTTPAsyncResponse) Id() string {
+	return r.id
+}
+
+func (r *HTTPAsyncResponse) Outputs() map[string]any {
+	return r.outputs
+}
+
+func (r *HTTPAsyncResponse) Logs() []models.ExecutionLog {
+	return r.logs
+}
+
+// httpResult holds what we read from an HTTP response.
+type httpResult struct {
+	statusCode int
+	headers    http.Header
+	body       []byte
 }
 
 func NewHTTPExecutor(execution models.StageExecution, jwtSigner *jwt.Signer) (*HTTPExecutor, error) {
@@ -65,17 +152,71 @@ func (e *HTTPExecutor) Name() string {
 }
 
 func (e *HTTPExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
-	payload, err := e.buildPayload(spec.HTTP)
+	req, err := e.buildRequest(spec.HTTP)
 	if err != nil {
-		return nil, fmt.Errorf("error building parameters: %v", err)
+		return nil, fmt.Errorf("error building request: %v", err)
 	}
 
-	data, err := json.Marshal(payload)
+	result, err := e.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := summarizeHTTPRequest(req, result)
+	response := &HTTPResponse{
+		statusCode:   result.statusCode,
+		allowedCodes: spec.HTTP.ResponsePolicy.StatusCodes,
+		body:         result.body,
+		logs:         logs,
+	}
+
+	if !spec.HTTP.IsAsync() {
+		if response.Successful() && len(spec.HTTP.Outputs) > 0 {
+			response.outputs, err = extractHTTPOutputs(spec.HTTP.Outputs, result)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return response, nil
+	}
+
+	//
+	// If the remote system did not accept the request,
+	// there is nothing to wait for, so the execution fails right away.
+	//
+	if !response.Successful() {
+		return &HTTPAsyncResponse{finished: true, successful: false, logs: logs}, nil
+	}
+
+	//
+	// In callback mode, the remote system reports back using the execution ID,
+	// so that is what we use as the reference for the execution.
+	//
+	if spec.HTTP.Mode == models.HTTPExecutorModeCallback {
+		return &HTTPAsyncResponse{id: e.execution.ID.String(), logs: logs}, nil
+	}
+
+	id, err := e.extractID(spec.HTTP.StatusPolicy, result)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, spec.HTTP.URL, bytes.NewReader(data))
+	return &HTTPAsyncResponse{id: id, logs: logs}, nil
+}
+
+func (e *HTTPExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	//
+	// In callback mode, the execution is finished
+	// when the remote system calls back, so we just keep waiting here.
+	//
+	if spec.HTTP.Mode != models.HTTPExecutorModePolling {
+		return &HTTPAsyncResponse{id: id}, nil
+	}
+
+	policy := spec.HTTP.StatusPolicy
+	statusURL := strings.ReplaceAll(policy.URL, HTTPStatusURLIDPlaceholder, url.PathEscape(id))
+	req, err := http.NewRequest(http.MethodGet, statusURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +225,162 @@ func (e *HTTPExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
 		req.Header.Set(k, v)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	result, err := e.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.statusCode < http.StatusOK || result.statusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("status request for %s returned %d", id, result.statusCode)
+	}
+
+	failed, err := evaluateHTTPBooleanExpression(policy.FailureExpression, result)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating failure expression: %v", err)
+	}
+
+	passed := false
+	if !failed {
+		passed, err = evaluateHTTPBooleanExpression(policy.SuccessExpression, result)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating success expression: %v", err)
+		}
+	}
+
+	if !failed && !passed {
+		return &HTTPAsyncResponse{id: id}, nil
+	}
+
+	//
+	// The outputs are extracted from the last status response.
+	//
+	outputs := (&HTTPResponse{body: result.body}).Outputs()
+	if len(spec.HTTP.Outputs) > 0 {
+		outputs, err = extractHTTPOutputs(spec.HTTP.Outputs, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &HTTPAsyncResponse{
+		id:         id,
+		finished:   true,
+		successful: passed,
+		outputs:    outputs,
+		logs:       summarizeHTTPRequest(req, result),
+	}, nil
+}
+
+func (e *HTTPExecutor) buildRequest(spec *models.HTTPExecutorSpec) (*http.Request, error) {
+	method := spec.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	URL, err := url.Parse(spec.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %v", err)
+	}
+
+	if len(spec.QueryParams) > 0 {
+		query := URL.Query()
+		for k, v := range spec.QueryParams {
+			query.Set(k, v)
+		}
+
+		URL.RawQuery = query.Encode()
+	}
+
+	body, contentType, err := e.buildBody(method, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, URL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	//
+	// In callback mode, the remote system needs a token
+	// to report the result of the execution back to us.
+	// We send it in the headers too, since custom bodies might not include it.
+	//
+	if spec.Mode == models.HTTPExecutorModeCallback {
+		token, err := e.executionToken()
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set(HTTPExecutionIDHeader, e.execution.ID.String())
+		req.Header.Set(HTTPExecutionTokenHeader, token)
+	}
+
+	for k, v := range spec.Headers {
+		req.Header.Set(k, v)
+	}
+
+	return req, nil
+}
+
+// If a body is specified, it is sent as is, after its expressions are resolved.
+// Bodies are JSON unless the spec sets another Content-Type header,
+// so a JSON body that is not valid is an error, and is never sent with another content type.
+// Otherwise, requests with methods that take a body receive the JSON payload.
+func (e *HTTPExecutor) buildBody(method string, spec *models.HTTPExecutorSpec) (io.Reader, string, error) {
+	if spec.Body != "" {
+		if !HTTPBodyIsJSON(spec) {
+			return strings.NewReader(spec.Body), "", nil
+		}
+
+		if !json.Valid([]byte(spec.Body)) {
+			return nil, "", fmt.Errorf("body is not valid JSON - set a Content-Type header to send other formats")
+		}
+
+		return strings.NewReader(spec.Body), "application/json", nil
+	}
+
+	if method == http.MethodGet || method == http.MethodDelete {
+		return nil, "", nil
+	}
+
+	payload, err := e.buildPayload(spec)
+	if err != nil {
+		return nil, "", fmt.Errorf("error building payload: %v", err)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return bytes.NewReader(data), "application/json", nil
+}
+
+// HTTPBodyIsJSON returns true if the spec does not set a Content-Type header,
+// or sets a JSON one.
+func HTTPBodyIsJSON(spec *models.HTTPExecutorSpec) bool {
+	for k, v := range spec.Headers {
+		if !strings.EqualFold(k, "Content-Type") {
+			continue
+		}
+
+		mediaType, _, err := mime.ParseMediaType(v)
+		if err != nil {
+			return false
+		}
+
+		return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+	}
+
+	return true
+}
+
+func (e *HTTPExecutor) do(req *http.Request) (*httpResult, error) {
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -98,15 +394,64 @@ func (e *HTTPExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
 		return nil, fmt.Errorf("error reading response: %v", err)
 	}
 
-	return &HTTPResponse{
-		statusCode:   res.StatusCode,
-		allowedCodes: spec.HTTP.ResponsePolicy.StatusCodes,
-		body:         body,
+	return &httpResult{
+		statusCode: res.StatusCode,
+		headers:    res.Header,
+		body:       body,
 	}, nil
 }
 
-func (e *HTTPExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
-	return nil, nil
+// The query string is left out of the summary, since it might include secrets.
+// For the same reason, request headers and bodies are not included.
+func summarizeHTTPRequest(req *http.Request, result *httpResult) []models.ExecutionLog {
+	URL := *req.URL
+	URL.RawQuery = ""
+	URL.User = nil
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "%s %s\n", req.Method, URL.String())
+	fmt.Fprintf(&summary, "Response: %d %s\n", result.statusCode, http.StatusText(result.statusCode))
+
+	if len(result.body) > MaxHTTPLogBodySize {
+		fmt.Fprintf(&summary, "%s... (%d bytes)\n", result.body[:MaxHTTPLogBodySize], len(result.body))
+	} else if len(result.body) > 0 {
+		fmt.Fprintf(&summary, "%s\n", result.body)
+	}
+
+	return []models.ExecutionLog{
+		{Stream: models.ExecutionLogStreamSystem, Content: summary.String()},
+	}
+}
+
+func (e *HTTPExecutor) extractID(policy *models.HTTPStatusPolicy, result *httpResult) (string, error) {
+	output, err := evaluateHTTPExpression(policy.IDExpression, result)
+	if err != nil {
+		return "", fmt.Errorf("error evaluating ID expression: %v", err)
+	}
+
+	if output == nil {
+		return "", fmt.Errorf("ID expression returned no value")
+	}
+
+	id := fmt.Sprintf("%v", output)
+	if id == "" {
+		return "", fmt.Errorf("ID expression returned an empty value")
+	}
+
+	return id, nil
+}
+
+func (e *HTTPExecutor) executionToken() (string, error) {
+	if e.jwtSigner == nil {
+		return "", fmt.Errorf("no signer for execution token")
+	}
+
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return "", fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	return token, nil
 }
 
 func (e *HTTPExecutor) buildPayload(spec *models.HTTPExecutorSpec) (map[string]string, error) {
@@ -115,9 +460,123 @@ func (e *HTTPExecutor) buildPayload(spec *models.HTTPExecutorSpec) (map[string]s
 		"executionId": e.execution.ID.String(),
 	}
 
+	if spec.Mode == models.HTTPExecutorModeCallback {
+		token, err := e.executionToken()
+		if err != nil {
+			return nil, err
+		}
+
+		payload["executionToken"] = token
+	}
+
 	for key, value := range spec.Payload {
 		payload[key] = value
 	}
 
 	return payload, nil
 }
+
+// Outputs with expressions that do not return anything are not included,
+// so missing required outputs fail the execution.
+func extractHTTPOutputs(definitions []models.HTTPOutput, result *httpResult) (map[string]any, error) {
+	outputs := map[string]any{}
+	for _, definition := range definitions {
+		value, err := evaluateHTTPExpression(definition.Expression, result)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating expression for output %s: %v", definition.Name, err)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		output, err := httpOutputValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("error converting output %s: %v", definition.Name, err)
+		}
+
+		outputs[definition.Name] = output
+	}
+
+	return outputs, nil
+}
+
+// Outputs are always strings, like the ones pushed through the outputs API,
+// so numbers, booleans, lists and objects are stored as JSON.
+func httpOutputValue(value any) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// The expressions used by the HTTP executor can use the response body, headers and status code.
+// The body is parsed as JSON if possible, and used as a string otherwise.
+// Header names are lowercased.
+func httpExpressionVariables(result *httpResult) map[string]any {
+	var body any
+	if len(result.body) > 0 {
+		if err := json.Unmarshal(result.body, &body); err != nil {
+			body = string(result.body)
+		}
+	}
+
+	headers := map[string]string{}
+	for k := range result.headers {
+		headers[strings.ToLower(k)] = result.headers.Get(k)
+	}
+
+	return map[string]any{
+		"body":    body,
+		"headers": headers,
+		"status":  result.statusCode,
+	}
+}
+
+func evaluateHTTPExpression(expression string, result *httpResult, options ...expr.Option) (any, error) {
+	//
+	// We don't want the expression to run for more than 5 seconds.
+	//
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	variables := httpExpressionVariables(result)
+	variables["ctx"] = ctx
+
+	options = append(options, expr.Env(variables), expr.WithContext("ctx"))
+	program, err := expr.Compile(expression, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling expression: %v", err)
+	}
+
+	output, err := expr.Run(program, variables)
+	if err != nil {
+		return nil, fmt.Errorf("error running expression: %v", err)
+	}
+
+	return output, nil
+}
+
+func evaluateHTTPBooleanExpression(expression string, result *httpResult) (bool, error) {
+	if expression == "" {
+		return false, nil
+	}
+
+	output, err := evaluateHTTPExpression(expression, result, expr.AsBool())
+	if err != nil {
+		return false, err
+	}
+
+	v, ok := output.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression does not return a boolean")
+	}
+
+	return v, nil
+}
@@ -2,6 +2,7 @@ package executors
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
 )
 
@@ -161,3 +163,353 @@ func Test_HTTP(t *testing.T) {
 		assert.Equal(t, map[string]any{"foo": "bar"}, response.Outputs())
 	})
 }
+
+func Test_HTTP_Requests(t *testing.T) {
+	execution := models.StageExecution{
+		ID:      uuid.New(),
+		StageID: uuid.New(),
+	}
+
+	executor, err := NewHTTPExecutor(execution, nil)
+	require.NoError(t, err)
+
+	t.Run("method and query params are used", func(t *testing.T) {
+		var method, query, body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			method = r.Method
+			query = r.URL.RawQuery
+			body = string(b)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		defer server.Close()
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL:         server.URL + "?a=1",
+				Method:      http.MethodGet,
+				QueryParams: map[string]string{"b": "2"},
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, response.Successful())
+		assert.Equal(t, http.MethodGet, method)
+		assert.Equal(t, "a=1&b=2", query)
+		assert.Empty(t, body)
+	})
+
+	t.Run("request and response are summarized in the logs", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"ok": true}` + strings.Repeat(" ", MaxHTTPLogBodySize)))
+		}))
+
+		defer server.Close()
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL:         server.URL + "/deploy",
+				Method:      http.MethodPut,
+				QueryParams: map[string]string{"token": "secret"},
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{202},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		r, ok := response.(LoggingResponse)
+		require.True(t, ok)
+		require.Len(t, r.Logs(), 1)
+
+		log := r.Logs()[0]
+		assert.Equal(t, models.ExecutionLogStreamSystem, log.Stream)
+		assert.True(t, strings.HasPrefix(log.Content, "PUT "+server.URL+"/deploy\nResponse: 202 Accepted\n{\"ok\": true}"))
+		assert.True(t, strings.HasSuffix(log.Content, fmt.Sprintf("... (%d bytes)\n", MaxHTTPLogBodySize+12)))
+		assert.NotContains(t, log.Content, "secret")
+	})
+
+	t.Run("JSON body is sent as is", func(t *testing.T) {
+		var contentType, body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			contentType = r.Header.Get("Content-Type")
+			body = string(b)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		defer server.Close()
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL:    server.URL,
+				Method: http.MethodPut,
+				Body:   `{"items": [1, 2], "name": "v1"}`,
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, response.Successful())
+		assert.Equal(t, "application/json", contentType)
+		assert.Equal(t, `{"items": [1, 2], "name": "v1"}`, body)
+	})
+
+	t.Run("text body with a Content-Type header is sent as is", func(t *testing.T) {
+		var contentType, body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			contentType = r.Header.Get("Content-Type")
+			body = string(b)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		defer server.Close()
+
+		_, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL:     server.URL,
+				Body:    "deploy v1",
+				Headers: map[string]string{"content-type": "text/plain; charset=utf-8"},
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain; charset=utf-8", contentType)
+		assert.Equal(t, "deploy v1", body)
+	})
+
+	t.Run("body that is not valid JSON and no Content-Type header -> error", func(t *testing.T) {
+		_, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL:  "http://localhost:1",
+				Body: "deploy v1",
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+			},
+		})
+
+		require.ErrorContains(t, err, "body is not valid JSON")
+	})
+
+	t.Run("outputs are extracted from body and headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-1")
+			w.Write([]byte(`{"release": {"version": "v1.2.0", "build": 42, "stable": true, "tags": ["a", "b"]}}`))
+		}))
+
+		defer server.Close()
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL: server.URL,
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+				Outputs: []models.HTTPOutput{
+					{Name: "VERSION", Expression: "body.release.version"},
+					{Name: "REQUEST_ID", Expression: `headers["x-request-id"]`},
+					{Name: "MISSING", Expression: "body.nope"},
+					{Name: "BUILD", Expression: "body.release.build"},
+					{Name: "STABLE", Expression: "body.release.stable"},
+					{Name: "TAGS", Expression: "body.release.tags"},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, response.Successful())
+		assert.Equal(t, map[string]any{
+			"VERSION":    "v1.2.0",
+			"REQUEST_ID": "req-1",
+			"BUILD":      "42",
+			"STABLE":     "true",
+			"TAGS":       `["a","b"]`,
+		}, response.Outputs())
+	})
+
+	t.Run("outputs are not extracted from unsuccessful responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`internal error`))
+		}))
+
+		defer server.Close()
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			HTTP: &models.HTTPExecutorSpec{
+				URL: server.URL,
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+				Outputs: []models.HTTPOutput{
+					{Name: "VERSION", Expression: "body.release.version"},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, response.Successful())
+		assert.Empty(t, response.Outputs())
+	})
+}
+
+func Test_HTTP_Polling(t *testing.T) {
+	execution := models.StageExecution{
+		ID:      uuid.New(),
+		StageID: uuid.New(),
+	}
+
+	state := "running"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/jobs":
+			w.Write([]byte(`{"job": {"id": "job-1"}}`))
+		case "/jobs/job-1":
+			w.Write([]byte(`{"state": "` + state + `", "outputs": {"version": "v1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer server.Close()
+
+	spec := models.ExecutorSpec{
+		HTTP: &models.HTTPExecutorSpec{
+			URL:  server.URL + "/jobs",
+			Mode: models.HTTPExecutorModePolling,
+			ResponsePolicy: &models.HTTPResponsePolicy{
+				StatusCodes: []uint32{200},
+			},
+			StatusPolicy: &models.HTTPStatusPolicy{
+				IDExpression:      "body.job.id",
+				URL:               server.URL + "/jobs/{id}",
+				SuccessExpression: `body.state == "passed"`,
+				FailureExpression: `body.state == "failed"`,
+			},
+		},
+	}
+
+	executor, err := NewHTTPExecutor(execution, nil)
+	require.NoError(t, err)
+
+	t.Run("execute returns ID from response", func(t *testing.T) {
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.Equal(t, "job-1", response.Id())
+	})
+
+	t.Run("request not accepted -> finished and not successful", func(t *testing.T) {
+		s := spec
+		httpSpec := *spec.HTTP
+		httpSpec.URL = server.URL + "/not-found"
+		s.HTTP = &httpSpec
+
+		response, err := executor.Execute(s)
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("ID expression does not match -> error", func(t *testing.T) {
+		s := spec
+		httpSpec := *spec.HTTP
+		policy := *spec.HTTP.StatusPolicy
+		policy.IDExpression = "body.nope"
+		httpSpec.StatusPolicy = &policy
+		s.HTTP = &httpSpec
+
+		_, err := executor.Execute(s)
+		require.ErrorContains(t, err, "ID expression returned no value")
+	})
+
+	t.Run("status not matching any expression -> not finished", func(t *testing.T) {
+		state = "running"
+		response, err := executor.Check(spec, "job-1")
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("status matching success expression -> finished and successful", func(t *testing.T) {
+		state = "passed"
+		response, err := executor.Check(spec, "job-1")
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+		assert.Equal(t, map[string]any{"version": "v1"}, response.Outputs())
+
+		r, ok := response.(LoggingResponse)
+		require.True(t, ok)
+		require.Len(t, r.Logs(), 1)
+		assert.Contains(t, r.Logs()[0].Content, "GET "+server.URL+"/jobs/job-1\nResponse: 200 OK\n")
+	})
+
+	t.Run("status matching failure expression -> finished and not successful", func(t *testing.T) {
+		state = "failed"
+		response, err := executor.Check(spec, "job-1")
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("status request fails -> error", func(t *testing.T) {
+		_, err := executor.Check(spec, "job-2")
+		require.ErrorContains(t, err, "returned 404")
+	})
+}
+
+func Test_HTTP_Callback(t *testing.T) {
+	execution := models.StageExecution{
+		ID:      uuid.New(),
+		StageID: uuid.New(),
+	}
+
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	spec := models.ExecutorSpec{
+		HTTP: &models.HTTPExecutorSpec{
+			URL:  server.URL,
+			Mode: models.HTTPExecutorModeCallback,
+			ResponsePolicy: &models.HTTPResponsePolicy{
+				StatusCodes: []uint32{200},
+			},
+		},
+	}
+
+	signer := jwt.NewSigner("test")
+	executor, err := NewHTTPExecutor(execution, signer)
+	require.NoError(t, err)
+
+	t.Run("execute sends execution token and uses execution ID as reference", func(t *testing.T) {
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.Equal(t, execution.ID.String(), response.Id())
+		require.NoError(t, signer.Validate(body["executionToken"], execution.ID.String()))
+	})
+
+	t.Run("check is never finished", func(t *testing.T) {
+		response, err := executor.Check(spec, execution.ID.String())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+}
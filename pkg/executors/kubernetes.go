@@ -0,0 +1,290 @@
+package executors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/apis/kubernetes"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	DefaultKubernetesJobTTL = 3600
+
+	// Finished jobs must be around for a few polls at least,
+	// so the execution sees how they finished before the cluster removes them.
+	MinKubernetesJobTTL = 300
+
+	KubernetesJobNamePrefix  = "superplane"
+	KubernetesStageLabel     = "superplane.io/stage-id"
+	KubernetesExecutionLabel = "superplane.io/execution-id"
+
+	// Job names are DNS labels, so they are limited to 63 characters.
+	// The execution ID takes 36 of them, plus the separator.
+	maxKubernetesJobNamePrefix = 26
+)
+
+// The client depends on the cluster configured in the spec,
+// so the executor receives a function to build it instead of the client itself.
+type KubernetesClientFactory func(spec *models.KubernetesExecutorSpec) (kubernetes.Client, error)
+
+func NewKubernetesClient(spec *models.KubernetesExecutorSpec) (kubernetes.Client, error) {
+	return kubernetes.NewKubernetesAPI(spec.URL, spec.Token, spec.CACertificate)
+}
+
+type KubernetesExecutor struct {
+	execution     models.StageExecution
+	jwtSigner     *jwt.Signer
+	clientFactory KubernetesClientFactory
+}
+
+type KubernetesResponse struct {
+	id  string
+	job *kubernetes.Job
+}
+
+// The job is not finished after the API call that creates it,
+// so we need to monitor its status. Jobs that are gone,
+// e.g. removed by someone else, will never finish, so they count as finished.
+func (r *KubernetesResponse) Finished() bool {
+	if r.job == nil {
+		return true
+	}
+
+	return r.job.Finished()
+}
+
+// Job names are unique inside a namespace,
+// so we use the name as the unique identifier here.
+func (r *KubernetesResponse) Id() string {
+	return r.id
+}
+
+func (r *KubernetesResponse) Successful() bool {
+	if r.job == nil {
+		return false
+	}
+
+	return r.job.Successful()
+}
+
+// Outputs for Kubernetes executions are sent via the /outputs API.
+func (r *KubernetesResponse) Outputs() map[string]any {
+	return nil
+}
+
+func NewKubernetesExecutor(execution models.StageExecution, jwtSigner *jwt.Signer, clientFactory KubernetesClientFactory) (*KubernetesExecutor, error) {
+	return &KubernetesExecutor{
+		execution:     execution,
+		jwtSigner:     jwtSigner,
+		clientFactory: clientFactory,
+	}, nil
+}
+
+func (e *KubernetesExecutor) Name() string {
+	return models.ExecutorSpecTypeKubernetes
+}
+
+func (e *KubernetesExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	job, err := e.buildJob(spec.Kubernetes)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := e.clientFactory(spec.Kubernetes)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+
+	created, err := client.CreateJob(spec.Kubernetes.Namespace, job)
+	if err != nil {
+		return nil, fmt.Errorf("error creating job: %v", err)
+	}
+
+	return &KubernetesResponse{id: created.Metadata.Name, job: created}, nil
+}
+
+func (e *KubernetesExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	client, err := e.clientFactory(spec.Kubernetes)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+
+	job, err := client.GetJob(spec.Kubernetes.Namespace, id)
+	if errors.Is(err, kubernetes.ErrNotFound) {
+		return &KubernetesResponse{id: id}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting job %s: %v", id, err)
+	}
+
+	return &KubernetesResponse{id: id, job: job}, nil
+}
+
+// Deleting the job stops its pods too.
+// If the job is already gone, there is nothing to cancel.
+func (e *KubernetesExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	client, err := e.clientFactory(spec.Kubernetes)
+	if err != nil {
+		return fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+
+	err = client.DeleteJob(spec.Kubernetes.Namespace, id)
+	if err != nil && !errors.Is(err, kubernetes.ErrNotFound) {
+		return fmt.Errorf("error deleting job %s: %v", id, err)
+	}
+
+	return nil
+}
+
+func (e *KubernetesExecutor) buildJob(spec *models.KubernetesExecutorSpec) (*kubernetes.Job, error) {
+	job, err := ParseKubernetesJobManifest(spec.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := job.Metadata.Name
+	if prefix == "" {
+		prefix = KubernetesJobNamePrefix
+	}
+
+	if len(prefix) > maxKubernetesJobNamePrefix {
+		prefix = prefix[:maxKubernetesJobNamePrefix]
+	}
+
+	job.Metadata.Name = fmt.Sprintf("%s-%s", prefix, e.execution.ID.String())
+	job.Metadata.Namespace = spec.Namespace
+	if job.Metadata.Labels == nil {
+		job.Metadata.Labels = map[string]string{}
+	}
+
+	job.Metadata.Labels[KubernetesStageLabel] = e.execution.StageID.String()
+	job.Metadata.Labels[KubernetesExecutionLabel] = e.execution.ID.String()
+
+	//
+	// Finished jobs are removed by the cluster after the TTL,
+	// so they do not pile up in the namespace.
+	//
+	ttl := spec.TTLSecondsAfterFinished
+	if ttl == 0 {
+		ttl = DefaultKubernetesJobTTL
+	}
+
+	if ttl < MinKubernetesJobTTL {
+		ttl = MinKubernetesJobTTL
+	}
+
+	job.Spec["ttlSecondsAfterFinished"] = ttl
+
+	env, err := e.buildEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	err = injectEnv(job, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (e *KubernetesExecutor) buildEnv() ([]any, error) {
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	values := map[string]string{
+		StageIDVariable:        e.execution.StageID.String(),
+		ExecutionIDVariable:    e.execution.ID.String(),
+		ExecutionTokenVariable: token,
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	env := make([]any, 0, len(keys))
+	for _, key := range keys {
+		env = append(env, map[string]any{"name": key, "value": values[key]})
+	}
+
+	return env, nil
+}
+
+// ParseKubernetesJobManifest reads a YAML or JSON job manifest.
+func ParseKubernetesJobManifest(manifest string) (*kubernetes.Job, error) {
+	var job kubernetes.Job
+	err := yaml.Unmarshal([]byte(manifest), &job)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job manifest: %v", err)
+	}
+
+	if job.Kind != "Job" {
+		return nil, fmt.Errorf("invalid job manifest: kind must be Job")
+	}
+
+	if job.APIVersion != "batch/v1" {
+		return nil, fmt.Errorf("invalid job manifest: apiVersion must be batch/v1")
+	}
+
+	if job.Spec == nil {
+		return nil, fmt.Errorf("invalid job manifest: missing spec")
+	}
+
+	_, err = jobContainers(&job)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// The execution variables are added to every container in the pod template,
+// so the job can push outputs for the execution.
+func injectEnv(job *kubernetes.Job, env []any) error {
+	containers, err := jobContainers(job)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid job manifest: invalid container")
+		}
+
+		existing, _ := container["env"].([]any)
+		container["env"] = append(existing, env...)
+	}
+
+	return nil
+}
+
+func jobContainers(job *kubernetes.Job) ([]any, error) {
+	template, ok := job.Spec["template"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid job manifest: missing spec.template")
+	}
+
+	podSpec, ok := template["spec"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid job manifest: missing spec.template.spec")
+	}
+
+	containers, ok := podSpec["containers"].([]any)
+	if !ok || len(containers) == 0 {
+		return nil, fmt.Errorf("invalid job manifest: missing spec.template.spec.containers")
+	}
+
+	return containers, nil
+}
@@ -0,0 +1,222 @@
+package executors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/kubernetes"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	kubernetesfake "github.com/superplanehq/superplane/test/kubernetes"
+)
+
+const testJobManifest = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: deploy
+spec:
+  backoffLimit: 0
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+        - name: deploy
+          image: ${{ inputs.IMAGE }}
+          env:
+            - name: TOKEN
+              value: ${{ secrets.TOKEN }}
+`
+
+func Test_Kubernetes(t *testing.T) {
+	client := kubernetesfake.NewFakeClient()
+	factory := func(spec *models.KubernetesExecutorSpec) (kubernetes.Client, error) {
+		return client, nil
+	}
+
+	signer := jwt.NewSigner("test")
+	builder := SpecBuilder{}
+	spec, err := builder.Build(models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeKubernetes,
+		Kubernetes: &models.KubernetesExecutorSpec{
+			Namespace:               "default",
+			Manifest:                testJobManifest,
+			TTLSecondsAfterFinished: 600,
+		},
+	}, map[string]any{"IMAGE": "alpine:3.21"}, map[string]string{"TOKEN": "secret"})
+
+	require.NoError(t, err)
+
+	t.Run("job is created from templated manifest", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewKubernetesExecutor(execution, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.Equal(t, "deploy-"+execution.ID.String(), response.Id())
+
+		job, err := client.GetJob("default", response.Id())
+		require.NoError(t, err)
+		assert.Equal(t, execution.StageID.String(), job.Metadata.Labels[KubernetesStageLabel])
+		assert.Equal(t, execution.ID.String(), job.Metadata.Labels[KubernetesExecutionLabel])
+		assert.Equal(t, float64(600), job.Spec["ttlSecondsAfterFinished"])
+
+		containers, err := jobContainers(job)
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+
+		container := containers[0].(map[string]any)
+		assert.Equal(t, "alpine:3.21", container["image"])
+
+		env := map[string]string{}
+		for _, e := range container["env"].([]any) {
+			variable := e.(map[string]any)
+			env[variable["name"].(string)] = variable["value"].(string)
+		}
+
+		assert.Equal(t, "secret", env["TOKEN"])
+		assert.Equal(t, execution.StageID.String(), env[StageIDVariable])
+		assert.Equal(t, execution.ID.String(), env[ExecutionIDVariable])
+		require.NoError(t, signer.Validate(env[ExecutionTokenVariable], execution.ID.String()))
+	})
+
+	t.Run("running job -> not finished", func(t *testing.T) {
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+
+		response, err = executor.Check(*spec, response.Id())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("completed job -> finished and successful", func(t *testing.T) {
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		client.FinishJob("default", response.Id(), true)
+
+		response, err = executor.Check(*spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+
+	t.Run("failed job -> finished and not successful", func(t *testing.T) {
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		client.FinishJob("default", response.Id(), false)
+
+		response, err = executor.Check(*spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("job that does not exist -> finished and not successful", func(t *testing.T) {
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Check(*spec, "does-not-exist")
+		require.NoError(t, err)
+		assert.Equal(t, "does-not-exist", response.Id())
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("TTL below the minimum -> minimum is used", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewKubernetesExecutor(execution, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeKubernetes,
+			Kubernetes: &models.KubernetesExecutorSpec{
+				Namespace:               "default",
+				Manifest:                spec.Kubernetes.Manifest,
+				TTLSecondsAfterFinished: 1,
+			},
+		})
+
+		require.NoError(t, err)
+		job, err := client.GetJob("default", response.Id())
+		require.NoError(t, err)
+		assert.Equal(t, float64(MinKubernetesJobTTL), job.Spec["ttlSecondsAfterFinished"])
+	})
+
+	t.Run("inputs with YAML -> values are not parsed as YAML", func(t *testing.T) {
+		spec, err := builder.Build(models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeKubernetes,
+			Kubernetes: &models.KubernetesExecutorSpec{
+				Namespace: "default",
+				Manifest:  testJobManifest,
+			},
+		}, map[string]any{"IMAGE": "alpine\n          securityContext:\n            privileged: true"}, map[string]string{"TOKEN": "secret"})
+
+		require.NoError(t, err)
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		job, err := client.GetJob("default", response.Id())
+		require.NoError(t, err)
+
+		containers, err := jobContainers(job)
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+
+		container := containers[0].(map[string]any)
+		assert.Equal(t, "alpine\n          securityContext:\n            privileged: true", container["image"])
+		assert.NotContains(t, container, "securityContext")
+	})
+
+	t.Run("job is deleted on cancel", func(t *testing.T) {
+		executor, err := NewKubernetesExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer, factory)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		require.NoError(t, executor.Cancel(*spec, response.Id()))
+		assert.Contains(t, client.DeletedJobs, "default/"+response.Id())
+
+		//
+		// Cancelling a job that is already gone does nothing.
+		//
+		require.NoError(t, executor.Cancel(*spec, response.Id()))
+	})
+}
+
+func Test_ParseKubernetesJobManifest(t *testing.T) {
+	t.Run("invalid YAML -> error", func(t *testing.T) {
+		_, err := ParseKubernetesJobManifest("not: [valid")
+		require.ErrorContains(t, err, "invalid job manifest")
+	})
+
+	t.Run("not a job -> error", func(t *testing.T) {
+		_, err := ParseKubernetesJobManifest("apiVersion: v1\nkind: Pod\nspec: {}")
+		require.ErrorContains(t, err, "kind must be Job")
+	})
+
+	t.Run("job without containers -> error", func(t *testing.T) {
+		_, err := ParseKubernetesJobManifest("apiVersion: batch/v1\nkind: Job\nspec:\n  template:\n    spec: {}")
+		require.ErrorContains(t, err, "missing spec.template.spec.containers")
+	})
+
+	t.Run("templated job -> no error", func(t *testing.T) {
+		job, err := ParseKubernetesJobManifest(testJobManifest)
+		require.NoError(t, err)
+		assert.Equal(t, "deploy", job.Metadata.Name)
+	})
+}
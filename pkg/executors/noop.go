@@ -0,0 +1,57 @@
+package executors
+
+import "github.com/superplanehq/superplane/pkg/models"
+
+// The noop executor is used for stages that are only gates:
+// once the event passes the conditions, the execution passes right away.
+type NoopExecutor struct {
+	execution models.StageExecution
+}
+
+type NoopResponse struct {
+	outputs map[string]any
+}
+
+func (r *NoopResponse) Finished() bool {
+	return true
+}
+
+func (r *NoopResponse) Successful() bool {
+	return true
+}
+
+func (r *NoopResponse) Id() string {
+	return ""
+}
+
+func (r *NoopResponse) Outputs() map[string]any {
+	return r.outputs
+}
+
+func NewNoopExecutor(execution models.StageExecution) (*NoopExecutor, error) {
+	return &NoopExecutor{
+		execution: execution,
+	}, nil
+}
+
+func (e *NoopExecutor) Name() string {
+	return models.ExecutorSpecTypeNoop
+}
+
+// The outputs in the spec are already resolved by the spec builder,
+// so static values and values copied from inputs look the same here.
+func (e *NoopExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	outputs := map[string]any{}
+	if spec.Noop != nil {
+		for name, value := range spec.Noop.Outputs {
+			outputs[name] = value
+		}
+	}
+
+	return &NoopResponse{outputs: outputs}, nil
+}
+
+// Noop executions always finish on Execute, so there is nothing to check.
+func (e *NoopExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	return &NoopResponse{outputs: map[string]any{}}, nil
+}
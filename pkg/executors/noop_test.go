@@ -0,0 +1,49 @@
+package executors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test_Noop(t *testing.T) {
+	execution := models.StageExecution{
+		ID:      uuid.New(),
+		StageID: uuid.New(),
+	}
+
+	executor, err := NewExecutor(models.ExecutorSpecTypeNoop, execution, nil, nil)
+	require.NoError(t, err)
+
+	t.Run("no outputs -> finished and successful", func(t *testing.T) {
+		response, err := executor.Execute(models.ExecutorSpec{Type: models.ExecutorSpecTypeNoop})
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+		assert.Empty(t, response.Outputs())
+	})
+
+	t.Run("outputs are static or copied from inputs", func(t *testing.T) {
+		builder := SpecBuilder{}
+		spec, err := builder.Build(models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeNoop,
+			Noop: &models.NoopExecutorSpec{
+				Outputs: map[string]string{
+					"APPROVED": "true",
+					"VERSION":  "${{ inputs.VERSION }}",
+				},
+			},
+		}, map[string]any{"VERSION": "v1.2.0"}, map[string]string{})
+
+		require.NoError(t, err)
+
+		response, err := executor.Execute(*spec)
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+		assert.Equal(t, map[string]any{"APPROVED": "true", "VERSION": "v1.2.0"}, response.Outputs())
+	})
+}
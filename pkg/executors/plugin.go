@@ -0,0 +1,260 @@
+package executors
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const PluginRequestTimeout = 30 * time.Second
+
+// Executor plugins are registered by name when Superplane starts.
+// Stages reference them by that name in their executor spec.
+var plugins = struct {
+	sync.RWMutex
+	clients map[string]pb.ExecutorPluginClient
+}{clients: map[string]pb.ExecutorPluginClient{}}
+
+func RegisterPlugin(name string, client pb.ExecutorPluginClient) {
+	plugins.Lock()
+	defer plugins.Unlock()
+	plugins.clients[name] = client
+}
+
+func UnregisterPlugin(name string) {
+	plugins.Lock()
+	defer plugins.Unlock()
+	delete(plugins.clients, name)
+}
+
+func FindPlugin(name string) (pb.ExecutorPluginClient, error) {
+	plugins.RLock()
+	defer plugins.RUnlock()
+
+	client, ok := plugins.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("plugin %s is not registered", name)
+	}
+
+	return client, nil
+}
+
+// LoadPlugins creates a client for every plugin address, keyed by plugin name.
+// Connections are established lazily, so plugins can start after Superplane.
+func LoadPlugins(addresses map[string]string) error {
+	for name, address := range addresses {
+		target, creds := pluginTransport(address)
+		conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("error creating client for plugin %s: %v", name, err)
+		}
+
+		log.Infof("Registering executor plugin %s at %s", name, address)
+		RegisterPlugin(name, pb.NewExecutorPluginClient(conn))
+	}
+
+	return nil
+}
+
+// Plugin addresses can start with tls:// or insecure:// to choose how to connect.
+// Otherwise, TLS is used, unless the plugin runs on the same host.
+func pluginTransport(address string) (string, credentials.TransportCredentials) {
+	if target, ok := strings.CutPrefix(address, "insecure://"); ok {
+		return target, insecure.NewCredentials()
+	}
+
+	if target, ok := strings.CutPrefix(address, "tls://"); ok {
+		return target, credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	if isLocalAddress(address) {
+		return address, insecure.NewCredentials()
+	}
+
+	return address, credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+}
+
+func isLocalAddress(address string) bool {
+	if strings.HasPrefix(address, "unix:") {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+type PluginExecutor struct {
+	execution models.StageExecution
+	jwtSigner *jwt.Signer
+}
+
+type PluginResponse struct {
+	status *pb.ExecutionStatus
+}
+
+func (r *PluginResponse) Finished() bool {
+	return r.status.Finished
+}
+
+func (r *PluginResponse) Successful() bool {
+	return r.status.Successful
+}
+
+func (r *PluginResponse) Id() string {
+	return r.status.Id
+}
+
+func (r *PluginResponse) Outputs() map[string]any {
+	if r.status.Outputs == nil {
+		return nil
+	}
+
+	return r.status.Outputs.AsMap()
+}
+
+func NewPluginExecutor(execution models.StageExecution, jwtSigner *jwt.Signer) (*PluginExecutor, error) {
+	return &PluginExecutor{
+		execution: execution,
+		jwtSigner: jwtSigner,
+	}, nil
+}
+
+func (e *PluginExecutor) Name() string {
+	return models.ExecutorSpecTypePlugin
+}
+
+func (e *PluginExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	client, config, err := e.prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), PluginRequestTimeout)
+	defer cancel()
+
+	res, err := client.Execute(ctx, &pb.ExecuteRequest{
+		Execution: e.buildExecution(token),
+		Spec:      config,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s failed to execute: %s", spec.Plugin.Name, status.Convert(err).Message())
+	}
+
+	return e.buildResponse(res.Status, "")
+}
+
+func (e *PluginExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	client, config, err := e.prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), PluginRequestTimeout)
+	defer cancel()
+
+	res, err := client.Check(ctx, &pb.CheckRequest{
+		Execution: e.buildExecution(""),
+		Spec:      config,
+		Id:        id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s failed to check %s: %s", spec.Plugin.Name, id, status.Convert(err).Message())
+	}
+
+	return e.buildResponse(res.Status, id)
+}
+
+// Plugins that cannot stop executions leave Cancel unimplemented,
+// and the execution is only cancelled on the Superplane side.
+func (e *PluginExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	client, config, err := e.prepare(spec)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), PluginRequestTimeout)
+	defer cancel()
+
+	_, err = client.Cancel(ctx, &pb.CancelRequest{
+		Execution: e.buildExecution(""),
+		Spec:      config,
+		Id:        id,
+	})
+
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return fmt.Errorf("plugin %s failed to cancel %s: %s", spec.Plugin.Name, id, status.Convert(err).Message())
+	}
+
+	return nil
+}
+
+func (e *PluginExecutor) prepare(spec models.ExecutorSpec) (pb.ExecutorPluginClient, *structpb.Struct, error) {
+	client, err := FindPlugin(spec.Plugin.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := structpb.NewStruct(spec.Plugin.Config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid plugin config: %v", err)
+	}
+
+	return client, config, nil
+}
+
+func (e *PluginExecutor) buildExecution(token string) *pb.Execution {
+	return &pb.Execution{
+		Id:      e.execution.ID.String(),
+		StageId: e.execution.StageID.String(),
+		Token:   token,
+	}
+}
+
+// If the plugin does not give back an ID on Check,
+// we keep using the one we already have.
+func (e *PluginExecutor) buildResponse(s *pb.ExecutionStatus, id string) (Response, error) {
+	if s == nil {
+		return nil, fmt.Errorf("plugin returned no status")
+	}
+
+	if s.Id == "" {
+		s.Id = id
+	}
+
+	if s.Id == "" {
+		return nil, fmt.Errorf("plugin returned no execution ID")
+	}
+
+	return &PluginResponse{status: s}, nil
+}
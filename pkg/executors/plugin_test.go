@@ -0,0 +1,149 @@
+package executors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	pluginsmock "github.com/superplanehq/superplane/test/plugins"
+)
+
+func Test_Plugin(t *testing.T) {
+	plugin := pluginsmock.NewExecutorPluginMock()
+	client, err := plugin.Start()
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	RegisterPlugin("deployer", client)
+	defer UnregisterPlugin("deployer")
+
+	signer := jwt.NewSigner("test")
+	spec := models.ExecutorSpec{
+		Type: models.ExecutorSpecTypePlugin,
+		Plugin: &models.PluginExecutorSpec{
+			Name:   "deployer",
+			Config: map[string]any{"target": "production"},
+		},
+	}
+
+	t.Run("plugin that is not registered -> error", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		_, err = executor.Execute(models.ExecutorSpec{
+			Type:   models.ExecutorSpecTypePlugin,
+			Plugin: &models.PluginExecutorSpec{Name: "does-not-exist"},
+		})
+
+		require.ErrorContains(t, err, "plugin does-not-exist is not registered")
+	})
+
+	t.Run("execution is sent to plugin with config and token", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewPluginExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.Equal(t, "run-"+execution.ID.String(), response.Id())
+
+		require.NotNil(t, plugin.LastExecute)
+		assert.Equal(t, "production", plugin.LastExecute.Spec.AsMap()["target"])
+		assert.Equal(t, execution.ID.String(), plugin.LastExecute.Execution.Id)
+		assert.Equal(t, execution.StageID.String(), plugin.LastExecute.Execution.StageId)
+		require.NoError(t, signer.Validate(plugin.LastExecute.Execution.Token, execution.ID.String()))
+	})
+
+	t.Run("running execution -> not finished", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+	})
+
+	t.Run("successful execution -> finished, successful and with outputs", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		plugin.Finish(response.Id(), true, map[string]any{"VERSION": "v1"})
+
+		id := response.Id()
+		response, err = executor.Check(spec, id)
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+		assert.Equal(t, id, response.Id())
+		assert.Equal(t, map[string]any{"VERSION": "v1"}, response.Outputs())
+	})
+
+	t.Run("failed execution -> finished and not successful", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		plugin.Finish(response.Id(), false, nil)
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("plugin error on check -> error", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		_, err = executor.Check(spec, "does-not-exist")
+		require.ErrorContains(t, err, "execution does-not-exist not found")
+	})
+
+	t.Run("execution is cancelled", func(t *testing.T) {
+		executor, err := NewPluginExecutor(models.StageExecution{ID: uuid.New(), StageID: uuid.New()}, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		require.NoError(t, executor.Cancel(spec, response.Id()))
+		assert.Contains(t, plugin.Cancelled, response.Id())
+	})
+}
+
+func Test_PluginTransport(t *testing.T) {
+	t.Run("remote address -> TLS", func(t *testing.T) {
+		target, creds := pluginTransport("deployer.internal:50051")
+		assert.Equal(t, "deployer.internal:50051", target)
+		assert.Equal(t, "tls", creds.Info().SecurityProtocol)
+	})
+
+	t.Run("loopback address -> insecure", func(t *testing.T) {
+		for _, address := range []string{"localhost:50051", "127.0.0.1:50051", "[::1]:50051", "unix:///tmp/plugin.sock"} {
+			target, creds := pluginTransport(address)
+			assert.Equal(t, address, target)
+			assert.Equal(t, "insecure", creds.Info().SecurityProtocol, address)
+		}
+	})
+
+	t.Run("insecure:// prefix -> insecure", func(t *testing.T) {
+		target, creds := pluginTransport("insecure://deployer:50051")
+		assert.Equal(t, "deployer:50051", target)
+		assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+	})
+
+	t.Run("tls:// prefix -> TLS", func(t *testing.T) {
+		target, creds := pluginTransport("tls://localhost:50051")
+		assert.Equal(t, "localhost:50051", target)
+		assert.Equal(t, "tls", creds.Info().SecurityProtocol)
+	})
+}
@@ -0,0 +1,114 @@
+package executors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// The process executor does not run anything itself.
+// It creates a job for an agent, and the agent pulls it,
+// runs the command and reports back the exit code.
+type ProcessExecutor struct {
+	execution models.StageExecution
+	jwtSigner *jwt.Signer
+	encryptor crypto.Encryptor
+}
+
+type ProcessResponse struct {
+	job *models.AgentJob
+}
+
+func (r *ProcessResponse) Finished() bool {
+	return r.job.IsDone()
+}
+
+func (r *ProcessResponse) Successful() bool {
+	return r.job.Succeeded()
+}
+
+func (r *ProcessResponse) Id() string {
+	return r.job.ID.String()
+}
+
+// Outputs for process executions are sent via the /outputs API.
+func (r *ProcessResponse) Outputs() map[string]any {
+	return nil
+}
+
+func NewProcessExecutor(execution models.StageExecution, jwtSigner *jwt.Signer, encryptor crypto.Encryptor) (*ProcessExecutor, error) {
+	return &ProcessExecutor{
+		execution: execution,
+		jwtSigner: jwtSigner,
+		encryptor: encryptor,
+	}, nil
+}
+
+func (e *ProcessExecutor) Name() string {
+	return models.ExecutorSpecTypeProcess
+}
+
+func (e *ProcessExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
+	stage, err := models.FindStageByID(e.execution.StageID.String())
+	if err != nil {
+		return nil, fmt.Errorf("error finding stage: %v", err)
+	}
+
+	env, err := e.buildEnv(spec.Process.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := models.CreateAgentJob(e.encryptor, stage.CanvasID, e.execution.ID, spec.Process.Agent, models.AgentJobSpec{
+		Command:          spec.Process.Command,
+		Args:             spec.Process.Args,
+		Env:              env,
+		WorkingDirectory: spec.Process.WorkingDirectory,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating agent job: %v", err)
+	}
+
+	return &ProcessResponse{job: job}, nil
+}
+
+func (e *ProcessExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
+	job, err := models.FindAgentJobByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("agent job %s not found: %v", id, err)
+	}
+
+	return &ProcessResponse{job: job}, nil
+}
+
+func (e *ProcessExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	job, err := models.FindAgentJobByID(id)
+	if err != nil {
+		return fmt.Errorf("agent job %s not found: %v", id, err)
+	}
+
+	return job.Cancel()
+}
+
+func (e *ProcessExecutor) buildEnv(env map[string]string) (map[string]string, error) {
+	token, err := e.jwtSigner.Generate(e.execution.ID.String(), 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("error generating execution token: %v", err)
+	}
+
+	values := map[string]string{
+		StageIDVariable:        e.execution.StageID.String(),
+		ExecutionIDVariable:    e.execution.ID.String(),
+		ExecutionTokenVariable: token,
+	}
+
+	for key, value := range env {
+		values[key] = value
+	}
+
+	return values, nil
+}
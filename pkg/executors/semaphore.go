@@ -2,6 +2,7 @@ package executors
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/superplanehq/superplane/pkg/apis/semaphore"
@@ -15,7 +16,7 @@ type SemaphoreExecutor struct {
 }
 
 type SemaphoreResponse struct {
-	wfID     string
+	id       string
 	pipeline *semaphore.Pipeline
 }
 
@@ -31,10 +32,11 @@ func (r *SemaphoreResponse) Finished() bool {
 	return r.pipeline.State == semaphore.PipelineStateDone
 }
 
-// The API call to run a pipeline gives me back a workflow ID,
-// so we use that ID as the unique identifier here.
+// Task and workflow runs create a new workflow, so we use its ID here.
+// Promotions happen inside an existing workflow,
+// so the ID of the promoted pipeline is used instead.
 func (r *SemaphoreResponse) Id() string {
-	return r.wfID
+	return r.id
 }
 
 func (r *SemaphoreResponse) Successful() bool {
@@ -62,30 +64,55 @@ func (e *SemaphoreExecutor) Name() string {
 }
 
 func (e *SemaphoreExecutor) Execute(spec models.ExecutorSpec) (Response, error) {
-	//
-	// For now, only task runs are supported,
-	// until the workflow API is updated to support parameters.
-	//
-	if spec.Semaphore.TaskID == "" {
-		return nil, fmt.Errorf("only task runs are supported")
+	if spec.Semaphore.TaskID != "" {
+		return e.triggerSemaphoreTask(spec)
 	}
 
-	return e.triggerSemaphoreTask(spec)
+	if spec.Semaphore.IsPromotion() {
+		return e.promotePipeline(spec)
+	}
+
+	return e.runWorkflow(spec)
 }
 
 func (e *SemaphoreExecutor) Check(spec models.ExecutorSpec, id string) (Response, error) {
 	api := semaphore.NewSemaphoreAPI(spec.Semaphore.OrganizationURL, string(spec.Semaphore.APIToken))
-	workflow, err := api.DescribeWorkflow(id)
+
+	pipelineID := id
+	if !spec.Semaphore.IsPromotion() {
+		workflow, err := api.DescribeWorkflow(id)
+		if err != nil {
+			return nil, fmt.Errorf("workflow %s not found", id)
+		}
+
+		pipelineID = workflow.InitialPplID
+	}
+
+	pipeline, err := api.DescribePipeline(pipelineID)
 	if err != nil {
-		return nil, fmt.Errorf("workflow %s not found", id)
+		return nil, fmt.Errorf("pipeline %s not found", pipelineID)
+	}
+
+	return &SemaphoreResponse{id: id, pipeline: pipeline}, nil
+}
+
+func (e *SemaphoreExecutor) Cancel(spec models.ExecutorSpec, id string) error {
+	api := semaphore.NewSemaphoreAPI(spec.Semaphore.OrganizationURL, string(spec.Semaphore.APIToken))
+	if spec.Semaphore.IsPromotion() {
+		err := api.StopPipeline(id)
+		if err != nil {
+			return fmt.Errorf("error stopping pipeline %s: %v", id, err)
+		}
+
+		return nil
 	}
 
-	pipeline, err := api.DescribePipeline(workflow.InitialPplID)
+	err := api.StopWorkflow(id)
 	if err != nil {
-		return nil, fmt.Errorf("pipeline %s not found", workflow.InitialPplID)
+		return fmt.Errorf("error stopping workflow %s: %v", id, err)
 	}
 
-	return &SemaphoreResponse{wfID: id, pipeline: pipeline}, nil
+	return nil
 }
 
 func (e *SemaphoreExecutor) triggerSemaphoreTask(spec models.ExecutorSpec) (Response, error) {
@@ -105,7 +132,75 @@ func (e *SemaphoreExecutor) triggerSemaphoreTask(spec models.ExecutorSpec) (Resp
 		return nil, err
 	}
 
-	return &SemaphoreResponse{wfID: workflowID, pipeline: nil}, nil
+	return &SemaphoreResponse{id: workflowID, pipeline: nil}, nil
+}
+
+func (e *SemaphoreExecutor) runWorkflow(spec models.ExecutorSpec) (Response, error) {
+	api := semaphore.NewSemaphoreAPI(spec.Semaphore.OrganizationURL, string(spec.Semaphore.APIToken))
+	parameters, err := e.buildParameters(spec.Semaphore.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("error building parameters: %v", err)
+	}
+
+	reference := spec.Semaphore.Branch
+	if !strings.HasPrefix(reference, "refs/") {
+		reference = "refs/heads/" + reference
+	}
+
+	response, err := api.RunWorkflow(semaphore.RunWorkflowRequest{
+		ProjectID:    spec.Semaphore.ProjectID,
+		Reference:    reference,
+		PipelineFile: spec.Semaphore.PipelineFile,
+		Parameters:   parameterMap(parameters),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SemaphoreResponse{id: response.WorkflowID, pipeline: nil}, nil
+}
+
+// Promotions start from the initial pipeline of the workflow.
+// Since the promotion API does not return the pipeline it creates,
+// we look for it in the promotions of the initial pipeline,
+// using the execution ID parameter, since other promotions
+// with the same name might be triggered at the same time.
+func (e *SemaphoreExecutor) promotePipeline(spec models.ExecutorSpec) (Response, error) {
+	api := semaphore.NewSemaphoreAPI(spec.Semaphore.OrganizationURL, string(spec.Semaphore.APIToken))
+	parameters, err := e.buildParameters(spec.Semaphore.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("error building parameters: %v", err)
+	}
+
+	workflow, err := api.DescribeWorkflow(spec.Semaphore.WorkflowID)
+	if err != nil {
+		return nil, fmt.Errorf("workflow %s not found", spec.Semaphore.WorkflowID)
+	}
+
+	err = api.Promote(workflow.InitialPplID, spec.Semaphore.Promotion, parameterMap(parameters))
+	if err != nil {
+		return nil, fmt.Errorf("error promoting pipeline %s: %v", workflow.InitialPplID, err)
+	}
+
+	promotions, err := api.ListPromotions(workflow.InitialPplID, spec.Semaphore.Promotion)
+	if err != nil {
+		return nil, fmt.Errorf("error listing promotions for pipeline %s: %v", workflow.InitialPplID, err)
+	}
+
+	for _, promotion := range promotions {
+		if promotion.Parameters["SEMAPHORE_STAGE_EXECUTION_ID"] != e.execution.ID.String() {
+			continue
+		}
+
+		if promotion.ScheduledPipelineID == "" {
+			return nil, fmt.Errorf("promotion %s did not create a pipeline", spec.Semaphore.Promotion)
+		}
+
+		return &SemaphoreResponse{id: promotion.ScheduledPipelineID, pipeline: nil}, nil
+	}
+
+	return nil, fmt.Errorf("promotion %s for execution %s not found", spec.Semaphore.Promotion, e.execution.ID)
 }
 
 func (e *SemaphoreExecutor) buildParameters(parameters map[string]string) ([]semaphore.TaskTriggerParameter, error) {
@@ -133,3 +228,12 @@ func (e *SemaphoreExecutor) buildParameters(parameters map[string]string) ([]sem
 
 	return parameterValues, nil
 }
+
+func parameterMap(parameters []semaphore.TaskTriggerParameter) map[string]string {
+	m := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		m[p.Name] = p.Value
+	}
+
+	return m
+}
@@ -0,0 +1,149 @@
+package executors
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/semaphore"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	semaphoremock "github.com/superplanehq/superplane/test/semaphore"
+)
+
+func Test_Semaphore(t *testing.T) {
+	mock := semaphoremock.NewSemaphoreAPIMock()
+	mock.Init()
+	defer mock.Close()
+
+	signer := jwt.NewSigner("test")
+
+	t.Run("no task -> workflow is run for the branch", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewSemaphoreExecutor(execution, signer)
+		require.NoError(t, err)
+
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeSemaphore,
+			Semaphore: &models.SemaphoreExecutorSpec{
+				OrganizationURL: mock.Server.URL,
+				APIToken:        "token",
+				ProjectID:       "project",
+				Branch:          "main",
+				PipelineFile:    ".semaphore/deploy.yml",
+				Parameters:      map[string]string{"VERSION": "v1"},
+			},
+		}
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+		assert.NotEmpty(t, response.Id())
+
+		require.NotNil(t, mock.LastRunWorkflow)
+		assert.Equal(t, "project", mock.LastRunWorkflow.ProjectID)
+		assert.Equal(t, "refs/heads/main", mock.LastRunWorkflow.Reference)
+		assert.Equal(t, ".semaphore/deploy.yml", mock.LastRunWorkflow.PipelineFile)
+		assert.Equal(t, "v1", mock.LastRunWorkflow.Parameters["VERSION"])
+		assert.Equal(t, execution.ID.String(), mock.LastRunWorkflow.Parameters["SEMAPHORE_STAGE_EXECUTION_ID"])
+		require.NoError(t, signer.Validate(mock.LastRunWorkflow.Parameters["SEMAPHORE_STAGE_EXECUTION_TOKEN"], execution.ID.String()))
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+	})
+
+	t.Run("promotion -> promoted pipeline is followed", func(t *testing.T) {
+		workflowID := uuid.New().String()
+		pipelineID := uuid.New().String()
+		mock.AddPipeline(pipelineID, workflowID, "passed")
+
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewSemaphoreExecutor(execution, signer)
+		require.NoError(t, err)
+
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeSemaphore,
+			Semaphore: &models.SemaphoreExecutorSpec{
+				OrganizationURL: mock.Server.URL,
+				APIToken:        "token",
+				WorkflowID:      workflowID,
+				Promotion:       "Production",
+				Parameters:      map[string]string{"VERSION": "v1"},
+			},
+		}
+
+		response, err := executor.Execute(spec)
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+
+		require.Len(t, mock.Promotions[pipelineID], 1)
+		promoted := mock.Promotions[pipelineID][0].ScheduledPipelineID
+		assert.Equal(t, promoted, response.Id())
+		assert.Equal(t, "Production", mock.LastPromotion["name"])
+		assert.Equal(t, "v1", mock.LastPromotion["VERSION"])
+		assert.Equal(t, execution.ID.String(), mock.LastPromotion["SEMAPHORE_STAGE_EXECUTION_ID"])
+
+		response, err = executor.Check(spec, response.Id())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+		assert.Equal(t, promoted, response.Id())
+
+		require.NoError(t, executor.Cancel(spec, promoted))
+		assert.Contains(t, mock.StoppedPipelines, promoted)
+		assert.NotContains(t, mock.StoppedWorkflows, workflowID)
+	})
+
+	t.Run("concurrent promotions -> promotion for the execution is followed", func(t *testing.T) {
+		workflowID := uuid.New().String()
+		pipelineID := uuid.New().String()
+		mock.AddPipeline(pipelineID, workflowID, "passed")
+		mock.ConcurrentPromotions = []semaphore.Promotion{
+			{
+				Name:                "Production",
+				PipelineID:          pipelineID,
+				ScheduledPipelineID: uuid.New().String(),
+				Parameters:          map[string]string{"SEMAPHORE_STAGE_EXECUTION_ID": uuid.New().String()},
+			},
+		}
+
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewSemaphoreExecutor(execution, signer)
+		require.NoError(t, err)
+
+		response, err := executor.Execute(models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeSemaphore,
+			Semaphore: &models.SemaphoreExecutorSpec{
+				OrganizationURL: mock.Server.URL,
+				APIToken:        "token",
+				WorkflowID:      workflowID,
+				Promotion:       "Production",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, mock.Promotions[pipelineID], 2)
+		assert.Equal(t, mock.Promotions[pipelineID][1].ScheduledPipelineID, response.Id())
+	})
+
+	t.Run("promotion for unknown workflow -> error", func(t *testing.T) {
+		execution := models.StageExecution{ID: uuid.New(), StageID: uuid.New()}
+		executor, err := NewSemaphoreExecutor(execution, signer)
+		require.NoError(t, err)
+
+		_, err = executor.Execute(models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeSemaphore,
+			Semaphore: &models.SemaphoreExecutorSpec{
+				OrganizationURL: mock.Server.URL,
+				APIToken:        "token",
+				WorkflowID:      uuid.New().String(),
+				Promotion:       "Production",
+			},
+		})
+
+		require.ErrorContains(t, err, "not found")
+	})
+}
@@ -1,6 +1,7 @@
 package executors
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -21,7 +22,92 @@ func (b *SpecBuilder) Build(spec models.ExecutorSpec, inputs map[string]any, sec
 		return nil, err
 	}
 
-	return b.mapToSpec(resolved)
+	result, err := b.mapToSpec(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// JSON bodies are resolved separately,
+	// so the values added to them are escaped.
+	//
+	if spec.HTTP != nil && spec.HTTP.Body != "" && HTTPBodyIsJSON(spec.HTTP) {
+		result.HTTP.Body, err = resolveJSONExpressions(spec.HTTP.Body, func(expression string) (any, error) {
+			return b.resolveExpression(expression, inputs, secrets)
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error resolving field body: %w", err)
+		}
+	}
+
+	//
+	// Kubernetes manifests are resolved after they are parsed,
+	// so the values added to them can never change the structure of the job.
+	//
+	if spec.Kubernetes != nil && spec.Kubernetes.Manifest != "" {
+		result.Kubernetes.Manifest, err = b.resolveKubernetesManifest(spec.Kubernetes.Manifest, inputs, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving field manifest: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// resolveKubernetesManifest resolves the expressions in the values of a job manifest,
+// and gives back the resolved job as JSON, which is also valid YAML.
+func (b *SpecBuilder) resolveKubernetesManifest(manifest string, inputs map[string]any, secrets map[string]string) (string, error) {
+	job, err := ParseKubernetesJobManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := b.ResolveExpression(job.Metadata.Name, inputs, secrets)
+	if err != nil {
+		return "", err
+	}
+
+	job.Metadata.Name = fmt.Sprintf("%v", name)
+	job.Metadata.Labels, err = b.resolveStringMap(job.Metadata.Labels, inputs, secrets)
+	if err != nil {
+		return "", err
+	}
+
+	job.Metadata.Annotations, err = b.resolveStringMap(job.Metadata.Annotations, inputs, secrets)
+	if err != nil {
+		return "", err
+	}
+
+	job.Spec, err = b.resolveMap(job.Spec, inputs, secrets)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func (b *SpecBuilder) resolveStringMap(m map[string]string, inputs map[string]any, secrets map[string]string) (map[string]string, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]string, len(m))
+	for key, value := range m {
+		resolved, err := b.ResolveExpression(value, inputs, secrets)
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = fmt.Sprintf("%v", resolved)
+	}
+
+	return result, nil
 }
 
 func (b *SpecBuilder) specToMap(spec models.ExecutorSpec) (map[string]any, error) {
@@ -85,6 +171,17 @@ func (b *SpecBuilder) resolveValue(value any, inputs map[string]any, secrets map
 		}
 
 		return b.resolveMap(anyMap, inputs, secrets)
+	case []string:
+		result := make([]string, len(v))
+		for i, item := range v {
+			resolved, err := b.ResolveExpression(item, inputs, secrets)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = fmt.Sprintf("%v", resolved)
+		}
+		return result, nil
+
 	case []any:
 		result := make([]any, len(v))
 		for i, item := range v {
@@ -159,3 +256,56 @@ func (b *SpecBuilder) resolveExpression(expression string, inputs map[string]any
 
 	return nil, fmt.Errorf("invalid expression format")
 }
+
+// resolveJSONExpressions resolves the expressions in a JSON document.
+// Values inside JSON strings are escaped, and values outside of them are added as JSON strings,
+// so they can never change the structure of the document.
+func resolveJSONExpressions(document string, resolve func(string) (any, error)) (string, error) {
+	var out strings.Builder
+	inString := false
+	escaped := false
+
+	for i := 0; i < len(document); {
+		if strings.HasPrefix(document[i:], "${{") {
+			end := strings.Index(document[i:], "}}")
+			if end < 0 {
+				out.WriteString(document[i:])
+				break
+			}
+
+			value, err := resolve(document[i+3 : i+end])
+			if err != nil {
+				return "", err
+			}
+
+			encoded, err := json.Marshal(fmt.Sprintf("%v", value))
+			if err != nil {
+				return "", err
+			}
+
+			if inString {
+				encoded = encoded[1 : len(encoded)-1]
+			}
+
+			out.Write(encoded)
+			escaped = false
+			i += end + 2
+			continue
+		}
+
+		c := document[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		}
+
+		out.WriteByte(c)
+		i++
+	}
+
+	return out.String(), nil
+}
@@ -1,6 +1,7 @@
 package executors
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,65 @@ func Test__SpecBuilder_Build(t *testing.T) {
 		})
 		assert.Equal(t, v.HTTP.ResponsePolicy.StatusCodes, []uint32{200, 201})
 	})
+
+	t.Run("http spec with body and query params", func(t *testing.T) {
+		builder := SpecBuilder{}
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeHTTP,
+			HTTP: &models.HTTPExecutorSpec{
+				URL:    "http://localhost:8000",
+				Method: "PUT",
+				Body:   `{"version": "${{ inputs.VERSION }}", "token": "${{ secrets.TOKEN }}"}`,
+				QueryParams: map[string]string{
+					"ref": "${{ inputs.VERSION }}",
+				},
+				ResponsePolicy: &models.HTTPResponsePolicy{
+					StatusCodes: []uint32{200},
+				},
+			},
+		}
+
+		v, err := builder.Build(spec, map[string]any{"VERSION": "v1"}, map[string]string{"TOKEN": "mytoken"})
+		require.NoError(t, err)
+		assert.Equal(t, "PUT", v.HTTP.Method)
+		assert.Equal(t, `{"version": "v1", "token": "mytoken"}`, v.HTTP.Body)
+		assert.Equal(t, map[string]string{"ref": "v1"}, v.HTTP.QueryParams)
+	})
+
+	t.Run("http spec with JSON body -> values are escaped", func(t *testing.T) {
+		builder := SpecBuilder{}
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeHTTP,
+			HTTP: &models.HTTPExecutorSpec{
+				URL:  "http://localhost:8000",
+				Body: `{"version": "${{ inputs.VERSION }}", "count": ${{ inputs.COUNT }}}`,
+			},
+		}
+
+		v, err := builder.Build(spec, map[string]any{"VERSION": `v1", "admin": true, "x": "`, "COUNT": "1, \"admin\": true"}, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, `{"version": "v1\", \"admin\": true, \"x\": \"", "count": "1, \"admin\": true"}`, v.HTTP.Body)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal([]byte(v.HTTP.Body), &body))
+		assert.Len(t, body, 2)
+	})
+
+	t.Run("http spec with text body -> values are not escaped", func(t *testing.T) {
+		builder := SpecBuilder{}
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeHTTP,
+			HTTP: &models.HTTPExecutorSpec{
+				URL:     "http://localhost:8000",
+				Headers: map[string]string{"Content-Type": "text/plain"},
+				Body:    `deploy "${{ inputs.VERSION }}"`,
+			},
+		}
+
+		v, err := builder.Build(spec, map[string]any{"VERSION": `v1"`}, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, `deploy "v1""`, v.HTTP.Body)
+	})
 }
 
 func Test__SpecBuilder_ResolveExpression(t *testing.T) {
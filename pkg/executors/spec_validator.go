@@ -1,12 +1,20 @@
 package executors
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/expr-lang/expr"
 	"github.com/superplanehq/superplane/pkg/models"
+	pluginspb "github.com/superplanehq/superplane/pkg/protos/plugins"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type SpecValidator struct {
@@ -22,6 +30,18 @@ func (v *SpecValidator) Validate(in *pb.ExecutorSpec) (*models.ExecutorSpec, err
 		return v.validateSemaphoreExecutorSpec(in)
 	case pb.ExecutorSpec_TYPE_HTTP:
 		return v.validateHTTPExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_GITHUB:
+		return v.validateGitHubExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_GITLAB:
+		return v.validateGitLabExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_KUBERNETES:
+		return v.validateKubernetesExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_PLUGIN:
+		return v.validatePluginExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_PROCESS:
+		return v.validateProcessExecutorSpec(in)
+	case pb.ExecutorSpec_TYPE_NOOP:
+		return v.validateNoopExecutorSpec(in)
 	default:
 		return nil, errors.New("invalid executor spec type")
 	}
@@ -63,17 +83,138 @@ func (v *SpecValidator) validateHTTPExecutorSpec(in *pb.ExecutorSpec) (*models.E
 		}
 	}
 
+	method := strings.ToUpper(in.Http.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	if !slices.Contains(HTTPMethods, method) {
+		return nil, fmt.Errorf("invalid HTTP executor spec: invalid method %s", in.Http.Method)
+	}
+
+	if in.Http.Body != "" && len(payload) > 0 {
+		return nil, fmt.Errorf("invalid HTTP executor spec: payload and body cannot be used together")
+	}
+
+	if in.Http.Body != "" && HTTPBodyIsJSON(&models.HTTPExecutorSpec{Headers: headers}) {
+		if err := v.validateJSONBody(in.Http.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	queryParams := in.Http.QueryParams
+	if queryParams == nil {
+		queryParams = map[string]string{}
+	}
+
+	outputs, err := v.validateHTTPOutputs(in.Http.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, statusPolicy, err := v.validateHTTPMode(in.Http)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.ExecutorSpec{
 		Type: models.ExecutorSpecTypeHTTP,
 		HTTP: &models.HTTPExecutorSpec{
 			URL:            in.Http.Url,
+			Method:         method,
+			QueryParams:    queryParams,
 			Headers:        headers,
 			Payload:        payload,
+			Body:           in.Http.Body,
 			ResponsePolicy: responsePolicy,
+			Mode:           mode,
+			StatusPolicy:   statusPolicy,
+			Outputs:        outputs,
 		},
 	}, nil
 }
 
+// Expressions in JSON bodies are always added as JSON strings,
+// so the body is checked with empty strings in their place.
+func (v *SpecValidator) validateJSONBody(body string) error {
+	document, err := resolveJSONExpressions(body, func(string) (any, error) { return "", nil })
+	if err != nil {
+		return err
+	}
+
+	if !json.Valid([]byte(document)) {
+		return fmt.Errorf("invalid HTTP executor spec: body is not valid JSON - set a Content-Type header to send other formats")
+	}
+
+	return nil
+}
+
+func (v *SpecValidator) validateHTTPOutputs(in []*pb.ExecutorSpec_HTTPOutput) ([]models.HTTPOutput, error) {
+	outputs := []models.HTTPOutput{}
+	for _, output := range in {
+		if output.Name == "" {
+			return nil, fmt.Errorf("invalid HTTP executor spec: output name is required")
+		}
+
+		if output.Expression == "" {
+			return nil, fmt.Errorf("invalid HTTP executor spec: expression for output %s is required", output.Name)
+		}
+
+		if _, err := expr.Compile(output.Expression); err != nil {
+			return nil, fmt.Errorf("invalid HTTP executor spec: invalid expression for output %s: %v", output.Name, err)
+		}
+
+		outputs = append(outputs, models.HTTPOutput{
+			Name:       output.Name,
+			Expression: output.Expression,
+		})
+	}
+
+	return outputs, nil
+}
+
+func (v *SpecValidator) validateHTTPMode(in *pb.ExecutorSpec_HTTP) (string, *models.HTTPStatusPolicy, error) {
+	switch in.Mode {
+	case pb.ExecutorSpec_HTTP_MODE_SYNC:
+		return models.HTTPExecutorModeSync, nil, nil
+
+	case pb.ExecutorSpec_HTTP_MODE_CALLBACK:
+		return models.HTTPExecutorModeCallback, nil, nil
+
+	case pb.ExecutorSpec_HTTP_MODE_POLLING:
+		policy := in.StatusPolicy
+		if policy == nil {
+			return "", nil, fmt.Errorf("invalid HTTP executor spec: missing status policy")
+		}
+
+		if policy.IdExpression == "" {
+			return "", nil, fmt.Errorf("invalid HTTP executor spec: missing status policy ID expression")
+		}
+
+		if policy.Url == "" {
+			return "", nil, fmt.Errorf("invalid HTTP executor spec: missing status policy URL")
+		}
+
+		if !strings.Contains(policy.Url, HTTPStatusURLIDPlaceholder) {
+			return "", nil, fmt.Errorf("invalid HTTP executor spec: status policy URL must include %s", HTTPStatusURLIDPlaceholder)
+		}
+
+		if policy.SuccessExpression == "" {
+			return "", nil, fmt.Errorf("invalid HTTP executor spec: missing status policy success expression")
+		}
+
+		return models.HTTPExecutorModePolling, &models.HTTPStatusPolicy{
+			IDExpression:      policy.IdExpression,
+			URL:               policy.Url,
+			SuccessExpression: policy.SuccessExpression,
+			FailureExpression: policy.FailureExpression,
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid HTTP executor spec: invalid mode %v", in.Mode)
+	}
+}
+
 func (v *SpecValidator) validateSemaphoreExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
 	if in.Semaphore == nil {
 		return nil, fmt.Errorf("invalid semaphore executor spec: missing semaphore executor spec")
@@ -87,8 +228,23 @@ func (v *SpecValidator) validateSemaphoreExecutorSpec(in *pb.ExecutorSpec) (*mod
 		return nil, fmt.Errorf("invalid semaphore executor spec: missing API token")
 	}
 
-	if in.Semaphore.TaskId == "" {
-		return nil, fmt.Errorf("invalid semaphore executor spec: only triggering tasks is supported for now")
+	//
+	// Promotions happen in a workflow that already exists.
+	// Tasks and workflows are run in a project, and tasks
+	// have a default branch configured in Semaphore, but workflows need one.
+	//
+	if in.Semaphore.TaskId == "" && in.Semaphore.Promotion != "" {
+		if in.Semaphore.WorkflowId == "" {
+			return nil, fmt.Errorf("invalid semaphore executor spec: missing workflow ID for promotion")
+		}
+	} else {
+		if in.Semaphore.ProjectId == "" {
+			return nil, fmt.Errorf("invalid semaphore executor spec: missing project ID")
+		}
+
+		if in.Semaphore.TaskId == "" && in.Semaphore.Branch == "" {
+			return nil, fmt.Errorf("invalid semaphore executor spec: missing branch")
+		}
 	}
 
 	return &models.ExecutorSpec{
@@ -101,6 +257,225 @@ func (v *SpecValidator) validateSemaphoreExecutorSpec(in *pb.ExecutorSpec) (*mod
 			PipelineFile:    in.Semaphore.PipelineFile,
 			Parameters:      in.Semaphore.Parameters,
 			TaskID:          in.Semaphore.TaskId,
+			WorkflowID:      in.Semaphore.WorkflowId,
+			Promotion:       in.Semaphore.Promotion,
+		},
+	}, nil
+}
+
+func (v *SpecValidator) validateGitHubExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	if in.Github == nil {
+		return nil, fmt.Errorf("invalid GitHub executor spec: missing GitHub executor spec")
+	}
+
+	if in.Github.Token == "" {
+		return nil, fmt.Errorf("invalid GitHub executor spec: missing token")
+	}
+
+	if in.Github.Owner == "" || in.Github.Repository == "" {
+		return nil, fmt.Errorf("invalid GitHub executor spec: missing owner or repository")
+	}
+
+	if in.Github.Workflow == "" {
+		return nil, fmt.Errorf("invalid GitHub executor spec: missing workflow")
+	}
+
+	if in.Github.Ref == "" {
+		return nil, fmt.Errorf("invalid GitHub executor spec: missing ref")
+	}
+
+	inputs := in.Github.Inputs
+	if inputs == nil {
+		inputs = map[string]string{}
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeGitHub,
+		GitHub: &models.GitHubExecutorSpec{
+			URL:             in.Github.Url,
+			Token:           in.Github.Token,
+			Owner:           in.Github.Owner,
+			Repository:      in.Github.Repository,
+			Workflow:        in.Github.Workflow,
+			Ref:             in.Github.Ref,
+			Inputs:          inputs,
+			ExecutionInputs: in.Github.ExecutionInputs,
+		},
+	}, nil
+}
+
+func (v *SpecValidator) validateGitLabExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	if in.Gitlab == nil {
+		return nil, fmt.Errorf("invalid GitLab executor spec: missing GitLab executor spec")
+	}
+
+	if in.Gitlab.Token == "" {
+		return nil, fmt.Errorf("invalid GitLab executor spec: missing token")
+	}
+
+	if in.Gitlab.ProjectId == "" {
+		return nil, fmt.Errorf("invalid GitLab executor spec: missing project ID")
+	}
+
+	if in.Gitlab.Ref == "" {
+		return nil, fmt.Errorf("invalid GitLab executor spec: missing ref")
+	}
+
+	variables := in.Gitlab.Variables
+	if variables == nil {
+		variables = map[string]string{}
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeGitLab,
+		GitLab: &models.GitLabExecutorSpec{
+			URL:       in.Gitlab.Url,
+			Token:     in.Gitlab.Token,
+			ProjectID: in.Gitlab.ProjectId,
+			Ref:       in.Gitlab.Ref,
+			Variables: variables,
+		},
+	}, nil
+}
+
+func (v *SpecValidator) validateKubernetesExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	if in.Kubernetes == nil {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: missing Kubernetes executor spec")
+	}
+
+	if in.Kubernetes.Namespace == "" {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: missing namespace")
+	}
+
+	if in.Kubernetes.Manifest == "" {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: missing manifest")
+	}
+
+	_, err := ParseKubernetesJobManifest(in.Kubernetes.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: %v", err)
+	}
+
+	ttl := in.Kubernetes.TtlSecondsAfterFinished
+	if ttl < 0 {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: TTL must be positive")
+	}
+
+	if ttl == 0 {
+		ttl = DefaultKubernetesJobTTL
+	}
+
+	if ttl < MinKubernetesJobTTL {
+		return nil, fmt.Errorf("invalid Kubernetes executor spec: TTL must be at least %d seconds", MinKubernetesJobTTL)
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeKubernetes,
+		Kubernetes: &models.KubernetesExecutorSpec{
+			URL:                     in.Kubernetes.Url,
+			Token:                   in.Kubernetes.Token,
+			CACertificate:           in.Kubernetes.CaCertificate,
+			Namespace:               in.Kubernetes.Namespace,
+			Manifest:                in.Kubernetes.Manifest,
+			TTLSecondsAfterFinished: ttl,
+		},
+	}, nil
+}
+
+// Plugin specs are validated by the plugin itself,
+// which can also give back a normalized version of its config.
+func (v *SpecValidator) validatePluginExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	if in.Plugin == nil {
+		return nil, fmt.Errorf("invalid plugin executor spec: missing plugin executor spec")
+	}
+
+	if in.Plugin.Name == "" {
+		return nil, fmt.Errorf("invalid plugin executor spec: missing name")
+	}
+
+	client, err := FindPlugin(in.Plugin.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin executor spec: %v", err)
+	}
+
+	config := in.Plugin.Config
+	if config == nil {
+		config = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), PluginRequestTimeout)
+	defer cancel()
+
+	res, err := client.Validate(ctx, &pluginspb.ValidateRequest{Spec: config})
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin executor spec: %s", status.Convert(err).Message())
+	}
+
+	if res.Spec != nil {
+		config = res.Spec
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypePlugin,
+		Plugin: &models.PluginExecutorSpec{
+			Name:   in.Plugin.Name,
+			Config: config.AsMap(),
+		},
+	}, nil
+}
+
+func (v *SpecValidator) validateProcessExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	if in.Process == nil {
+		return nil, fmt.Errorf("invalid process executor spec: missing process executor spec")
+	}
+
+	if in.Process.Agent == "" {
+		return nil, fmt.Errorf("invalid process executor spec: missing agent")
+	}
+
+	if in.Process.Command == "" {
+		return nil, fmt.Errorf("invalid process executor spec: missing command")
+	}
+
+	args := in.Process.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	env := in.Process.Env
+	if env == nil {
+		env = map[string]string{}
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeProcess,
+		Process: &models.ProcessExecutorSpec{
+			Agent:            in.Process.Agent,
+			Command:          in.Process.Command,
+			Args:             args,
+			Env:              env,
+			WorkingDirectory: in.Process.WorkingDirectory,
+		},
+	}, nil
+}
+
+// The noop spec is optional, since gates do not need any configuration.
+func (v *SpecValidator) validateNoopExecutorSpec(in *pb.ExecutorSpec) (*models.ExecutorSpec, error) {
+	outputs := map[string]string{}
+	if in.Noop != nil {
+		for name, value := range in.Noop.Outputs {
+			if name == "" {
+				return nil, fmt.Errorf("invalid noop executor spec: output name is required")
+			}
+
+			outputs[name] = value
+		}
+	}
+
+	return &models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeNoop,
+		Noop: &models.NoopExecutorSpec{
+			Outputs: outputs,
 		},
 	}, nil
 }
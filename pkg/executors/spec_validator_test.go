@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	pluginsmock "github.com/superplanehq/superplane/test/plugins"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func Test__SpecValidator(t *testing.T) {
@@ -59,4 +62,410 @@ func Test__SpecValidator(t *testing.T) {
 		_, err := validator.validateHTTPExecutorSpec(in)
 		require.NoError(t, err)
 	})
+
+	t.Run("HTTP spec in polling mode without status policy -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:  "https://httpbin.org/post",
+				Mode: pb.ExecutorSpec_HTTP_MODE_POLLING,
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing status policy")
+	})
+
+	t.Run("HTTP spec in polling mode with status URL without ID -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:  "https://httpbin.org/post",
+				Mode: pb.ExecutorSpec_HTTP_MODE_POLLING,
+				StatusPolicy: &pb.ExecutorSpec_HTTPStatusPolicy{
+					IdExpression:      "body.id",
+					Url:               "https://httpbin.org/get",
+					SuccessExpression: `body.state == "done"`,
+				},
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "status policy URL must include {id}")
+	})
+
+	t.Run("valid HTTP spec in polling mode -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:  "https://httpbin.org/post",
+				Mode: pb.ExecutorSpec_HTTP_MODE_POLLING,
+				StatusPolicy: &pb.ExecutorSpec_HTTPStatusPolicy{
+					IdExpression:      "body.id",
+					Url:               "https://httpbin.org/get/{id}",
+					SuccessExpression: `body.state == "done"`,
+					FailureExpression: `body.state == "failed"`,
+				},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, models.HTTPExecutorModePolling, spec.HTTP.Mode)
+		require.Equal(t, "body.id", spec.HTTP.StatusPolicy.IDExpression)
+	})
+
+	t.Run("HTTP spec with invalid method -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:    "https://httpbin.org/post",
+				Method: "CONNECT",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "invalid method CONNECT")
+	})
+
+	t.Run("HTTP spec with payload and body -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:     "https://httpbin.org/post",
+				Payload: map[string]string{"a": "b"},
+				Body:    `{"a": "b"}`,
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "payload and body cannot be used together")
+	})
+
+	t.Run("HTTP spec with body that is not JSON -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:  "https://httpbin.org/post",
+				Body: `deploy ${{ inputs.VERSION }}`,
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "body is not valid JSON")
+	})
+
+	t.Run("HTTP spec with JSON body using expressions outside strings -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:  "https://httpbin.org/post",
+				Body: `{"count": ${{ inputs.COUNT }}}`,
+			},
+		}
+		_, err := validator.Validate(in)
+		require.NoError(t, err)
+	})
+
+	t.Run("HTTP spec with text body and Content-Type header -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:     "https://httpbin.org/post",
+				Body:    `deploy ${{ inputs.VERSION }}`,
+				Headers: map[string]string{"Content-Type": "text/plain"},
+			},
+		}
+		_, err := validator.Validate(in)
+		require.NoError(t, err)
+	})
+
+	t.Run("HTTP spec with invalid output expression -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url: "https://httpbin.org/post",
+				Outputs: []*pb.ExecutorSpec_HTTPOutput{
+					{Name: "VERSION", Expression: "body.version ==="},
+				},
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "invalid expression for output VERSION")
+	})
+
+	t.Run("HTTP spec with method, body and outputs -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_HTTP,
+			Http: &pb.ExecutorSpec_HTTP{
+				Url:         "https://httpbin.org/put",
+				Method:      "put",
+				Body:        `{"version": "${{ inputs.VERSION }}"}`,
+				QueryParams: map[string]string{"ref": "main"},
+				Outputs: []*pb.ExecutorSpec_HTTPOutput{
+					{Name: "VERSION", Expression: "body.version"},
+				},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, "PUT", spec.HTTP.Method)
+		require.Equal(t, []models.HTTPOutput{{Name: "VERSION", Expression: "body.version"}}, spec.HTTP.Outputs)
+	})
+
+	t.Run("Semaphore workflow spec without branch -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_SEMAPHORE,
+			Semaphore: &pb.ExecutorSpec_Semaphore{
+				OrganizationUrl: "https://example.semaphoreci.com",
+				ApiToken:        "token",
+				ProjectId:       "project",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing branch")
+	})
+
+	t.Run("Semaphore promotion spec without workflow ID -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_SEMAPHORE,
+			Semaphore: &pb.ExecutorSpec_Semaphore{
+				OrganizationUrl: "https://example.semaphoreci.com",
+				ApiToken:        "token",
+				Promotion:       "Production",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing workflow ID for promotion")
+	})
+
+	t.Run("valid Semaphore promotion spec -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_SEMAPHORE,
+			Semaphore: &pb.ExecutorSpec_Semaphore{
+				OrganizationUrl: "https://example.semaphoreci.com",
+				ApiToken:        "token",
+				WorkflowId:      "${{ inputs.WORKFLOW_ID }}",
+				Promotion:       "Production",
+				Parameters:      map[string]string{"VERSION": "${{ inputs.VERSION }}"},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.True(t, spec.Semaphore.IsPromotion())
+		require.Equal(t, "Production", spec.Semaphore.Promotion)
+	})
+
+	t.Run("GitHub spec without workflow -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITHUB,
+			Github: &pb.ExecutorSpec_GitHub{
+				Token:      "token",
+				Owner:      "superplanehq",
+				Repository: "superplane",
+				Ref:        "main",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing workflow")
+	})
+
+	t.Run("valid GitHub spec -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITHUB,
+			Github: &pb.ExecutorSpec_GitHub{
+				Token:      "${{ secrets.GITHUB_TOKEN }}",
+				Owner:      "superplanehq",
+				Repository: "superplane",
+				Workflow:   "deploy.yml",
+				Ref:        "main",
+				Inputs:     map[string]string{"VERSION": "${{ inputs.VERSION }}"},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypeGitHub, spec.Type)
+		require.Equal(t, "deploy.yml", spec.GitHub.Workflow)
+	})
+
+	t.Run("GitLab spec without project ID -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITLAB,
+			Gitlab: &pb.ExecutorSpec_GitLab{
+				Token: "token",
+				Ref:   "main",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing project ID")
+	})
+
+	t.Run("valid GitLab spec -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITLAB,
+			Gitlab: &pb.ExecutorSpec_GitLab{
+				Token:     "${{ secrets.GITLAB_TOKEN }}",
+				ProjectId: "superplanehq/superplane",
+				Ref:       "main",
+				Variables: map[string]string{"VERSION": "${{ inputs.VERSION }}"},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypeGitLab, spec.Type)
+		require.Equal(t, "superplanehq/superplane", spec.GitLab.ProjectID)
+	})
+
+	t.Run("Kubernetes spec without namespace -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_KUBERNETES,
+			Kubernetes: &pb.ExecutorSpec_Kubernetes{
+				Manifest: "apiVersion: batch/v1\nkind: Job",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing namespace")
+	})
+
+	t.Run("Kubernetes spec with invalid manifest -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_KUBERNETES,
+			Kubernetes: &pb.ExecutorSpec_Kubernetes{
+				Namespace: "default",
+				Manifest:  "apiVersion: v1\nkind: Pod",
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "kind must be Job")
+	})
+
+	t.Run("Kubernetes spec with TTL below the minimum -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_KUBERNETES,
+			Kubernetes: &pb.ExecutorSpec_Kubernetes{
+				Namespace:               "default",
+				Manifest:                "apiVersion: batch/v1\nkind: Job\nspec:\n  template:\n    spec:\n      containers:\n        - name: job\n          image: alpine",
+				TtlSecondsAfterFinished: 10,
+			},
+		}
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "TTL must be at least 300 seconds")
+	})
+
+	t.Run("valid Kubernetes spec -> default TTL is used", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_KUBERNETES,
+			Kubernetes: &pb.ExecutorSpec_Kubernetes{
+				Namespace: "default",
+				Manifest:  "apiVersion: batch/v1\nkind: Job\nspec:\n  template:\n    spec:\n      containers:\n        - name: job\n          image: ${{ inputs.IMAGE }}",
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypeKubernetes, spec.Type)
+		require.Equal(t, int32(DefaultKubernetesJobTTL), spec.Kubernetes.TTLSecondsAfterFinished)
+	})
+
+	t.Run("plugin spec for plugin that is not registered -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type:   pb.ExecutorSpec_TYPE_PLUGIN,
+			Plugin: &pb.ExecutorSpec_Plugin{Name: "does-not-exist"},
+		}
+
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "plugin does-not-exist is not registered")
+	})
+
+	t.Run("plugin spec is validated by the plugin", func(t *testing.T) {
+		plugin := pluginsmock.NewExecutorPluginMock()
+		client, err := plugin.Start()
+		require.NoError(t, err)
+		defer plugin.Close()
+
+		RegisterPlugin("deployer", client)
+		defer UnregisterPlugin("deployer")
+
+		config, err := structpb.NewStruct(map[string]any{"target": ""})
+		require.NoError(t, err)
+
+		_, err = validator.Validate(&pb.ExecutorSpec{
+			Type:   pb.ExecutorSpec_TYPE_PLUGIN,
+			Plugin: &pb.ExecutorSpec_Plugin{Name: "deployer", Config: config},
+		})
+
+		require.ErrorContains(t, err, "target is required")
+
+		config, err = structpb.NewStruct(map[string]any{"target": "${{ inputs.TARGET }}"})
+		require.NoError(t, err)
+
+		spec, err := validator.Validate(&pb.ExecutorSpec{
+			Type:   pb.ExecutorSpec_TYPE_PLUGIN,
+			Plugin: &pb.ExecutorSpec_Plugin{Name: "deployer", Config: config},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypePlugin, spec.Type)
+		require.Equal(t, "deployer", spec.Plugin.Name)
+		require.Equal(t, map[string]any{"target": "${{ inputs.TARGET }}", "timeout": float64(60)}, spec.Plugin.Config)
+	})
+
+	t.Run("process spec without agent -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type:    pb.ExecutorSpec_TYPE_PROCESS,
+			Process: &pb.ExecutorSpec_Process{Command: "make"},
+		}
+
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing agent")
+	})
+
+	t.Run("process spec without command -> error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type:    pb.ExecutorSpec_TYPE_PROCESS,
+			Process: &pb.ExecutorSpec_Process{Agent: "builder"},
+		}
+
+		_, err := validator.Validate(in)
+		require.ErrorContains(t, err, "missing command")
+	})
+
+	t.Run("valid process spec -> no error", func(t *testing.T) {
+		in := &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_PROCESS,
+			Process: &pb.ExecutorSpec_Process{
+				Agent:   "builder",
+				Command: "make",
+				Args:    []string{"deploy", "VERSION=${{ inputs.VERSION }}"},
+			},
+		}
+
+		spec, err := validator.Validate(in)
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypeProcess, spec.Type)
+		require.Equal(t, "builder", spec.Process.Agent)
+		require.Equal(t, []string{"deploy", "VERSION=${{ inputs.VERSION }}"}, spec.Process.Args)
+		require.Equal(t, map[string]string{}, spec.Process.Env)
+	})
+
+	t.Run("noop spec without config -> no outputs", func(t *testing.T) {
+		spec, err := validator.Validate(&pb.ExecutorSpec{Type: pb.ExecutorSpec_TYPE_NOOP})
+		require.NoError(t, err)
+		require.Equal(t, models.ExecutorSpecTypeNoop, spec.Type)
+		require.Equal(t, map[string]string{}, spec.Noop.Outputs)
+	})
+
+	t.Run("noop spec with outputs -> no error", func(t *testing.T) {
+		spec, err := validator.Validate(&pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_NOOP,
+			Noop: &pb.ExecutorSpec_Noop{
+				Outputs: map[string]string{
+					"APPROVED": "true",
+					"VERSION":  "${{ inputs.VERSION }}",
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"APPROVED": "true", "VERSION": "${{ inputs.VERSION }}"}, spec.Noop.Outputs)
+	})
 }
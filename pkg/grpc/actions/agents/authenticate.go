@@ -0,0 +1,40 @@
+package agents
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Agents send their token as a bearer token in the authorization metadata.
+func Authenticate(ctx context.Context) (*models.Agent, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing agent token")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "missing agent token")
+	}
+
+	agent, err := models.FindAgentByToken(strings.TrimPrefix(values[0], "Bearer "))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid agent token")
+	}
+
+	return agent, nil
+}
+
+func findJobForAgent(agent *models.Agent, jobID string) (*models.AgentJob, error) {
+	job, err := models.FindAgentJobByID(jobID)
+	if err != nil || job.AgentID == nil || *job.AgentID != agent.ID {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+
+	return job, nil
+}
@@ -0,0 +1,77 @@
+package agents
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func CreateAgent(ctx context.Context, req *pb.CreateAgentRequest) (*pb.CreateAgentResponse, error) {
+	err := actions.ValidateUUIDs(req.CanvasIdOrName)
+	var canvas *models.Canvas
+	if err != nil {
+		canvas, err = models.FindCanvasByName(req.CanvasIdOrName)
+	} else {
+		canvas, err = models.FindCanvasByID(req.CanvasIdOrName)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "canvas not found")
+	}
+
+	if req.Agent == nil || req.Agent.Metadata == nil || req.Agent.Metadata.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent name is required")
+	}
+
+	err = actions.ValidateUUIDs(req.RequesterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid requester ID")
+	}
+
+	logger := logging.ForCanvas(canvas)
+	token, err := crypto.Base64String(32)
+	if err != nil {
+		logger.Errorf("Error generating agent token. Request: %v. Error: %v", req, err)
+		return nil, status.Error(codes.Internal, "error generating token")
+	}
+
+	agent, err := canvas.CreateAgent(req.Agent.Metadata.Name, req.RequesterId, token)
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		logger.Errorf("Error creating agent. Request: %v. Error: %v", req, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Infof("Created agent %s", agent.Name)
+
+	return &pb.CreateAgentResponse{
+		Agent: serializeAgent(agent),
+		Token: token,
+	}, nil
+}
+
+func serializeAgent(agent *models.Agent) *pb.Agent {
+	metadata := &pb.Agent_Metadata{
+		Id:        agent.ID.String(),
+		Name:      agent.Name,
+		CanvasId:  agent.CanvasID.String(),
+		CreatedAt: timestamppb.New(*agent.CreatedAt),
+	}
+
+	if agent.LastSeenAt != nil {
+		metadata.LastSeenAt = timestamppb.New(*agent.LastSeenAt)
+	}
+
+	return &pb.Agent{Metadata: metadata}
+}
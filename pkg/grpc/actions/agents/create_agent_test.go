@@ -0,0 +1,92 @@
+package agents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CreateAgent(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := CreateAgent(context.Background(), &protos.CreateAgentRequest{
+			CanvasIdOrName: uuid.New().String(),
+			RequesterId:    uuid.NewString(),
+			Agent:          &protos.Agent{Metadata: &protos.Agent_Metadata{Name: "test"}},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "canvas not found", s.Message())
+	})
+
+	t.Run("missing name -> error", func(t *testing.T) {
+		_, err := CreateAgent(context.Background(), &protos.CreateAgentRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			RequesterId:    uuid.NewString(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "agent name is required", s.Message())
+	})
+
+	t.Run("missing requester ID -> error", func(t *testing.T) {
+		_, err := CreateAgent(context.Background(), &protos.CreateAgentRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			Agent:          &protos.Agent{Metadata: &protos.Agent_Metadata{Name: "test"}},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "invalid requester ID", s.Message())
+	})
+
+	t.Run("name still not used -> agent is created", func(t *testing.T) {
+		response, err := CreateAgent(context.Background(), &protos.CreateAgentRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			RequesterId:    uuid.NewString(),
+			Agent:          &protos.Agent{Metadata: &protos.Agent_Metadata{Name: "test"}},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, response.Agent)
+		assert.NotEmpty(t, response.Token)
+		assert.NotEmpty(t, response.Agent.Metadata.Id)
+		assert.Equal(t, "test", response.Agent.Metadata.Name)
+		assert.Equal(t, r.Canvas.ID.String(), response.Agent.Metadata.CanvasId)
+		assert.NotNil(t, response.Agent.Metadata.CreatedAt)
+		assert.Nil(t, response.Agent.Metadata.LastSeenAt)
+
+		// only the token hash is stored
+		agent, err := models.FindAgentByToken(response.Token)
+		require.NoError(t, err)
+		assert.Equal(t, response.Agent.Metadata.Id, agent.ID.String())
+		assert.NotEqual(t, response.Token, agent.TokenHash)
+	})
+
+	t.Run("name already used -> error", func(t *testing.T) {
+		_, err := CreateAgent(context.Background(), &protos.CreateAgentRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			RequesterId:    uuid.NewString(),
+			Agent:          &protos.Agent{Metadata: &protos.Agent_Metadata{Name: "test"}},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "name already used", s.Message())
+	})
+}
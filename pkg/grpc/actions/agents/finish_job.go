@@ -0,0 +1,41 @@
+package agents
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FinishJob records the exit code of the job.
+// The execution itself is finished by the execution poller,
+// like for every other executor.
+func FinishJob(ctx context.Context, req *pb.FinishJobRequest) (*pb.FinishJobResponse, error) {
+	agent, err := Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := findJobForAgent(agent, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = job.Finish(int(req.ExitCode))
+	if err != nil {
+		if errors.Is(err, models.ErrAgentJobNotRunning) {
+			return nil, status.Error(codes.FailedPrecondition, "job is not running")
+		}
+
+		log.Errorf("Error finishing job %s: %v", job.ID, err)
+		return nil, status.Error(codes.Internal, "error finishing job")
+	}
+
+	log.Infof("Agent %s finished job %s with exit code %d", agent.Name, job.ID, req.ExitCode)
+
+	return &pb.FinishJobResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package agents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/executors"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__FinishJob(t *testing.T) {
+	r := support.Setup(t)
+	agent, err := r.Canvas.CreateAgent("agent-1", r.User.String(), "token-1")
+	require.NoError(t, err)
+
+	executor, err := executors.NewProcessExecutor(*support.CreateExecution(t, r.Source, r.Stage), nil, crypto.NewNoOpEncryptor())
+	require.NoError(t, err)
+
+	t.Run("exit code 0 -> execution check is successful", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+
+		response, err := executor.Check(r.Stage.ExecutorSpec.Data(), job.ID.String())
+		require.NoError(t, err)
+		assert.False(t, response.Finished())
+
+		_, err = FinishJob(agentContext("token-1"), &pb.FinishJobRequest{JobId: job.ID.String(), ExitCode: 0})
+		require.NoError(t, err)
+
+		response, err = executor.Check(r.Stage.ExecutorSpec.Data(), job.ID.String())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.True(t, response.Successful())
+
+		job, err = models.FindAgentJobByID(job.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.AgentJobFinished, job.State)
+		require.NotNil(t, job.ExitCode)
+		assert.Equal(t, 0, *job.ExitCode)
+		assert.NotNil(t, job.FinishedAt)
+	})
+
+	t.Run("non-zero exit code -> execution check is not successful", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+
+		_, err = FinishJob(agentContext("token-1"), &pb.FinishJobRequest{JobId: job.ID.String(), ExitCode: 2})
+		require.NoError(t, err)
+
+		job, err = models.FindAgentJobByID(job.ID.String())
+		require.NoError(t, err)
+		require.NotNil(t, job.ExitCode)
+		assert.Equal(t, 2, *job.ExitCode)
+
+		response, err := executor.Check(r.Stage.ExecutorSpec.Data(), job.ID.String())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+
+	t.Run("job is not running -> error", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+		require.NoError(t, executor.Cancel(r.Stage.ExecutorSpec.Data(), job.ID.String()))
+
+		cancelled, err := models.FindAgentJobByID(job.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.AgentJobCancelled, cancelled.State)
+		assert.NotNil(t, cancelled.FinishedAt)
+
+		_, err = FinishJob(agentContext("token-1"), &pb.FinishJobRequest{JobId: job.ID.String(), ExitCode: 0})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "job is not running", s.Message())
+
+		response, err := executor.Check(r.Stage.ExecutorSpec.Data(), job.ID.String())
+		require.NoError(t, err)
+		assert.True(t, response.Finished())
+		assert.False(t, response.Successful())
+	})
+}
@@ -0,0 +1,50 @@
+package agents
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// PullJob gives the agent its next pending job, if there is one.
+// Agents call it periodically, so no inbound connection to them is needed.
+func PullJob(ctx context.Context, encryptor crypto.Encryptor, req *pb.PullJobRequest) (*pb.PullJobResponse, error) {
+	agent, err := Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = agent.UpdateLastSeen()
+	if err != nil {
+		log.Errorf("Error updating last seen for agent %s: %v", agent.ID, err)
+	}
+
+	job, spec, err := agent.ClaimNextJob(encryptor)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pb.PullJobResponse{}, nil
+		}
+
+		log.Errorf("Error claiming job for agent %s: %v", agent.ID, err)
+		return nil, status.Error(codes.Internal, "error claiming job")
+	}
+
+	log.Infof("Agent %s claimed job %s for execution %s", agent.Name, job.ID, job.ExecutionID)
+
+	return &pb.PullJobResponse{
+		Job: &pb.Job{
+			Id:               job.ID.String(),
+			ExecutionId:      job.ExecutionID.String(),
+			Command:          spec.Command,
+			Args:             spec.Args,
+			Env:              spec.Env,
+			WorkingDirectory: spec.WorkingDirectory,
+		},
+	}, nil
+}
@@ -0,0 +1,125 @@
+package agents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testEncryptor = crypto.NewAESGCMEncryptor([]byte("0123456789abcdef0123456789abcdef"))
+
+func Test__PullJob(t *testing.T) {
+	r := support.Setup(t)
+	agent, err := r.Canvas.CreateAgent("agent-1", r.User.String(), "token-1")
+	require.NoError(t, err)
+
+	t.Run("no token -> error", func(t *testing.T) {
+		_, err := PullJob(context.Background(), testEncryptor, &pb.PullJobRequest{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+		assert.Equal(t, "missing agent token", s.Message())
+	})
+
+	t.Run("invalid token -> error", func(t *testing.T) {
+		_, err := PullJob(agentContext("not-a-token"), testEncryptor, &pb.PullJobRequest{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+		assert.Equal(t, "invalid agent token", s.Message())
+	})
+
+	t.Run("no pending jobs -> empty response", func(t *testing.T) {
+		response, err := PullJob(agentContext("token-1"), testEncryptor, &pb.PullJobRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, response.Job)
+
+		agent, err := models.FindAgentByToken("token-1")
+		require.NoError(t, err)
+		assert.NotNil(t, agent.LastSeenAt)
+	})
+
+	t.Run("pending job -> job is claimed", func(t *testing.T) {
+		job := createAgentJob(t, r, agent.Name)
+
+		response, err := PullJob(agentContext("token-1"), testEncryptor, &pb.PullJobRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, response.Job)
+		assert.Equal(t, job.ID.String(), response.Job.Id)
+		assert.Equal(t, job.ExecutionID.String(), response.Job.ExecutionId)
+		assert.Equal(t, "sh", response.Job.Command)
+		assert.Equal(t, []string{"-c", "echo hello"}, response.Job.Args)
+		assert.Equal(t, map[string]string{"VERSION": "v1"}, response.Job.Env)
+
+		job, err = models.FindAgentJobByID(job.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.AgentJobRunning, job.State)
+		assert.NotContains(t, string(job.Spec), "VERSION")
+		assert.Equal(t, agent.ID, *job.AgentID)
+
+		// job is not given out twice
+		response, err = PullJob(agentContext("token-1"), testEncryptor, &pb.PullJobRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, response.Job)
+	})
+
+	t.Run("job spec cannot be decrypted -> job remains pending", func(t *testing.T) {
+		job := createAgentJob(t, r, agent.Name)
+		otherEncryptor := crypto.NewAESGCMEncryptor([]byte("abcdef0123456789abcdef0123456789"))
+
+		_, err := PullJob(agentContext("token-1"), otherEncryptor, &pb.PullJobRequest{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, s.Code())
+
+		job, err = models.FindAgentJobByID(job.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.AgentJobPending, job.State)
+		assert.Nil(t, job.AgentID)
+
+		response, err := PullJob(agentContext("token-1"), testEncryptor, &pb.PullJobRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, response.Job)
+		assert.Equal(t, job.ID.String(), response.Job.Id)
+	})
+
+	t.Run("job for another agent -> not claimed", func(t *testing.T) {
+		createAgentJob(t, r, "agent-2")
+
+		response, err := PullJob(agentContext("token-1"), testEncryptor, &pb.PullJobRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, response.Job)
+	})
+}
+
+func agentContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func createAgentJob(t *testing.T, r *support.ResourceRegistry, agentName string) *models.AgentJob {
+	execution := support.CreateExecution(t, r.Source, r.Stage)
+	job, err := models.CreateAgentJob(testEncryptor, r.Canvas.ID, execution.ID, agentName, models.AgentJobSpec{
+		Command: "sh",
+		Args:    []string{"-c", "echo hello"},
+		Env:     map[string]string{"VERSION": "v1"},
+	})
+
+	require.NoError(t, err)
+	return job
+}
+
+func claimAgentJob(t *testing.T, r *support.ResourceRegistry, agent *models.Agent) *models.AgentJob {
+	createAgentJob(t, r, agent.Name)
+	job, _, err := agent.ClaimNextJob(testEncryptor)
+	require.NoError(t, err)
+	return job
+}
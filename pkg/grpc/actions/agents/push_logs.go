@@ -0,0 +1,55 @@
+package agents
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PushLogs stores the output of the job as execution logs.
+// The response tells the agent if the job was cancelled,
+// so it can stop the process.
+func PushLogs(ctx context.Context, req *pb.PushLogsRequest) (*pb.PushLogsResponse, error) {
+	agent, err := Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := findJobForAgent(agent, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []models.ExecutionLog{}
+	for _, chunk := range req.Chunks {
+		if chunk.Content == "" {
+			continue
+		}
+
+		logs = append(logs, models.ExecutionLog{
+			Stream:  protoToLogStream(chunk.Stream),
+			Content: chunk.Content,
+		})
+	}
+
+	err = models.CreateExecutionLogs(job.ExecutionID, logs)
+	if err != nil {
+		log.Errorf("Error storing logs for job %s: %v", job.ID, err)
+		return nil, status.Error(codes.Internal, "error storing logs")
+	}
+
+	return &pb.PushLogsResponse{Cancelled: job.State == models.AgentJobCancelled}, nil
+}
+
+func protoToLogStream(stream pb.LogChunk_Stream) string {
+	switch stream {
+	case pb.LogChunk_STREAM_STDERR:
+		return models.ExecutionLogStreamStderr
+	default:
+		return models.ExecutionLogStreamStdout
+	}
+}
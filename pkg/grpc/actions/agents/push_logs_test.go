@@ -0,0 +1,70 @@
+package agents
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__PushLogs(t *testing.T) {
+	r := support.Setup(t)
+	agent, err := r.Canvas.CreateAgent("agent-1", r.User.String(), "token-1")
+	require.NoError(t, err)
+	_, err = r.Canvas.CreateAgent("agent-2", r.User.String(), "token-2")
+	require.NoError(t, err)
+
+	t.Run("job does not exist -> error", func(t *testing.T) {
+		_, err := PushLogs(agentContext("token-1"), &pb.PushLogsRequest{JobId: uuid.NewString()})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+		assert.Equal(t, "job not found", s.Message())
+	})
+
+	t.Run("job claimed by another agent -> error", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+		_, err := PushLogs(agentContext("token-2"), &pb.PushLogsRequest{JobId: job.ID.String()})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("logs are stored for the execution", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+		response, err := PushLogs(agentContext("token-1"), &pb.PushLogsRequest{
+			JobId: job.ID.String(),
+			Chunks: []*pb.LogChunk{
+				{Stream: pb.LogChunk_STREAM_STDOUT, Content: "hello\n"},
+				{Stream: pb.LogChunk_STREAM_STDERR, Content: "oops\n"},
+				{Stream: pb.LogChunk_STREAM_STDOUT, Content: ""},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, response.Cancelled)
+
+		logs, err := models.ListExecutionLogs(job.ExecutionID, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, models.ExecutionLogStreamStdout, logs[0].Stream)
+		assert.Equal(t, "hello\n", logs[0].Content)
+		assert.Equal(t, models.ExecutionLogStreamStderr, logs[1].Stream)
+		assert.Equal(t, "oops\n", logs[1].Content)
+	})
+
+	t.Run("job was cancelled -> agent is told", func(t *testing.T) {
+		job := claimAgentJob(t, r, agent)
+		require.NoError(t, job.Cancel())
+
+		response, err := PushLogs(agentContext("token-1"), &pb.PushLogsRequest{JobId: job.ID.String()})
+		require.NoError(t, err)
+		assert.True(t, response.Cancelled)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	pbSuperplane "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ValidateUUIDs(ids ...string) error {
@@ -25,7 +26,68 @@ func ExecutionResultToProto(result string) pbSuperplane.Execution_Result {
 		return pbSuperplane.Execution_RESULT_FAILED
 	case models.StageExecutionResultPassed:
 		return pbSuperplane.Execution_RESULT_PASSED
+	case models.StageExecutionResultCancelled:
+		return pbSuperplane.Execution_RESULT_CANCELLED
 	default:
 		return pbSuperplane.Execution_RESULT_UNKNOWN
 	}
 }
+
+func ExecutionResultReasonToProto(reason string) pbSuperplane.Execution_ResultReason {
+	switch reason {
+	case models.StageExecutionResultReasonTimeout:
+		return pbSuperplane.Execution_RESULT_REASON_TIMEOUT
+	case models.StageExecutionResultReasonError:
+		return pbSuperplane.Execution_RESULT_REASON_ERROR
+	default:
+		return pbSuperplane.Execution_RESULT_REASON_NONE
+	}
+}
+
+func ExecutionStateToProto(state string) pbSuperplane.Execution_State {
+	switch state {
+	case models.StageExecutionPending:
+		return pbSuperplane.Execution_STATE_PENDING
+	case models.StageExecutionStarted:
+		return pbSuperplane.Execution_STATE_STARTED
+	case models.StageExecutionFinished:
+		return pbSuperplane.Execution_STATE_FINISHED
+	default:
+		return pbSuperplane.Execution_STATE_UNKNOWN
+	}
+}
+
+func SerializeExecution(execution *models.StageExecution) *pbSuperplane.Execution {
+	e := &pbSuperplane.Execution{
+		Id:           execution.ID.String(),
+		ReferenceId:  execution.ReferenceID,
+		State:        ExecutionStateToProto(execution.State),
+		Result:       ExecutionResultToProto(execution.Result),
+		ResultReason: ExecutionResultReasonToProto(execution.ResultReason),
+		CreatedAt:    timestamppb.New(*execution.CreatedAt),
+		Outputs:      []*pbSuperplane.OutputValue{},
+		Attempt:      uint32(execution.Attempt),
+	}
+
+	if execution.PreviousExecutionID != nil {
+		e.PreviousExecutionId = execution.PreviousExecutionID.String()
+	}
+
+	if execution.ScheduledAt != nil {
+		e.ScheduledAt = timestamppb.New(*execution.ScheduledAt)
+	}
+
+	if execution.StartedAt != nil {
+		e.StartedAt = timestamppb.New(*execution.StartedAt)
+	}
+
+	if execution.FinishedAt != nil {
+		e.FinishedAt = timestamppb.New(*execution.FinishedAt)
+	}
+
+	for k, v := range execution.Outputs.Data() {
+		e.Outputs = append(e.Outputs, &pbSuperplane.OutputValue{Name: k, Value: v.(string)})
+	}
+
+	return e
+}
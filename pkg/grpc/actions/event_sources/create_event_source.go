@@ -3,17 +3,23 @@ package eventsources
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/pkg/webhooks"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func CreateEventSource(ctx context.Context, encryptor crypto.Encryptor, req *pb.CreateEventSourceRequest) (*pb.CreateEventSourceResponse, error) {
@@ -35,6 +41,11 @@ func CreateEventSource(ctx context.Context, encryptor crypto.Encryptor, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "event source name is required")
 	}
 
+	spec, err := validateEventSourceSpec(req.EventSource.Spec)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	plainKey, encryptedKey, err := genNewEventSourceKey(ctx, encryptor, req.EventSource.Metadata.Name)
 	if err != nil {
 		logger.Errorf("Error generating event source key. Request: %v. Error: %v", req, err)
@@ -45,7 +56,16 @@ func CreateEventSource(ctx context.Context, encryptor crypto.Encryptor, req *pb.
 	// using Notifications API for semaphore event sources. This webhook should point
 	// to the created secret, as designed in the API.
 
-	eventSource, err := canvas.CreateEventSource(req.EventSource.Metadata.Name, encryptedKey)
+	var eventSource *models.EventSource
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		eventSource, err = canvas.CreateEventSourceInTransaction(tx, req.EventSource.Metadata.Name, encryptedKey, *spec)
+		if err != nil {
+			return err
+		}
+
+		return messages.NewEventSourceCreatedMessage(eventSource).PublishInTransaction(tx)
+	})
+
 	if err != nil {
 		if errors.Is(err, models.ErrNameAlreadyUsed) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -62,12 +82,6 @@ func CreateEventSource(ctx context.Context, encryptor crypto.Encryptor, req *pb.
 
 	logger.Infof("Created event source. Request: %v", req)
 
-	err = messages.NewEventSourceCreatedMessage(eventSource).Publish()
-
-	if err != nil {
-		logger.Errorf("failed to publish event source created message: %v", err)
-	}
-
 	return response, nil
 }
 
@@ -79,7 +93,196 @@ func serializeEventSource(eventSource models.EventSource) *pb.EventSource {
 			CanvasId:  eventSource.CanvasID.String(),
 			CreatedAt: timestamppb.New(*eventSource.CreatedAt),
 		},
-		Spec: &pb.EventSource_Spec{},
+		Spec: &pb.EventSource_Spec{
+			Webhook:  serializeWebhook(eventSource.Spec.Data().Webhook),
+			Schedule: serializeSchedule(eventSource),
+		},
+	}
+}
+
+func validateEventSourceSpec(in *pb.EventSource_Spec) (*models.EventSourceSpec, error) {
+	spec := &models.EventSourceSpec{}
+	if in == nil {
+		return spec, nil
+	}
+
+	if in.Webhook != nil && in.Schedule != nil {
+		return nil, fmt.Errorf("event source cannot have both a webhook and a schedule")
+	}
+
+	if in.Webhook != nil {
+		webhook, err := validateWebhook(in.Webhook)
+		if err != nil {
+			return nil, err
+		}
+
+		spec.Webhook = webhook
+	}
+
+	if in.Schedule != nil {
+		schedule, err := validateSchedule(in.Schedule)
+		if err != nil {
+			return nil, err
+		}
+
+		spec.Schedule = schedule
+	}
+
+	return spec, nil
+}
+
+func validateSchedule(in *pb.EventSource_Schedule) (*models.ScheduleSpec, error) {
+	if in.Cron == "" {
+		return nil, fmt.Errorf("schedule cron expression is required")
+	}
+
+	schedule := &models.ScheduleSpec{
+		Cron:     in.Cron,
+		Timezone: in.Timezone,
+		Payload:  in.Payload.AsMap(),
+	}
+
+	if _, err := schedule.NextFireAt(time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid schedule: %v", err)
+	}
+
+	if _, err := schedule.BuildPayload(time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid schedule payload: %v", err)
+	}
+
+	return schedule, nil
+}
+
+func serializeSchedule(eventSource models.EventSource) *pb.EventSource_Schedule {
+	in := eventSource.Spec.Data().Schedule
+	if in == nil {
+		return nil
+	}
+
+	schedule := &pb.EventSource_Schedule{
+		Cron:     in.Cron,
+		Timezone: in.Timezone,
+	}
+
+	if payload, err := structpb.NewStruct(in.Payload); err == nil {
+		schedule.Payload = payload
+	}
+
+	if eventSource.LastFiredAt != nil {
+		schedule.LastFiredAt = timestamppb.New(*eventSource.LastFiredAt)
+	}
+
+	if eventSource.NextFireAt != nil {
+		schedule.NextFireAt = timestamppb.New(*eventSource.NextFireAt)
+	}
+
+	return schedule
+}
+
+func validateWebhook(in *pb.EventSource_Webhook) (*models.WebhookSpec, error) {
+	algorithm, err := protoToWebhookAlgorithm(in.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	encoding := models.WebhookEncodingHex
+	if in.Encoding == pb.EventSource_Webhook_ENCODING_BASE64 {
+		encoding = models.WebhookEncodingBase64
+	}
+
+	signedContent := models.WebhookSignedContentBody
+	if in.SignedContent == pb.EventSource_Webhook_SIGNED_CONTENT_TIMESTAMP_AND_BODY {
+		if algorithm == models.WebhookAlgorithmBearer || algorithm == models.WebhookAlgorithmBasic {
+			return nil, fmt.Errorf("webhook signed content can only include the timestamp for HMAC algorithms")
+		}
+
+		if in.TimestampHeader == "" {
+			return nil, fmt.Errorf("webhook timestamp header is required when the signed content includes the timestamp")
+		}
+
+		signedContent = models.WebhookSignedContentTimestampAndBody
+	}
+
+	//
+	// The timestamp can only be trusted if it is signed,
+	// otherwise a captured request can be sent again with a new timestamp.
+	//
+	if in.ReplayWindow > 0 && signedContent != models.WebhookSignedContentTimestampAndBody {
+		return nil, fmt.Errorf("webhook replay window requires an HMAC algorithm with signed content including the timestamp")
+	}
+
+	spec := webhooks.WithDefaults(models.WebhookSpec{
+		Algorithm:           algorithm,
+		SignatureHeader:     in.SignatureHeader,
+		SignaturePrefix:     in.SignaturePrefix,
+		Encoding:            encoding,
+		SignedContent:       signedContent,
+		SignedContentPrefix: in.SignedContentPrefix,
+		TimestampHeader:     in.TimestampHeader,
+		TimestampSeparator:  in.TimestampSeparator,
+		ReplayWindow:        int(in.ReplayWindow),
+	})
+
+	return &spec, nil
+}
+
+func protoToWebhookAlgorithm(in pb.EventSource_Webhook_Algorithm) (string, error) {
+	switch in {
+	case pb.EventSource_Webhook_ALGORITHM_HMAC_SHA256:
+		return models.WebhookAlgorithmHMACSHA256, nil
+	case pb.EventSource_Webhook_ALGORITHM_HMAC_SHA1:
+		return models.WebhookAlgorithmHMACSHA1, nil
+	case pb.EventSource_Webhook_ALGORITHM_HMAC_SHA512:
+		return models.WebhookAlgorithmHMACSHA512, nil
+	case pb.EventSource_Webhook_ALGORITHM_BEARER:
+		return models.WebhookAlgorithmBearer, nil
+	case pb.EventSource_Webhook_ALGORITHM_BASIC:
+		return models.WebhookAlgorithmBasic, nil
+	default:
+		return "", fmt.Errorf("invalid webhook algorithm: %v", in)
+	}
+}
+
+func webhookAlgorithmToProto(in string) pb.EventSource_Webhook_Algorithm {
+	switch in {
+	case models.WebhookAlgorithmHMACSHA1:
+		return pb.EventSource_Webhook_ALGORITHM_HMAC_SHA1
+	case models.WebhookAlgorithmHMACSHA512:
+		return pb.EventSource_Webhook_ALGORITHM_HMAC_SHA512
+	case models.WebhookAlgorithmBearer:
+		return pb.EventSource_Webhook_ALGORITHM_BEARER
+	case models.WebhookAlgorithmBasic:
+		return pb.EventSource_Webhook_ALGORITHM_BASIC
+	default:
+		return pb.EventSource_Webhook_ALGORITHM_HMAC_SHA256
+	}
+}
+
+func serializeWebhook(in *models.WebhookSpec) *pb.EventSource_Webhook {
+	if in == nil {
+		return nil
+	}
+
+	encoding := pb.EventSource_Webhook_ENCODING_HEX
+	if in.Encoding == models.WebhookEncodingBase64 {
+		encoding = pb.EventSource_Webhook_ENCODING_BASE64
+	}
+
+	signedContent := pb.EventSource_Webhook_SIGNED_CONTENT_BODY
+	if in.SignedContent == models.WebhookSignedContentTimestampAndBody {
+		signedContent = pb.EventSource_Webhook_SIGNED_CONTENT_TIMESTAMP_AND_BODY
+	}
+
+	return &pb.EventSource_Webhook{
+		Algorithm:           webhookAlgorithmToProto(in.Algorithm),
+		SignatureHeader:     in.SignatureHeader,
+		SignaturePrefix:     in.SignaturePrefix,
+		Encoding:            encoding,
+		SignedContent:       signedContent,
+		SignedContentPrefix: in.SignedContentPrefix,
+		TimestampHeader:     in.TimestampHeader,
+		TimestampSeparator:  in.TimestampSeparator,
+		ReplayWindow:        uint32(in.ReplayWindow),
 	}
 }
 
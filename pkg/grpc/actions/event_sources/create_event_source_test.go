@@ -15,6 +15,7 @@ import (
 	testconsumer "github.com/superplanehq/superplane/test/test_consumer"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const EventSourceCreatedRoutingKey = "event-source-created"
@@ -69,6 +70,7 @@ func Test__CreateEventSource(t *testing.T) {
 		assert.NotEmpty(t, response.Key)
 		assert.Equal(t, "test", response.EventSource.Metadata.Name)
 		assert.Equal(t, r.Canvas.ID.String(), response.EventSource.Metadata.CanvasId)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
@@ -90,4 +92,110 @@ func Test__CreateEventSource(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, s.Code())
 		assert.Equal(t, "name already used", s.Message())
 	})
+
+	t.Run("webhook with replay window and unsigned timestamp -> error", func(t *testing.T) {
+		webhooks := []*protos.EventSource_Webhook{
+			{ReplayWindow: 300},
+			{ReplayWindow: 300, TimestampHeader: "X-Timestamp"},
+			{ReplayWindow: 300, TimestampHeader: "X-Timestamp", Algorithm: protos.EventSource_Webhook_ALGORITHM_BEARER},
+			{ReplayWindow: 300, TimestampHeader: "X-Timestamp", Algorithm: protos.EventSource_Webhook_ALGORITHM_BASIC},
+		}
+
+		for _, webhook := range webhooks {
+			_, err := CreateEventSource(context.Background(), encryptor, &protos.CreateEventSourceRequest{
+				CanvasIdOrName: r.Canvas.Name,
+				EventSource: &protos.EventSource{
+					Metadata: &protos.EventSource_Metadata{Name: "webhook-invalid"},
+					Spec:     &protos.EventSource_Spec{Webhook: webhook},
+				},
+			})
+
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code())
+			assert.Equal(t, "webhook replay window requires an HMAC algorithm with signed content including the timestamp", s.Message())
+		}
+	})
+
+	t.Run("webhook source -> webhook spec is returned with defaults", func(t *testing.T) {
+		eventSource := &protos.EventSource{
+			Metadata: &protos.EventSource_Metadata{
+				Name: "webhook",
+			},
+			Spec: &protos.EventSource_Spec{
+				Webhook: &protos.EventSource_Webhook{
+					Algorithm: protos.EventSource_Webhook_ALGORITHM_HMAC_SHA512,
+					Encoding:  protos.EventSource_Webhook_ENCODING_BASE64,
+				},
+			},
+		}
+
+		response, err := CreateEventSource(context.Background(), encryptor, &protos.CreateEventSourceRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventSource:    eventSource,
+		})
+
+		require.NoError(t, err)
+		webhook := response.EventSource.Spec.Webhook
+		require.NotNil(t, webhook)
+		assert.Equal(t, protos.EventSource_Webhook_ALGORITHM_HMAC_SHA512, webhook.Algorithm)
+		assert.Equal(t, protos.EventSource_Webhook_ENCODING_BASE64, webhook.Encoding)
+		assert.Equal(t, protos.EventSource_Webhook_SIGNED_CONTENT_BODY, webhook.SignedContent)
+		assert.Equal(t, "X-Signature", webhook.SignatureHeader)
+	})
+
+	t.Run("schedule with invalid cron expression -> error", func(t *testing.T) {
+		eventSource := &protos.EventSource{
+			Metadata: &protos.EventSource_Metadata{
+				Name: "schedule-invalid",
+			},
+			Spec: &protos.EventSource_Spec{
+				Schedule: &protos.EventSource_Schedule{
+					Cron: "every night",
+				},
+			},
+		}
+
+		_, err := CreateEventSource(context.Background(), encryptor, &protos.CreateEventSourceRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventSource:    eventSource,
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Contains(t, s.Message(), "invalid schedule")
+	})
+
+	t.Run("scheduled source -> schedule is returned with next fire time", func(t *testing.T) {
+		payload, err := structpb.NewStruct(map[string]any{"date": "${{ schedule.date }}"})
+		require.NoError(t, err)
+
+		eventSource := &protos.EventSource{
+			Metadata: &protos.EventSource_Metadata{
+				Name: "nightly",
+			},
+			Spec: &protos.EventSource_Spec{
+				Schedule: &protos.EventSource_Schedule{
+					Cron:     "0 2 * * *",
+					Timezone: "Europe/Belgrade",
+					Payload:  payload,
+				},
+			},
+		}
+
+		response, err := CreateEventSource(context.Background(), encryptor, &protos.CreateEventSourceRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventSource:    eventSource,
+		})
+
+		require.NoError(t, err)
+		schedule := response.EventSource.Spec.Schedule
+		require.NotNil(t, schedule)
+		assert.Equal(t, "0 2 * * *", schedule.Cron)
+		assert.Equal(t, "Europe/Belgrade", schedule.Timezone)
+		assert.Equal(t, "${{ schedule.date }}", schedule.Payload.AsMap()["date"])
+		assert.NotNil(t, schedule.NextFireAt)
+		assert.Nil(t, schedule.LastFiredAt)
+	})
 }
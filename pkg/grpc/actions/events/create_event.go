@@ -0,0 +1,146 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Same limit as the one for events received through webhooks.
+const MaxEventSize = 64 * 1024
+
+func CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.CreateEventResponse, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	triggeredBy, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	canvas, err := findCanvas(req.CanvasIdOrName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "canvas not found")
+	}
+
+	logger := logging.ForCanvas(canvas)
+	source, err := findEventSource(canvas, req.EventSourceIdOrName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "event source not found")
+		}
+
+		logger.Errorf("Error finding event source %s. Error: %v", req.EventSourceIdOrName, err)
+		return nil, status.Error(codes.Internal, "error finding event source")
+	}
+
+	raw, err := json.Marshal(req.Payload.AsMap())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payload")
+	}
+
+	if len(raw) > MaxEventSize {
+		return nil, status.Errorf(codes.InvalidArgument, "payload is too large - must be up to %d bytes", MaxEventSize)
+	}
+
+	//
+	// Headers are stored the same way they are for events received through webhooks,
+	// so connection filters work the same way for both.
+	//
+	canonicalHeaders := make(map[string]string, len(req.Headers))
+	for k, v := range req.Headers {
+		canonicalHeaders[http.CanonicalHeaderKey(k)] = v
+	}
+
+	headers, err := json.Marshal(canonicalHeaders)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid headers")
+	}
+
+	event, err := models.CreateTriggeredEvent(source, raw, headers, triggeredBy)
+	if err != nil {
+		logger.Errorf("Error creating event for source %s. Request: %v. Error: %v", source.ID, req, err)
+		return nil, status.Error(codes.Internal, "error creating event")
+	}
+
+	logger.Infof("User %s emitted event %s for source %s", triggeredBy, event.ID, source.ID)
+
+	return &pb.CreateEventResponse{Event: serializeEvent(*event)}, nil
+}
+
+func findCanvas(canvasIDOrName string) (*models.Canvas, error) {
+	if err := actions.ValidateUUIDs(canvasIDOrName); err != nil {
+		return models.FindCanvasByName(canvasIDOrName)
+	}
+
+	return models.FindCanvasByID(canvasIDOrName)
+}
+
+func findEventSource(canvas *models.Canvas, idOrName string) (*models.EventSource, error) {
+	ID, err := uuid.Parse(idOrName)
+	if err != nil {
+		return canvas.FindEventSourceByName(idOrName)
+	}
+
+	return canvas.FindEventSourceByID(ID)
+}
+
+// Events received from webhooks can have any JSON payload,
+// so the payload and headers are only included if they are JSON objects.
+func serializeEvent(event models.Event) *pb.Event {
+	e := &pb.Event{
+		Id:         event.ID.String(),
+		SourceId:   event.SourceID.String(),
+		SourceName: event.SourceName,
+		State:      eventStateToProto(event.State),
+		ReceivedAt: timestamppb.New(*event.ReceivedAt),
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(event.Raw, &payload); err == nil {
+		e.Payload, _ = structpb.NewStruct(payload)
+	}
+
+	headers := map[string]string{}
+	if err := json.Unmarshal(event.Headers, &headers); err == nil {
+		e.Headers = headers
+	}
+
+	if event.TriggeredBy != nil {
+		e.TriggeredBy = event.TriggeredBy.String()
+	}
+
+	if event.ReplayOf != nil {
+		e.ReplayOf = event.ReplayOf.String()
+	}
+
+	return e
+}
+
+func eventStateToProto(state string) pb.Event_State {
+	switch state {
+	case models.EventStatePending:
+		return pb.Event_STATE_PENDING
+	case models.EventStateProcessed:
+		return pb.Event_STATE_PROCESSED
+	case models.EventStateDiscarded:
+		return pb.Event_STATE_DISCARDED
+	default:
+		return pb.Event_STATE_UNKNOWN
+	}
+}
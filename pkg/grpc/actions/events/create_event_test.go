@@ -0,0 +1,87 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__CreateEvent(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{Source: true})
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	payload, err := structpb.NewStruct(map[string]any{"ref": "refs/heads/main"})
+	require.NoError(t, err)
+
+	t.Run("no authenticated user -> error", func(t *testing.T) {
+		_, err := CreateEvent(context.Background(), &protos.CreateEventRequest{
+			CanvasIdOrName:      r.Canvas.Name,
+			EventSourceIdOrName: r.Source.Name,
+			Payload:             payload,
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := CreateEvent(ctx, &protos.CreateEventRequest{
+			CanvasIdOrName:      uuid.New().String(),
+			EventSourceIdOrName: r.Source.Name,
+			Payload:             payload,
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "canvas not found", s.Message())
+	})
+
+	t.Run("event source does not exist -> error", func(t *testing.T) {
+		_, err := CreateEvent(ctx, &protos.CreateEventRequest{
+			CanvasIdOrName:      r.Canvas.Name,
+			EventSourceIdOrName: "does-not-exist",
+			Payload:             payload,
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+		assert.Equal(t, "event source not found", s.Message())
+	})
+
+	t.Run("event is created with who triggered it", func(t *testing.T) {
+		response, err := CreateEvent(ctx, &protos.CreateEventRequest{
+			CanvasIdOrName:      r.Canvas.Name,
+			EventSourceIdOrName: r.Source.ID.String(),
+			Payload:             payload,
+			Headers:             map[string]string{"x-github-event": "push"},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, response.Event)
+		assert.Equal(t, r.Source.ID.String(), response.Event.SourceId)
+		assert.Equal(t, protos.Event_STATE_PENDING, response.Event.State)
+		assert.Equal(t, r.User.String(), response.Event.TriggeredBy)
+		assert.Equal(t, "refs/heads/main", response.Event.Payload.AsMap()["ref"])
+		assert.Equal(t, "push", response.Event.Headers["X-Github-Event"])
+
+		events, err := models.ListEventsBySourceID(r.Source.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.NotNil(t, events[0].TriggeredBy)
+		assert.Equal(t, r.User, *events[0].TriggeredBy)
+		assert.Equal(t, models.SourceTypeEventSource, events[0].SourceType)
+	})
+}
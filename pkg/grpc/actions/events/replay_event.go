@@ -0,0 +1,168 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ReplayEvent(ctx context.Context, req *pb.ReplayEventRequest) (*pb.ReplayEventResponse, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	triggeredBy, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	canvas, err := findCanvas(req.CanvasIdOrName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "canvas not found")
+	}
+
+	eventID, err := uuid.Parse(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid event ID")
+	}
+
+	logger := logging.ForCanvas(canvas)
+	event, err := findEvent(canvas, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "event not found")
+		}
+
+		logger.Errorf("Error finding event %s. Error: %v", eventID, err)
+		return nil, status.Error(codes.Internal, "error finding event")
+	}
+
+	if event.State == models.EventStatePending {
+		return nil, status.Error(codes.FailedPrecondition, "event is still pending")
+	}
+
+	var stageID *uuid.UUID
+	if req.StageIdOrName != "" {
+		stage, err := findConnectedStage(canvas, event, req.StageIdOrName)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+
+			logger.Errorf("Error finding stage %s for event %s. Error: %v", req.StageIdOrName, event.ID, err)
+			return nil, status.Error(codes.Internal, "error finding stage")
+		}
+
+		stageID = &stage.ID
+	}
+
+	//
+	// Re-deliveries only go to stages that received the original event,
+	// so we fail early if there is nothing to re-deliver.
+	//
+	if !req.UseCurrentSpec {
+		err := checkEventWasDelivered(event, stageID)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+
+			logger.Errorf("Error checking deliveries for event %s. Error: %v", event.ID, err)
+			return nil, status.Error(codes.Internal, "error replaying event")
+		}
+	}
+
+	replay, err := event.CreateReplay(stageID, req.UseCurrentSpec, triggeredBy)
+	if err != nil {
+		logger.Errorf("Error replaying event %s. Request: %v. Error: %v", event.ID, req, err)
+		return nil, status.Error(codes.Internal, "error replaying event")
+	}
+
+	logger.Infof("User %s replayed event %s as %s", triggeredBy, event.ID, replay.ID)
+
+	return &pb.ReplayEventResponse{Event: serializeEvent(*replay)}, nil
+}
+
+// Events are not directly linked to canvases,
+// so we check that the source of the event belongs to the canvas.
+func findEvent(canvas *models.Canvas, eventID uuid.UUID) (*models.Event, error) {
+	event, err := models.FindEventByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch event.SourceType {
+	case models.SourceTypeEventSource:
+		_, err = canvas.FindEventSourceByID(event.SourceID)
+	case models.SourceTypeStage:
+		_, err = canvas.FindStageByID(event.SourceID.String())
+	default:
+		err = gorm.ErrRecordNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func findConnectedStage(canvas *models.Canvas, event *models.Event, stageIDOrName string) (*models.Stage, error) {
+	stage, err := findStage(canvas, stageIDOrName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "stage not found")
+		}
+
+		return nil, err
+	}
+
+	connections, err := models.ListConnectionsForSource(event.SourceID, event.SourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connection := range connections {
+		if connection.StageID == stage.ID {
+			return stage, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.FailedPrecondition, "stage %s is not connected to %s", stage.Name, event.SourceName)
+}
+
+func findStage(canvas *models.Canvas, idOrName string) (*models.Stage, error) {
+	if _, err := uuid.Parse(idOrName); err != nil {
+		return canvas.FindStageByName(idOrName)
+	}
+
+	return canvas.FindStageByID(idOrName)
+}
+
+func checkEventWasDelivered(event *models.Event, stageID *uuid.UUID) error {
+	stageEvents, err := models.ListStageEventsForEvent(event.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, stageEvent := range stageEvents {
+		if stageID == nil || stageEvent.StageID == *stageID {
+			return nil
+		}
+	}
+
+	if stageID != nil {
+		return status.Error(codes.FailedPrecondition, "event was not delivered to stage - use the current spec to send it there")
+	}
+
+	return status.Error(codes.FailedPrecondition, "event was not delivered to any stage - use the current spec to replay it")
+}
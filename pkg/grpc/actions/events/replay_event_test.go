@@ -0,0 +1,108 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__ReplayEvent(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{Source: true, Stage: true})
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	event, err := models.CreateEvent(r.Source.ID, r.Source.Name, models.SourceTypeEventSource, []byte(`{"ref":"v1"}`), []byte(`{}`))
+	require.NoError(t, err)
+
+	t.Run("no authenticated user -> error", func(t *testing.T) {
+		_, err := ReplayEvent(context.Background(), &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        event.ID.String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+
+	t.Run("event does not exist -> error", func(t *testing.T) {
+		_, err := ReplayEvent(ctx, &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+		assert.Equal(t, "event not found", s.Message())
+	})
+
+	t.Run("event is still pending -> error", func(t *testing.T) {
+		_, err := ReplayEvent(ctx, &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        event.ID.String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "event is still pending", s.Message())
+	})
+
+	require.NoError(t, event.MarkAsProcessed())
+
+	t.Run("stage does not exist -> error", func(t *testing.T) {
+		_, err := ReplayEvent(ctx, &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        event.ID.String(),
+			StageIdOrName:  "does-not-exist",
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+		assert.Equal(t, "stage not found", s.Message())
+	})
+
+	t.Run("event was not delivered to any stage -> error", func(t *testing.T) {
+		_, err := ReplayEvent(ctx, &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        event.ID.String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("replay with current spec for a stage -> replay is created", func(t *testing.T) {
+		response, err := ReplayEvent(ctx, &protos.ReplayEventRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			EventId:        event.ID.String(),
+			StageIdOrName:  r.Stage.Name,
+			UseCurrentSpec: true,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, response.Event)
+		assert.NotEqual(t, event.ID.String(), response.Event.Id)
+		assert.Equal(t, event.ID.String(), response.Event.ReplayOf)
+		assert.Equal(t, protos.Event_STATE_PENDING, response.Event.State)
+		assert.Equal(t, r.User.String(), response.Event.TriggeredBy)
+		assert.Equal(t, "v1", response.Event.Payload.AsMap()["ref"])
+
+		replay, err := models.FindEventByID(uuid.MustParse(response.Event.Id))
+		require.NoError(t, err)
+		require.NotNil(t, replay.ReplayStageID)
+		assert.Equal(t, r.Stage.ID, *replay.ReplayStageID)
+		assert.True(t, replay.ReplayWithCurrentSpec)
+	})
+}
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 type EventSourceCreatedMessage struct {
@@ -23,5 +25,9 @@ func NewEventSourceCreatedMessage(eventSource *models.EventSource) EventSourceCr
 }
 
 func (m EventSourceCreatedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, EventSourceCreatedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m EventSourceCreatedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, EventSourceCreatedRoutingKey, toBytes(m.message))
 }
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const ExecutionCreatedRoutingKey = "execution-created"
@@ -25,5 +27,9 @@ func NewExecutionCreatedMessage(canvasId string, execution *models.StageExecutio
 }
 
 func (m ExecutionCreatedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, ExecutionCreatedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m ExecutionCreatedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, ExecutionCreatedRoutingKey, toBytes(m.message))
 }
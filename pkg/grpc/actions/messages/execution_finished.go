@@ -1,9 +1,12 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const ExecutionFinishedRoutingKey = "execution-finished"
@@ -15,15 +18,21 @@ type ExecutionFinishedMessage struct {
 func NewExecutionFinishedMessage(canvasId string, execution *models.StageExecution) ExecutionFinishedMessage {
 	return ExecutionFinishedMessage{
 		message: &pb.StageExecutionFinished{
-			CanvasId:    canvasId,
-			ExecutionId: execution.ID.String(),
-			StageId:     execution.StageID.String(),
-			EventId:     execution.StageEventID.String(),
-			Timestamp:   timestamppb.Now(),
+			CanvasId:     canvasId,
+			ExecutionId:  execution.ID.String(),
+			StageId:      execution.StageID.String(),
+			EventId:      execution.StageEventID.String(),
+			Timestamp:    timestamppb.Now(),
+			Result:       actions.ExecutionResultToProto(execution.Result),
+			ResultReason: actions.ExecutionResultReasonToProto(execution.ResultReason),
 		},
 	}
 }
 
 func (m ExecutionFinishedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, ExecutionFinishedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m ExecutionFinishedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, ExecutionFinishedRoutingKey, toBytes(m.message))
 }
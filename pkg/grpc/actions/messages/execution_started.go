@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const ExecutionStartedRoutingKey = "execution-started"
@@ -25,5 +27,9 @@ func NewExecutionStartedMessage(canvasId string, execution *models.StageExecutio
 }
 
 func (m ExecutionStartedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, ExecutionStartedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m ExecutionStartedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, ExecutionStartedRoutingKey, toBytes(m.message))
 }
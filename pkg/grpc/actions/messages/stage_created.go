@@ -1,10 +1,11 @@
 package messages
 
 import (
-	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const StageCreatedRoutingKey = "stage-created"
@@ -24,6 +25,9 @@ func NewStageCreatedMessage(stage *models.Stage) StageCreatedMessage {
 }
 
 func (m StageCreatedMessage) Publish() error {
-	log.Infof("publishing stage created message")
-	return Publish(DeliveryHubCanvasExchange, StageCreatedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m StageCreatedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, StageCreatedRoutingKey, toBytes(m.message))
 }
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const StageEventApprovedRoutingKey = "stage-event-approved"
@@ -25,5 +27,9 @@ func NewStageEventApprovedMessage(canvasId string, stageEvent *models.StageEvent
 }
 
 func (m StageEventApprovedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, StageEventApprovedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m StageEventApprovedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, StageEventApprovedRoutingKey, toBytes(m.message))
 }
@@ -1,9 +1,11 @@
 package messages
 
 import (
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 const StageEventCreatedRoutingKey = "stage-event-created"
@@ -25,5 +27,9 @@ func NewStageEventCreatedMessage(canvasId string, stageEvent *models.StageEvent)
 }
 
 func (m StageEventCreatedMessage) Publish() error {
-	return Publish(DeliveryHubCanvasExchange, StageEventCreatedRoutingKey, toBytes(m.message))
+	return m.PublishInTransaction(database.Conn())
+}
+
+func (m StageEventCreatedMessage) PublishInTransaction(tx *gorm.DB) error {
+	return enqueueInTransaction(tx, DeliveryHubCanvasExchange, StageEventCreatedRoutingKey, toBytes(m.message))
 }
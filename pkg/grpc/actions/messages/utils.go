@@ -1,27 +1,32 @@
 package messages
 
 import (
-	"github.com/renderedtext/go-tackle"
-	config "github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/bus"
+	"github.com/superplanehq/superplane/pkg/models"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/gorm"
 )
 
 const DeliveryHubCanvasExchange = "superplane.canvas-exchange"
 
+// Publish sends the message to the message bus right away.
+// Only the outbox relay should use it - everything else
+// writes to the outbox, with enqueueInTransaction.
 func Publish(exchange string, routingKey string, message []byte) error {
-	amqpURL, err := config.RabbitMQURL()
-
+	b, err := bus.Get()
 	if err != nil {
 		return err
 	}
 
-	return tackle.PublishMessage(&tackle.PublishParams{
-		Body:       message,
-		AmqpURL:    amqpURL,
-		RoutingKey: routingKey,
-		Exchange:   exchange,
-	})
+	return b.Publish(exchange, routingKey, message)
+}
+
+// Messages are written to the outbox in the transaction given,
+// so they are only sent if that transaction commits.
+func enqueueInTransaction(tx *gorm.DB, exchange string, routingKey string, message []byte) error {
+	_, err := models.CreateOutboxMessageInTransaction(tx, exchange, routingKey, message)
+	return err
 }
 
 func toBytes(m protoreflect.ProtoMessage) []byte {
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
@@ -62,7 +63,15 @@ func ApproveStageEvent(ctx context.Context, req *pb.ApproveStageEventRequest) (*
 		return nil, err
 	}
 
-	err = event.Approve(uuid.MustParse(req.RequesterId))
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := event.ApproveInTransaction(tx, uuid.MustParse(req.RequesterId))
+		if err != nil {
+			return err
+		}
+
+		return messages.NewStageEventApprovedMessage(canvas.ID.String(), event).PublishInTransaction(tx)
+	})
+
 	if err != nil {
 		if errors.Is(err, models.ErrEventAlreadyApprovedByRequester) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -74,11 +83,6 @@ func ApproveStageEvent(ctx context.Context, req *pb.ApproveStageEventRequest) (*
 
 	logger.Infof("event %s approved", event.ID)
 
-	err = messages.NewStageEventApprovedMessage(canvas.ID.String(), event).Publish()
-	if err != nil {
-		logger.Errorf("failed to publish event approved message: %v", err)
-	}
-
 	serialized, err := serializeStageEvent(*event)
 	if err != nil {
 		logger.Errorf("failed to serialize stage event: %v", err)
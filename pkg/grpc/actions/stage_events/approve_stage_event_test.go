@@ -88,6 +88,7 @@ func Test__ApproveStageEvent(t *testing.T) {
 		assert.Equal(t, userID, res.Event.Approvals[0].ApprovedBy)
 		assert.NotNil(t, res.Event.Approvals[0].ApprovedAt)
 
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
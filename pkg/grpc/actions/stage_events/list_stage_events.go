@@ -176,41 +176,7 @@ func serializeStageEventExecution(event models.StageEvent) (*pb.Execution, error
 		return nil, nil
 	}
 
-	e := &pb.Execution{
-		Id:          execution.ID.String(),
-		ReferenceId: execution.ReferenceID,
-		State:       executionStateToProto(execution.State),
-		Result:      actions.ExecutionResultToProto(execution.Result),
-		CreatedAt:   timestamppb.New(*execution.CreatedAt),
-		Outputs:     []*pb.OutputValue{},
-	}
-
-	if execution.StartedAt != nil {
-		e.StartedAt = timestamppb.New(*execution.StartedAt)
-	}
-
-	if execution.FinishedAt != nil {
-		e.FinishedAt = timestamppb.New(*execution.FinishedAt)
-	}
-
-	for k, v := range execution.Outputs.Data() {
-		e.Outputs = append(e.Outputs, &pb.OutputValue{Name: k, Value: v.(string)})
-	}
-
-	return e, nil
-}
-
-func executionStateToProto(state string) pb.Execution_State {
-	switch state {
-	case models.StageExecutionPending:
-		return pb.Execution_STATE_PENDING
-	case models.StageExecutionStarted:
-		return pb.Execution_STATE_STARTED
-	case models.StageExecutionFinished:
-		return pb.Execution_STATE_FINISHED
-	default:
-		return pb.Execution_STATE_UNKNOWN
-	}
+	return actions.SerializeExecution(execution), nil
 }
 
 func stateToProto(state string) pb.StageEvent_State {
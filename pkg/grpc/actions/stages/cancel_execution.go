@@ -0,0 +1,139 @@
+package stages
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/executors"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func CancelExecution(ctx context.Context, encryptor crypto.Encryptor, req *pb.CancelExecutionRequest) (*pb.CancelExecutionResponse, error) {
+	canvas, stage, execution, err := findExecution(req.CanvasIdOrName, req.StageIdOrName, req.ExecutionId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.ForStage(stage)
+
+	if execution.State == models.StageExecutionFinished {
+		return nil, status.Error(codes.FailedPrecondition, "execution already finished")
+	}
+
+	//
+	// The execution is locked in the state we found it in,
+	// so it is not finished by the execution poller or reaper at the same time.
+	//
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockExecutionInState(tx, execution.ID, execution.State)
+		if err != nil {
+			return err
+		}
+
+		if err := locked.FinishInTransaction(tx, stage, models.StageExecutionResultCancelled); err != nil {
+			return err
+		}
+
+		execution = locked
+		return messages.NewExecutionFinishedMessage(canvas.ID.String(), execution).PublishInTransaction(tx)
+	})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.FailedPrecondition, "execution changed while cancelling it - try again")
+	}
+
+	if err != nil {
+		logger.Errorf("failed to cancel execution %s: %v", execution.ID, err)
+		return nil, status.Error(codes.Internal, "failed to cancel execution")
+	}
+
+	//
+	// The executor is only called after the execution is cancelled,
+	// so we don't hold the execution lock while waiting on remote systems.
+	// If it fails to stop the execution, the execution remains cancelled.
+	//
+	if err := executors.Cancel(encryptor, stage, execution); err != nil {
+		logger.Errorf("failed to stop execution %s in executor: %v", execution.ID, err)
+	}
+
+	logger.Infof("execution %s cancelled by %s", execution.ID, req.RequesterId)
+
+	return &pb.CancelExecutionResponse{
+		Execution: actions.SerializeExecution(execution),
+	}, nil
+}
+
+func findExecution(canvasIDOrName, stageIDOrName, executionID, requesterID string) (*models.Canvas, *models.Stage, *models.StageExecution, error) {
+	canvas, stage, execution, err := findStageExecution(canvasIDOrName, stageIDOrName, executionID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	err = actions.ValidateUUIDs(requesterID)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "invalid UUIDs")
+	}
+
+	return canvas, stage, execution, nil
+}
+
+func findStageExecution(canvasIDOrName, stageIDOrName, executionID string) (*models.Canvas, *models.Stage, *models.StageExecution, error) {
+	err := actions.ValidateUUIDs(canvasIDOrName)
+
+	var canvas *models.Canvas
+	if err != nil {
+		canvas, err = models.FindCanvasByName(canvasIDOrName)
+	} else {
+		canvas, err = models.FindCanvasByID(canvasIDOrName)
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, "canvas not found")
+		}
+
+		return nil, nil, nil, err
+	}
+
+	err = actions.ValidateUUIDs(stageIDOrName)
+	var stage *models.Stage
+	if err != nil {
+		stage, err = canvas.FindStageByName(stageIDOrName)
+	} else {
+		stage, err = canvas.FindStageByID(stageIDOrName)
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, "stage not found")
+		}
+
+		return nil, nil, nil, err
+	}
+
+	err = actions.ValidateUUIDs(executionID)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "invalid UUIDs")
+	}
+
+	execution, err := stage.FindExecutionByID(uuid.MustParse(executionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, "execution not found")
+		}
+
+		return nil, nil, nil, err
+	}
+
+	return canvas, stage, execution, nil
+}
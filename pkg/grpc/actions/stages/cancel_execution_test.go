@@ -0,0 +1,169 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/semaphore"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	testconsumer "github.com/superplanehq/superplane/test/test_consumer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ExecutionFinishedRoutingKey = "execution-finished"
+
+func Test__CancelExecution(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{
+		Source:       true,
+		SemaphoreAPI: true,
+	})
+
+	defer r.Close()
+
+	spec := support.ExecutorSpecWithURL(r.SemaphoreAPIMock.Server.URL)
+	err := r.Canvas.CreateStage("stage-1", r.User.String(), []models.StageCondition{}, spec, []models.StageConnection{
+		{
+			SourceID:   r.Source.ID,
+			SourceType: models.SourceTypeEventSource,
+		},
+	}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+
+	require.NoError(t, err)
+	stage, err := r.Canvas.FindStageByName("stage-1")
+	require.NoError(t, err)
+
+	encryptor := &crypto.NoOpEncryptor{}
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: uuid.New().String(),
+			StageIdOrName:  stage.ID.String(),
+			ExecutionId:    uuid.New().String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "canvas not found", s.Message())
+	})
+
+	t.Run("stage does not exist -> error", func(t *testing.T) {
+		_, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  uuid.New().String(),
+			ExecutionId:    uuid.New().String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "stage not found", s.Message())
+	})
+
+	t.Run("execution does not exist -> error", func(t *testing.T) {
+		_, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  stage.Name,
+			ExecutionId:    uuid.New().String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "execution not found", s.Message())
+	})
+
+	t.Run("finished execution -> error", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.Finish(stage, models.StageExecutionResultPassed))
+
+		_, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "execution already finished", s.Message())
+	})
+
+	t.Run("started execution -> stops workflow and cancels execution", func(t *testing.T) {
+		amqpURL, _ := config.RabbitMQURL()
+		testconsumer := testconsumer.New(amqpURL, ExecutionFinishedRoutingKey)
+		testconsumer.Start()
+		defer testconsumer.Stop()
+
+		workflowID := uuid.New().String()
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultPassed)
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+		eventsBefore, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+
+		res, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res.Execution)
+		assert.Equal(t, execution.ID.String(), res.Execution.Id)
+		assert.Equal(t, protos.Execution_STATE_FINISHED, res.Execution.State)
+		assert.Equal(t, protos.Execution_RESULT_CANCELLED, res.Execution.Result)
+		assert.NotNil(t, res.Execution.FinishedAt)
+		assert.Contains(t, r.SemaphoreAPIMock.StoppedWorkflows, workflowID)
+
+		//
+		// Stage execution completion event is still emitted.
+		//
+		events, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, events, len(eventsBefore)+1)
+
+		support.RelayOutbox(t)
+		assert.True(t, testconsumer.HasReceivedMessage())
+	})
+
+	t.Run("executor fails to stop workflow -> execution is still cancelled", func(t *testing.T) {
+		//
+		// The Semaphore API mock does not know about this workflow,
+		// so stopping it fails.
+		//
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		res, err := CancelExecution(context.Background(), encryptor, &protos.CancelExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res.Execution)
+		assert.Equal(t, protos.Execution_RESULT_CANCELLED, res.Execution.Result)
+		assert.NotContains(t, r.SemaphoreAPIMock.StoppedWorkflows, workflowID)
+
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultCancelled, execution.Result)
+	})
+}
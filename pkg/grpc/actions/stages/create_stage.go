@@ -4,19 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/executors"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/inputs"
-	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func CreateStage(ctx context.Context, specValidator executors.SpecValidator, req *pb.CreateStageRequest) (*pb.CreateStageResponse, error) {
@@ -61,6 +65,11 @@ func CreateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = validateExecutorOutputs(spec, req.Stage.Spec.Outputs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	connections, err := validateConnections(canvas, req.Stage.Spec.Connections)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -76,17 +85,35 @@ func CreateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = canvas.CreateStage(
-		req.Stage.Metadata.Name,
-		req.RequesterId,
-		conditions,
-		*spec,
-		connections,
-		inputValidator.SerializeInputs(),
-		inputValidator.SerializeInputMappings(),
-		inputValidator.SerializeOutputs(),
-		secrets,
-	)
+	retryPolicy, err := validateRetryPolicy(req.Stage.Spec.RetryPolicy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		stage, err := canvas.CreateStageInTransaction(
+			tx,
+			req.Stage.Metadata.Name,
+			req.RequesterId,
+			conditions,
+			*spec,
+			connections,
+			inputValidator.SerializeInputs(),
+			inputValidator.SerializeInputMappings(),
+			inputValidator.SerializeOutputs(),
+			secrets,
+			validateMaxConcurrentExecutions(req.Stage.Spec.MaxConcurrentExecutions),
+			protoToQueuePolicy(req.Stage.Spec.QueuePolicy),
+			int(req.Stage.Spec.ExecutionTimeout),
+			*retryPolicy,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return messages.NewStageCreatedMessage(stage).PublishInTransaction(tx)
+	})
 
 	if err != nil {
 		if errors.Is(err, models.ErrNameAlreadyUsed) {
@@ -117,13 +144,159 @@ func CreateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		Stage: serialized,
 	}
 
-	err = messages.NewStageCreatedMessage(stage).Publish()
+	return response, nil
+}
 
-	if err != nil {
-		logging.ForStage(stage).Errorf("failed to publish stage created message: %v", err)
+// Outputs extracted by the executor must be defined in the stage.
+func validateExecutorOutputs(spec *models.ExecutorSpec, outputs []*pb.OutputDefinition) error {
+	names := []string{}
+	if spec.HTTP != nil {
+		for _, output := range spec.HTTP.Outputs {
+			names = append(names, output.Name)
+		}
 	}
 
-	return response, nil
+	if spec.Noop != nil {
+		for name := range spec.Noop.Outputs {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		defined := slices.ContainsFunc(outputs, func(o *pb.OutputDefinition) bool {
+			return o.Name == name
+		})
+
+		if !defined {
+			return fmt.Errorf("executor output %s is not defined in the stage", name)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxConcurrentExecutions(max uint32) int {
+	if max == 0 {
+		return models.DefaultMaxConcurrentExecutions
+	}
+
+	return int(max)
+}
+
+func protoToQueuePolicy(in pb.Stage_QueuePolicy) string {
+	switch in {
+	case pb.Stage_QUEUE_POLICY_LATEST:
+		return models.StageQueuePolicyLatest
+	case pb.Stage_QUEUE_POLICY_CANCEL_RUNNING:
+		return models.StageQueuePolicyCancelRunning
+	default:
+		return models.StageQueuePolicyFIFO
+	}
+}
+
+func queuePolicyToProto(in string) pb.Stage_QueuePolicy {
+	switch in {
+	case models.StageQueuePolicyLatest:
+		return pb.Stage_QUEUE_POLICY_LATEST
+	case models.StageQueuePolicyCancelRunning:
+		return pb.Stage_QUEUE_POLICY_CANCEL_RUNNING
+	default:
+		return pb.Stage_QUEUE_POLICY_FIFO
+	}
+}
+
+// Attempts are capped to avoid retrying forever,
+// and to keep exponential backoffs within reasonable bounds.
+const maxRetryAttempts = 10
+
+func validateRetryPolicy(in *pb.RetryPolicy) (*models.RetryPolicy, error) {
+	if in == nil || in.MaxAttempts <= 1 {
+		return &models.RetryPolicy{}, nil
+	}
+
+	if in.MaxAttempts > maxRetryAttempts {
+		return nil, fmt.Errorf("retry policy max attempts must be at most %d", maxRetryAttempts)
+	}
+
+	if len(in.RetryOn) == 0 {
+		return nil, fmt.Errorf("retry policy must specify which failures to retry")
+	}
+
+	maxBackoff := uint32(models.MaxRetryBackoff / time.Second)
+	if in.Backoff > maxBackoff {
+		return nil, fmt.Errorf("retry policy backoff must be at most %d seconds", maxBackoff)
+	}
+
+	retryOn := []string{}
+	for _, kind := range in.RetryOn {
+		k, err := protoToFailureKind(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		retryOn = append(retryOn, k)
+	}
+
+	strategy := models.BackoffStrategyFixed
+	if in.BackoffStrategy == pb.RetryPolicy_BACKOFF_STRATEGY_EXPONENTIAL {
+		strategy = models.BackoffStrategyExponential
+	}
+
+	return &models.RetryPolicy{
+		MaxAttempts:     int(in.MaxAttempts),
+		Backoff:         int(in.Backoff),
+		BackoffStrategy: strategy,
+		RetryOn:         retryOn,
+	}, nil
+}
+
+func protoToFailureKind(in pb.RetryPolicy_FailureKind) (string, error) {
+	switch in {
+	case pb.RetryPolicy_FAILURE_KIND_FAILED:
+		return models.RetryOnFailed, nil
+	case pb.RetryPolicy_FAILURE_KIND_TIMEOUT:
+		return models.RetryOnTimeout, nil
+	case pb.RetryPolicy_FAILURE_KIND_ERROR:
+		return models.RetryOnError, nil
+	default:
+		return "", fmt.Errorf("invalid failure kind: %v", in)
+	}
+}
+
+func failureKindToProto(in string) pb.RetryPolicy_FailureKind {
+	switch in {
+	case models.RetryOnFailed:
+		return pb.RetryPolicy_FAILURE_KIND_FAILED
+	case models.RetryOnTimeout:
+		return pb.RetryPolicy_FAILURE_KIND_TIMEOUT
+	case models.RetryOnError:
+		return pb.RetryPolicy_FAILURE_KIND_ERROR
+	default:
+		return pb.RetryPolicy_FAILURE_KIND_UNKNOWN
+	}
+}
+
+func serializeRetryPolicy(in models.RetryPolicy) *pb.RetryPolicy {
+	if in.MaxAttempts <= 1 {
+		return nil
+	}
+
+	retryOn := []pb.RetryPolicy_FailureKind{}
+	for _, kind := range in.RetryOn {
+		retryOn = append(retryOn, failureKindToProto(kind))
+	}
+
+	strategy := pb.RetryPolicy_BACKOFF_STRATEGY_FIXED
+	if in.BackoffStrategy == models.BackoffStrategyExponential {
+		strategy = pb.RetryPolicy_BACKOFF_STRATEGY_EXPONENTIAL
+	}
+
+	return &pb.RetryPolicy{
+		MaxAttempts:     uint32(in.MaxAttempts),
+		Backoff:         uint32(in.Backoff),
+		BackoffStrategy: strategy,
+		RetryOn:         retryOn,
+	}
 }
 
 func validateSecrets(in []*pb.ValueDefinition) ([]models.ValueDefinition, error) {
@@ -492,6 +665,11 @@ func serializeStage(
 			Outputs:       outputs,
 			InputMappings: inputMappings,
 			Secrets:       secrets,
+
+			MaxConcurrentExecutions: uint32(stage.ConcurrencyLimit()),
+			QueuePolicy:             queuePolicyToProto(stage.QueuePolicy),
+			ExecutionTimeout:        uint32(stage.ExecutionTimeout),
+			RetryPolicy:             serializeRetryPolicy(stage.RetryPolicy.Data()),
 		},
 	}, nil
 }
@@ -637,6 +815,42 @@ func serializeCondition(condition models.StageCondition) (*pb.Condition, error)
 	}
 }
 
+func serializeHTTPMode(mode string) pb.ExecutorSpec_HTTPMode {
+	switch mode {
+	case models.HTTPExecutorModePolling:
+		return pb.ExecutorSpec_HTTP_MODE_POLLING
+	case models.HTTPExecutorModeCallback:
+		return pb.ExecutorSpec_HTTP_MODE_CALLBACK
+	default:
+		return pb.ExecutorSpec_HTTP_MODE_SYNC
+	}
+}
+
+func serializeHTTPOutputs(outputs []models.HTTPOutput) []*pb.ExecutorSpec_HTTPOutput {
+	serialized := []*pb.ExecutorSpec_HTTPOutput{}
+	for _, output := range outputs {
+		serialized = append(serialized, &pb.ExecutorSpec_HTTPOutput{
+			Name:       output.Name,
+			Expression: output.Expression,
+		})
+	}
+
+	return serialized
+}
+
+func serializeHTTPStatusPolicy(policy *models.HTTPStatusPolicy) *pb.ExecutorSpec_HTTPStatusPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &pb.ExecutorSpec_HTTPStatusPolicy{
+		IdExpression:      policy.IDExpression,
+		Url:               policy.URL,
+		SuccessExpression: policy.SuccessExpression,
+		FailureExpression: policy.FailureExpression,
+	}
+}
+
 func serializeExecutorSpec(executor models.ExecutorSpec) (*pb.ExecutorSpec, error) {
 	switch executor.Type {
 	case models.ExecutorSpecTypeHTTP:
@@ -649,6 +863,12 @@ func serializeExecutorSpec(executor models.ExecutorSpec) (*pb.ExecutorSpec, erro
 				ResponsePolicy: &pb.ExecutorSpec_HTTPResponsePolicy{
 					StatusCodes: executor.HTTP.ResponsePolicy.StatusCodes,
 				},
+				Mode:         serializeHTTPMode(executor.HTTP.Mode),
+				StatusPolicy: serializeHTTPStatusPolicy(executor.HTTP.StatusPolicy),
+				Method:       executor.HTTP.Method,
+				QueryParams:  executor.HTTP.QueryParams,
+				Body:         executor.HTTP.Body,
+				Outputs:      serializeHTTPOutputs(executor.HTTP.Outputs),
 			},
 		}, nil
 	case models.ExecutorSpecTypeSemaphore:
@@ -662,6 +882,82 @@ func serializeExecutorSpec(executor models.ExecutorSpec) (*pb.ExecutorSpec, erro
 				PipelineFile:    executor.Semaphore.PipelineFile,
 				Parameters:      executor.Semaphore.Parameters,
 				TaskId:          executor.Semaphore.TaskID,
+				WorkflowId:      executor.Semaphore.WorkflowID,
+				Promotion:       executor.Semaphore.Promotion,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypeGitHub:
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITHUB,
+			Github: &pb.ExecutorSpec_GitHub{
+				Url:             executor.GitHub.URL,
+				Token:           executor.GitHub.Token,
+				Owner:           executor.GitHub.Owner,
+				Repository:      executor.GitHub.Repository,
+				Workflow:        executor.GitHub.Workflow,
+				Ref:             executor.GitHub.Ref,
+				Inputs:          executor.GitHub.Inputs,
+				ExecutionInputs: executor.GitHub.ExecutionInputs,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypeGitLab:
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_GITLAB,
+			Gitlab: &pb.ExecutorSpec_GitLab{
+				Url:       executor.GitLab.URL,
+				Token:     executor.GitLab.Token,
+				ProjectId: executor.GitLab.ProjectID,
+				Ref:       executor.GitLab.Ref,
+				Variables: executor.GitLab.Variables,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypeKubernetes:
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_KUBERNETES,
+			Kubernetes: &pb.ExecutorSpec_Kubernetes{
+				Url:                     executor.Kubernetes.URL,
+				Token:                   executor.Kubernetes.Token,
+				CaCertificate:           executor.Kubernetes.CACertificate,
+				Namespace:               executor.Kubernetes.Namespace,
+				Manifest:                executor.Kubernetes.Manifest,
+				TtlSecondsAfterFinished: executor.Kubernetes.TTLSecondsAfterFinished,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypePlugin:
+		config, err := structpb.NewStruct(executor.Plugin.Config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin config: %v", err)
+		}
+
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_PLUGIN,
+			Plugin: &pb.ExecutorSpec_Plugin{
+				Name:   executor.Plugin.Name,
+				Config: config,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypeProcess:
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_PROCESS,
+			Process: &pb.ExecutorSpec_Process{
+				Agent:            executor.Process.Agent,
+				Command:          executor.Process.Command,
+				Args:             executor.Process.Args,
+				Env:              executor.Process.Env,
+				WorkingDirectory: executor.Process.WorkingDirectory,
+			},
+		}, nil
+
+	case models.ExecutorSpecTypeNoop:
+		return &pb.ExecutorSpec{
+			Type: pb.ExecutorSpec_TYPE_NOOP,
+			Noop: &pb.ExecutorSpec_Noop{
+				Outputs: executor.Noop.Outputs,
 			},
 		}, nil
 
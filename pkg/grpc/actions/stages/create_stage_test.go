@@ -87,6 +87,71 @@ func Test__CreateStage(t *testing.T) {
 		assert.Equal(t, "invalid connection: event source source-does-not-exist not found", s.Message())
 	})
 
+	t.Run("HTTP executor output not defined in stage -> error", func(t *testing.T) {
+		_, err := CreateStage(context.Background(), specValidator, &pb.CreateStageRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			RequesterId:    r.User.String(),
+			Stage: &pb.Stage{
+				Metadata: &pb.Stage_Metadata{
+					Name: "test",
+				},
+				Spec: &pb.Stage_Spec{
+					Connections: []*pb.Connection{
+						{
+							Name: r.Source.Name,
+							Type: pb.Connection_TYPE_EVENT_SOURCE,
+						},
+					},
+					Executor: &pb.ExecutorSpec{
+						Type: pb.ExecutorSpec_TYPE_HTTP,
+						Http: &pb.ExecutorSpec_HTTP{
+							Url: "http://localhost:8000",
+							Outputs: []*pb.ExecutorSpec_HTTPOutput{
+								{Name: "VERSION", Expression: "body.version"},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "executor output VERSION is not defined in the stage", s.Message())
+	})
+
+	t.Run("noop executor output not defined in stage -> error", func(t *testing.T) {
+		_, err := CreateStage(context.Background(), specValidator, &pb.CreateStageRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			RequesterId:    r.User.String(),
+			Stage: &pb.Stage{
+				Metadata: &pb.Stage_Metadata{
+					Name: "test",
+				},
+				Spec: &pb.Stage_Spec{
+					Connections: []*pb.Connection{
+						{
+							Name: r.Source.Name,
+							Type: pb.Connection_TYPE_EVENT_SOURCE,
+						},
+					},
+					Executor: &pb.ExecutorSpec{
+						Type: pb.ExecutorSpec_TYPE_NOOP,
+						Noop: &pb.ExecutorSpec_Noop{
+							Outputs: map[string]string{"VERSION": "v1"},
+						},
+					},
+				},
+			},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "executor output VERSION is not defined in the stage", s.Message())
+	})
+
 	t.Run("invalid approval condition -> error", func(t *testing.T) {
 		_, err := CreateStage(context.Background(), specValidator, &pb.CreateStageRequest{
 			CanvasIdOrName: r.Canvas.ID.String(),
@@ -371,6 +436,14 @@ func Test__CreateStage(t *testing.T) {
 		assert.Equal(t, "08:00", res.Stage.Spec.Conditions[1].TimeWindow.Start)
 		assert.Equal(t, "17:00", res.Stage.Spec.Conditions[1].TimeWindow.End)
 		assert.Equal(t, []string{"Monday", "Tuesday"}, res.Stage.Spec.Conditions[1].TimeWindow.WeekDays)
+
+		// No queue settings means FIFO, with only one execution at a time
+		assert.Equal(t, uint32(1), res.Stage.Spec.MaxConcurrentExecutions)
+		assert.Equal(t, pb.Stage_QUEUE_POLICY_FIFO, res.Stage.Spec.QueuePolicy)
+
+		// No execution timeout means executions never time out
+		assert.Equal(t, uint32(0), res.Stage.Spec.ExecutionTimeout)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
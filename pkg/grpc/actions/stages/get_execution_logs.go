@@ -0,0 +1,103 @@
+package stages
+
+import (
+	"context"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxExecutionLogsPerRequest = 500
+
+// In follow mode, the request waits for new logs while the execution is running.
+// Clients keep following by sending the last ID they received as after_id.
+// Logs for an execution are committed in ID order, so no logs are skipped that way.
+var (
+	ExecutionLogsFollowTimeout  = 30 * time.Second
+	ExecutionLogsFollowInterval = time.Second
+)
+
+func GetExecutionLogs(ctx context.Context, req *pb.GetExecutionLogsRequest) (*pb.GetExecutionLogsResponse, error) {
+	_, stage, execution, err := findStageExecution(req.CanvasIdOrName, req.StageIdOrName, req.ExecutionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AfterId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid after_id")
+	}
+
+	logger := logging.ForStage(stage)
+	deadline := time.Now().Add(ExecutionLogsFollowTimeout)
+
+	for {
+		logs, err := models.ListExecutionLogs(execution.ID, req.AfterId, MaxExecutionLogsPerRequest)
+		if err != nil {
+			logger.Errorf("Error listing logs for execution %s: %v", execution.ID, err)
+			return nil, status.Error(codes.Internal, "error listing logs")
+		}
+
+		//
+		// Logs can still arrive while the execution is running,
+		// so the logs are only complete once the execution is finished
+		// and there is nothing else to read after this page.
+		//
+		finished := execution.State == models.StageExecutionFinished && len(logs) < MaxExecutionLogsPerRequest
+		if len(logs) > 0 || finished || !req.Follow || time.Now().After(deadline) {
+			return serializeExecutionLogs(logs, req.AfterId, finished), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-time.After(ExecutionLogsFollowInterval):
+		}
+
+		current, err := models.FindExecutionByID(execution.ID)
+		if err != nil {
+			logger.Errorf("Error finding execution %s: %v", execution.ID, err)
+			return nil, status.Error(codes.Internal, "error finding execution")
+		}
+
+		execution = current
+	}
+}
+
+func serializeExecutionLogs(logs []models.ExecutionLog, afterID int64, finished bool) *pb.GetExecutionLogsResponse {
+	response := &pb.GetExecutionLogsResponse{
+		Logs:     []*pb.ExecutionLog{},
+		LastId:   afterID,
+		Finished: finished,
+	}
+
+	for _, log := range logs {
+		response.Logs = append(response.Logs, &pb.ExecutionLog{
+			Id:        log.ID,
+			Stream:    logStreamToProto(log.Stream),
+			Content:   log.Content,
+			CreatedAt: timestamppb.New(*log.CreatedAt),
+		})
+
+		response.LastId = log.ID
+	}
+
+	return response
+}
+
+func logStreamToProto(stream string) pb.ExecutionLog_Stream {
+	switch stream {
+	case models.ExecutionLogStreamStdout:
+		return pb.ExecutionLog_STREAM_STDOUT
+	case models.ExecutionLogStreamStderr:
+		return pb.ExecutionLog_STREAM_STDERR
+	case models.ExecutionLogStreamSystem:
+		return pb.ExecutionLog_STREAM_SYSTEM
+	default:
+		return pb.ExecutionLog_STREAM_UNKNOWN
+	}
+}
@@ -0,0 +1,189 @@
+package stages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__GetExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+
+	ExecutionLogsFollowInterval = 10 * time.Millisecond
+	ExecutionLogsFollowTimeout = 500 * time.Millisecond
+
+	t.Run("canvas does not exist -> error", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: uuid.New().String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "canvas not found", s.Message())
+	})
+
+	t.Run("execution does not exist -> error", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "execution not found", s.Message())
+	})
+
+	t.Run("logs after the given ID are returned", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, models.CreateExecutionLogs(execution.ID, []models.ExecutionLog{
+			{Stream: models.ExecutionLogStreamSystem, Content: "POST https://example.com\n"},
+			{Stream: models.ExecutionLogStreamStdout, Content: "hello\n"},
+			{Stream: models.ExecutionLogStreamStderr, Content: "oops\n"},
+		}))
+
+		response, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 3)
+		assert.False(t, response.Finished)
+		assert.Equal(t, protos.ExecutionLog_STREAM_SYSTEM, response.Logs[0].Stream)
+		assert.Equal(t, protos.ExecutionLog_STREAM_STDOUT, response.Logs[1].Stream)
+		assert.Equal(t, "hello\n", response.Logs[1].Content)
+		assert.Equal(t, protos.ExecutionLog_STREAM_STDERR, response.Logs[2].Stream)
+		assert.Equal(t, response.Logs[2].Id, response.LastId)
+
+		response, err = GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			AfterId:        response.Logs[1].Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 1)
+		assert.Equal(t, "oops\n", response.Logs[0].Content)
+	})
+
+	t.Run("follow mode -> waits for new logs", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Start())
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			_ = models.CreateExecutionLogs(execution.ID, []models.ExecutionLog{
+				{Stream: models.ExecutionLogStreamStdout, Content: "hello\n"},
+			})
+		}()
+
+		response, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    execution.ID.String(),
+			Follow:         true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 1)
+		assert.Equal(t, "hello\n", response.Logs[0].Content)
+		assert.False(t, response.Finished)
+	})
+
+	t.Run("follow mode with no new logs -> returns after timeout", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Start())
+
+		response, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    execution.ID.String(),
+			AfterId:        10,
+			Follow:         true,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Logs)
+		assert.Equal(t, int64(10), response.LastId)
+		assert.False(t, response.Finished)
+	})
+
+	t.Run("follow mode and execution finished -> finished", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Start())
+		require.NoError(t, execution.Finish(r.Stage, models.StageExecutionResultPassed))
+
+		response, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    execution.ID.String(),
+			Follow:         true,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Logs)
+		assert.True(t, response.Finished)
+	})
+
+	t.Run("logs written while another write is in flight -> logs are read in commit order", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Start())
+
+		//
+		// The first write is not committed yet,
+		// so the second one waits for it, instead of being read first.
+		//
+		tx := database.Conn().Begin()
+		require.NoError(t, models.CreateExecutionLogsInTransaction(tx, execution.ID, []models.ExecutionLog{
+			{Stream: models.ExecutionLogStreamStdout, Content: "first\n"},
+		}))
+
+		done := make(chan error)
+		go func() {
+			done <- models.CreateExecutionLogs(execution.ID, []models.ExecutionLog{
+				{Stream: models.ExecutionLogStreamStdout, Content: "second\n"},
+			})
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		response, err := GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    execution.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Logs)
+
+		require.NoError(t, tx.Commit().Error)
+		require.NoError(t, <-done)
+
+		response, err = GetExecutionLogs(context.Background(), &protos.GetExecutionLogsRequest{
+			CanvasIdOrName: r.Canvas.ID.String(),
+			StageIdOrName:  r.Stage.ID.String(),
+			ExecutionId:    execution.ID.String(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 2)
+		assert.Equal(t, "first\n", response.Logs[0].Content)
+		assert.Equal(t, "second\n", response.Logs[1].Content)
+	})
+}
@@ -0,0 +1,111 @@
+package stages
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var (
+	errStageLocked       = errors.New("stage is locked")
+	errAlreadyRetried    = errors.New("execution was already retried")
+	errTooManyExecutions = errors.New("too many executions in progress")
+)
+
+func RetryExecution(ctx context.Context, req *pb.RetryExecutionRequest) (*pb.RetryExecutionResponse, error) {
+	canvas, stage, execution, err := findExecution(req.CanvasIdOrName, req.StageIdOrName, req.ExecutionId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.ForStage(stage)
+
+	if execution.State != models.StageExecutionFinished {
+		return nil, status.Error(codes.FailedPrecondition, "execution is not finished")
+	}
+
+	if execution.Result == models.StageExecutionResultPassed {
+		return nil, status.Error(codes.FailedPrecondition, "only failed or cancelled executions can be retried")
+	}
+
+	var newExecution *models.StageExecution
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		//
+		// Workers and other retries might be creating executions for this stage
+		// at the same time, so we lock the stage before checking anything.
+		//
+		_, err := models.LockStage(tx, stage.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errStageLocked
+		}
+
+		if err != nil {
+			return err
+		}
+
+		//
+		// Only the latest attempt for a stage event can be retried,
+		// otherwise we could end up with multiple attempts running for the same event.
+		//
+		latest, err := models.FindExecutionByStageEventIDInTransaction(tx, execution.StageEventID)
+		if err != nil {
+			return err
+		}
+
+		if latest.ID != execution.ID {
+			return errAlreadyRetried
+		}
+
+		inProgress, err := models.CountExecutionsInStateInTransaction(tx, stage.ID, []string{
+			models.StageExecutionPending,
+			models.StageExecutionStarted,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if inProgress >= int64(stage.ConcurrencyLimit()) {
+			return errTooManyExecutions
+		}
+
+		newExecution, err = execution.RetryInTransaction(tx)
+		if err != nil {
+			return err
+		}
+
+		return messages.NewExecutionCreatedMessage(canvas.ID.String(), newExecution).PublishInTransaction(tx)
+	})
+
+	if errors.Is(err, errStageLocked) {
+		return nil, status.Error(codes.Aborted, "stage is being updated - try again")
+	}
+
+	if errors.Is(err, errAlreadyRetried) {
+		return nil, status.Error(codes.FailedPrecondition, "execution was already retried")
+	}
+
+	if errors.Is(err, errTooManyExecutions) {
+		return nil, status.Error(codes.FailedPrecondition, "stage already has the maximum number of executions in progress")
+	}
+
+	if err != nil {
+		logger.Errorf("failed to retry execution %s: %v", execution.ID, err)
+		return nil, status.Error(codes.Internal, "failed to retry execution")
+	}
+
+	logger.Infof("execution %s retried by %s - attempt %d is %s", execution.ID, req.RequesterId, newExecution.Attempt, newExecution.ID)
+
+	return &pb.RetryExecutionResponse{
+		Execution: actions.SerializeExecution(newExecution),
+	}, nil
+}
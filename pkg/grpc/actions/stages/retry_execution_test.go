@@ -0,0 +1,139 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
+	"github.com/superplanehq/superplane/test/support"
+	testconsumer "github.com/superplanehq/superplane/test/test_consumer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ExecutionCreatedRoutingKey = "execution-created"
+
+func Test__RetryExecution(t *testing.T) {
+	r := support.Setup(t)
+
+	t.Run("execution does not exist -> error", func(t *testing.T) {
+		_, err := RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    uuid.New().String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "execution not found", s.Message())
+	})
+
+	t.Run("execution not finished -> error", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+
+		_, err := RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "execution is not finished", s.Message())
+		require.NoError(t, execution.Finish(r.Stage, models.StageExecutionResultCancelled))
+	})
+
+	t.Run("execution passed -> error", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Finish(r.Stage, models.StageExecutionResultPassed))
+
+		_, err := RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "only failed or cancelled executions can be retried", s.Message())
+	})
+
+	t.Run("failed execution -> new attempt is created", func(t *testing.T) {
+		amqpURL, _ := config.RabbitMQURL()
+		testconsumer := testconsumer.New(amqpURL, ExecutionCreatedRoutingKey)
+		testconsumer.Start()
+		defer testconsumer.Stop()
+
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Finish(r.Stage, models.StageExecutionResultFailed))
+
+		res, err := RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res.Execution)
+		assert.NotEqual(t, execution.ID.String(), res.Execution.Id)
+		assert.Equal(t, protos.Execution_STATE_PENDING, res.Execution.State)
+		assert.Equal(t, uint32(2), res.Execution.Attempt)
+		assert.Equal(t, execution.ID.String(), res.Execution.PreviousExecutionId)
+		support.RelayOutbox(t)
+		assert.True(t, testconsumer.HasReceivedMessage())
+
+		//
+		// Stage event goes back to waiting for the new attempt.
+		//
+		event, err := models.FindStageEventByID(execution.StageEventID.String(), r.Stage.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.StageEventStateWaiting, event.State)
+		assert.Equal(t, models.StageEventStateReasonExecution, event.StateReason)
+
+		//
+		// The same attempt cannot be retried twice.
+		//
+		_, err = RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "execution was already retried", s.Message())
+	})
+	t.Run("stage at its concurrency limit -> error", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.Finish(r.Stage, models.StageExecutionResultFailed))
+
+		//
+		// The attempt created by the previous test is still pending.
+		//
+		_, err := RetryExecution(context.Background(), &protos.RetryExecutionRequest{
+			CanvasIdOrName: r.Canvas.Name,
+			StageIdOrName:  r.Stage.Name,
+			ExecutionId:    execution.ID.String(),
+			RequesterId:    uuid.New().String(),
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+		assert.Equal(t, "stage already has the maximum number of executions in progress", s.Message())
+	})
+}
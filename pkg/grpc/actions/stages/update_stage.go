@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/executors"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/inputs"
-	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"google.golang.org/grpc/codes"
@@ -73,6 +73,11 @@ func UpdateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = validateExecutorOutputs(executor, req.Stage.Spec.Outputs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	connections, err := validateConnections(canvas, req.Stage.Spec.Connections)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -88,17 +93,35 @@ func UpdateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = canvas.UpdateStage(
-		stage.ID.String(),
-		req.RequesterId,
-		conditions,
-		*executor,
-		connections,
-		inputValidator.SerializeInputs(),
-		inputValidator.SerializeInputMappings(),
-		inputValidator.SerializeOutputs(),
-		secrets,
-	)
+	retryPolicy, err := validateRetryPolicy(req.Stage.Spec.RetryPolicy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := canvas.UpdateStageInTransaction(
+			tx,
+			stage.ID.String(),
+			req.RequesterId,
+			conditions,
+			*executor,
+			connections,
+			inputValidator.SerializeInputs(),
+			inputValidator.SerializeInputMappings(),
+			inputValidator.SerializeOutputs(),
+			secrets,
+			validateMaxConcurrentExecutions(req.Stage.Spec.MaxConcurrentExecutions),
+			protoToQueuePolicy(req.Stage.Spec.QueuePolicy),
+			int(req.Stage.Spec.ExecutionTimeout),
+			*retryPolicy,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return messages.NewStageCreatedMessage(stage).PublishInTransaction(tx)
+	})
 
 	if err != nil {
 		if errors.Is(err, models.ErrNameAlreadyUsed) {
@@ -129,11 +152,5 @@ func UpdateStage(ctx context.Context, specValidator executors.SpecValidator, req
 		Stage: serialized,
 	}
 
-	err = messages.NewStageCreatedMessage(stage).Publish()
-
-	if err != nil {
-		logging.ForStage(stage).Errorf("failed to publish stage created message: %v", err)
-	}
-
 	return response, nil
 }
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/agents"
+	pb "github.com/superplanehq/superplane/pkg/protos/agents"
+)
+
+type AgentService struct {
+	encryptor crypto.Encryptor
+}
+
+func NewAgentService(encryptor crypto.Encryptor) *AgentService {
+	return &AgentService{encryptor: encryptor}
+}
+
+func (s *AgentService) PullJob(ctx context.Context, req *pb.PullJobRequest) (*pb.PullJobResponse, error) {
+	return agents.PullJob(ctx, s.encryptor, req)
+}
+
+func (s *AgentService) PushLogs(ctx context.Context, req *pb.PushLogsRequest) (*pb.PushLogsResponse, error) {
+	return agents.PushLogs(ctx, req)
+}
+
+func (s *AgentService) FinishJob(ctx context.Context, req *pb.FinishJobRequest) (*pb.FinishJobResponse, error) {
+	return agents.FinishJob(ctx, req)
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/executors"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/agents"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
 	eventsources "github.com/superplanehq/superplane/pkg/grpc/actions/event_sources"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/events"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/secrets"
 	stageevents "github.com/superplanehq/superplane/pkg/grpc/actions/stage_events"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/stages"
@@ -60,6 +62,18 @@ func (s *DeliveryService) ApproveStageEvent(ctx context.Context, req *pb.Approve
 	return stageevents.ApproveStageEvent(ctx, req)
 }
 
+func (s *DeliveryService) CancelExecution(ctx context.Context, req *pb.CancelExecutionRequest) (*pb.CancelExecutionResponse, error) {
+	return stages.CancelExecution(ctx, s.encryptor, req)
+}
+
+func (s *DeliveryService) RetryExecution(ctx context.Context, req *pb.RetryExecutionRequest) (*pb.RetryExecutionResponse, error) {
+	return stages.RetryExecution(ctx, req)
+}
+
+func (s *DeliveryService) GetExecutionLogs(ctx context.Context, req *pb.GetExecutionLogsRequest) (*pb.GetExecutionLogsResponse, error) {
+	return stages.GetExecutionLogs(ctx, req)
+}
+
 func (s *DeliveryService) ListEventSources(ctx context.Context, req *pb.ListEventSourcesRequest) (*pb.ListEventSourcesResponse, error) {
 	return eventsources.ListEventSources(ctx, req)
 }
@@ -95,3 +109,15 @@ func (s *DeliveryService) ListSecrets(ctx context.Context, req *pb.ListSecretsRe
 func (s *DeliveryService) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*pb.DeleteSecretResponse, error) {
 	return secrets.DeleteSecret(ctx, req)
 }
+
+func (s *DeliveryService) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.CreateEventResponse, error) {
+	return events.CreateEvent(ctx, req)
+}
+
+func (s *DeliveryService) ReplayEvent(ctx context.Context, req *pb.ReplayEventRequest) (*pb.ReplayEventResponse, error) {
+	return events.ReplayEvent(ctx, req)
+}
+
+func (s *DeliveryService) CreateAgent(ctx context.Context, req *pb.CreateAgentRequest) (*pb.CreateAgentResponse, error) {
+	return agents.CreateAgent(ctx, req)
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -9,6 +10,7 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	agentProtos "github.com/superplanehq/superplane/pkg/protos/agents"
 	authorizationProtos "github.com/superplanehq/superplane/pkg/protos/authorization"
 	organizationProtos "github.com/superplanehq/superplane/pkg/protos/organizations"
 	superplaneProtos "github.com/superplanehq/superplane/pkg/protos/superplane"
@@ -25,14 +27,12 @@ var (
 	customFunc recovery.RecoveryHandlerFunc
 )
 
-func RunServer(encryptor crypto.Encryptor, authService authorization.Authorization, port int) {
-	endpoint := fmt.Sprintf("0.0.0.0:%d", port)
-	lis, err := net.Listen("tcp", endpoint)
-
-	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
-	}
+type Server struct {
+	grpcServer *grpc.Server
+	endpoint   string
+}
 
+func NewServer(encryptor crypto.Encryptor, authService authorization.Authorization, port int) *Server {
 	//
 	// Set up error handler middlewares for the server.
 	//
@@ -68,14 +68,42 @@ func RunServer(encryptor crypto.Encryptor, authService authorization.Authorizati
 	server := NewAuthorizationServer(authService)
 	authorizationProtos.RegisterAuthorizationServer(grpcServer, server)
 
+	agentService := NewAgentService(encryptor)
+	agentProtos.RegisterAgentsServer(grpcServer, agentService)
+
 	reflection.Register(grpcServer)
 
-	//
-	// Start handling incoming requests
-	//
-	log.Infof("Starting GRPC on %s.", endpoint)
-	err = grpcServer.Serve(lis)
+	return &Server{
+		grpcServer: grpcServer,
+		endpoint:   fmt.Sprintf("0.0.0.0:%d", port),
+	}
+}
+
+// Serve handles incoming requests until the server is shut down.
+func (s *Server) Serve() error {
+	lis, err := net.Listen("tcp", s.endpoint)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to listen: %v", err)
+	}
+
+	log.Infof("Starting GRPC on %s.", s.endpoint)
+	return s.grpcServer.Serve(lis)
+}
+
+// Shutdown stops accepting new requests and waits for the ones in progress.
+// If they do not finish before the context is done, they are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
 	}
 }
@@ -12,10 +12,10 @@ import (
 func Test__InputBuilder(t *testing.T) {
 	r := support.SetupWithOptions(t, support.SetupOptions{})
 
-	docsSource, err := r.Canvas.CreateEventSource("docs", []byte("docs-key"))
+	docsSource, err := r.Canvas.CreateEventSource("docs", []byte("docs-key"), models.EventSourceSpec{})
 	require.NoError(t, err)
 	require.NotNil(t, docsSource)
-	tfSource, err := r.Canvas.CreateEventSource("tf", []byte("tf-key"))
+	tfSource, err := r.Canvas.CreateEventSource("tf", []byte("tf-key"), models.EventSourceSpec{})
 	require.NoError(t, err)
 
 	t.Run("no inputs", func(t *testing.T) {
@@ -152,7 +152,7 @@ func Test__InputBuilder(t *testing.T) {
 					},
 				},
 			},
-		}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 		stage, err := r.Canvas.FindStageByName("stage-1")
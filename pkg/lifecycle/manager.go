@@ -0,0 +1,228 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Workers that do not report progress for this long are considered stuck.
+const DefaultStallTimeout = 15 * time.Minute
+
+// Load balancers only notice the process is not ready on their next readiness check,
+// so servers keep accepting requests for a while after shutdown starts.
+const DefaultDrainDelay = 5 * time.Second
+
+var ErrShuttingDown = errors.New("shutting down")
+
+// Server is something that accepts requests
+// and needs to stop accepting them before the workers stop.
+type Server interface {
+	Shutdown(ctx context.Context) error
+}
+
+// Manager runs the workers and servers of a Superplane process.
+// When shutting down, the process stops being ready first, and after the drain delay,
+// servers stop accepting requests, and then workers are asked to stop, finishing whatever they are doing.
+type Manager struct {
+	StallTimeout time.Duration
+	DrainDelay   time.Duration
+
+	nowFunc func() time.Time
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	mu       sync.Mutex
+	stopping bool
+	servers  []Server
+	workers  map[string]*worker
+}
+
+type worker struct {
+	running  bool
+	err      error
+	lastBeat *time.Time
+}
+
+type beatKey struct{}
+
+func NewManager(nowFunc func() time.Time) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		StallTimeout: DefaultStallTimeout,
+		DrainDelay:   DefaultDrainDelay,
+		nowFunc:      nowFunc,
+		ctx:          ctx,
+		cancel:       cancel,
+		workers:      map[string]*worker{},
+	}
+}
+
+// Go runs a worker in its own goroutine, until the context it receives is done.
+// A worker that returns or panics before that makes the process not live.
+func (m *Manager) Go(name string, run func(ctx context.Context) error) {
+	m.mu.Lock()
+	m.workers[name] = &worker{running: true}
+	m.mu.Unlock()
+
+	ctx := context.WithValue(m.ctx, beatKey{}, func() { m.beat(name) })
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		err := m.run(ctx, run)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.workers[name].running = false
+		m.workers[name].err = err
+		if !m.stopping {
+			log.Errorf("Worker %s stopped: %v", name, err)
+		}
+	}()
+}
+
+func (m *Manager) run(ctx context.Context, run func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return run(ctx)
+}
+
+// AddServer registers a server to be shut down
+// before the workers are stopped.
+func (m *Manager) AddServer(server Server) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.servers = append(m.servers, server)
+}
+
+// Beat records that the worker running with this context is making progress.
+// Contexts not created by a manager are ignored.
+func Beat(ctx context.Context) {
+	if beat, ok := ctx.Value(beatKey{}).(func()); ok {
+		beat()
+	}
+}
+
+func (m *Manager) beat(name string) {
+	now := m.nowFunc()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workers[name].lastBeat = &now
+}
+
+// Live returns an error if a worker stopped when it should not,
+// or if it is stuck.
+func (m *Manager) Live() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.nowFunc()
+	for _, name := range m.workerNames() {
+		w := m.workers[name]
+		if !w.running && !m.stopping {
+			return fmt.Errorf("worker %s stopped: %v", name, w.err)
+		}
+
+		if w.running && w.lastBeat != nil && now.Sub(*w.lastBeat) > m.StallTimeout {
+			return fmt.Errorf("worker %s made no progress since %v", name, w.lastBeat)
+		}
+	}
+
+	return nil
+}
+
+// Ready returns an error if the process should not get new work,
+// because it is shutting down or not live.
+func (m *Manager) Ready() error {
+	m.mu.Lock()
+	stopping := m.stopping
+	m.mu.Unlock()
+
+	if stopping {
+		return ErrShuttingDown
+	}
+
+	return m.Live()
+}
+
+// Shutdown makes the process not ready, waits for the drain delay,
+// and then stops the servers and the workers, and waits for them, until the context is done.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	m.stopping = true
+	servers := m.servers
+	m.mu.Unlock()
+
+	//
+	// Requests routed here before the load balancers
+	// notice we are not ready anymore are still served.
+	//
+	select {
+	case <-time.After(m.DrainDelay):
+	case <-ctx.Done():
+	}
+
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Errorf("Error shutting down server: %v", err)
+		}
+	}
+
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		running := m.runningWorkers()
+		if len(running) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("workers still running: %v", running)
+	}
+}
+
+func (m *Manager) runningWorkers() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	running := []string{}
+	for _, name := range m.workerNames() {
+		if m.workers[name].running {
+			running = append(running, name)
+		}
+	}
+
+	return running
+}
+
+func (m *Manager) workerNames() []string {
+	names := make([]string, 0, len(m.workers))
+	for name := range m.workers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
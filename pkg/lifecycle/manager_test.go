@@ -0,0 +1,165 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testServer struct {
+	shutdown   atomic.Bool
+	onShutdown func()
+}
+
+func (s *testServer) Shutdown(ctx context.Context) error {
+	if s.onShutdown != nil {
+		s.onShutdown()
+	}
+
+	s.shutdown.Store(true)
+	return nil
+}
+
+func Test__Manager(t *testing.T) {
+	t.Run("workers running -> live and ready", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.Go("worker", func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		})
+
+		require.NoError(t, m.Live())
+		require.NoError(t, m.Ready())
+
+		m.DrainDelay = 0
+		require.NoError(t, m.Shutdown(context.Background()))
+	})
+
+	t.Run("worker stops on its own -> not live", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.Go("worker", func(ctx context.Context) error {
+			return errors.New("oops")
+		})
+
+		require.Eventually(t, func() bool {
+			err := m.Live()
+			return err != nil && err.Error() == "worker worker stopped: oops"
+		}, time.Second, 10*time.Millisecond)
+
+		require.Error(t, m.Ready())
+	})
+
+	t.Run("worker panics -> not live", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.Go("worker", func(ctx context.Context) error {
+			panic("oops")
+		})
+
+		require.Eventually(t, func() bool {
+			err := m.Live()
+			return err != nil && err.Error() == "worker worker stopped: panic: oops"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("worker makes no progress -> not live", func(t *testing.T) {
+		var now atomic.Value
+		now.Store(time.Now())
+
+		m := NewManager(func() time.Time { return now.Load().(time.Time) })
+		beat := make(chan struct{})
+		m.Go("worker", func(ctx context.Context) error {
+			Beat(ctx)
+			close(beat)
+			<-ctx.Done()
+			return nil
+		})
+
+		<-beat
+		require.NoError(t, m.Live())
+
+		now.Store(now.Load().(time.Time).Add(DefaultStallTimeout + time.Second))
+		require.ErrorContains(t, m.Live(), "worker worker made no progress")
+	})
+
+	t.Run("shutdown -> not ready, servers are shut down and workers finish", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.DrainDelay = 0
+		server := &testServer{}
+		m.AddServer(server)
+
+		started := make(chan struct{})
+		finished := atomic.Bool{}
+		m.Go("worker", func(ctx context.Context) error {
+			RunEvery(ctx, time.Minute, func() {
+				close(started)
+				time.Sleep(50 * time.Millisecond)
+				finished.Store(true)
+			})
+
+			return nil
+		})
+
+		<-started
+		require.NoError(t, m.Shutdown(context.Background()))
+		assert.True(t, server.shutdown.Load())
+		assert.True(t, finished.Load())
+		assert.ErrorIs(t, m.Ready(), ErrShuttingDown)
+		assert.NoError(t, m.Live())
+	})
+
+	t.Run("shutdown -> not ready during the drain delay, and servers are shut down after it", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.DrainDelay = 200 * time.Millisecond
+
+		var readyOnShutdown error
+		server := &testServer{onShutdown: func() { readyOnShutdown = m.Ready() }}
+		m.AddServer(server)
+
+		done := make(chan error)
+		start := time.Now()
+		go func() { done <- m.Shutdown(context.Background()) }()
+
+		require.Eventually(t, func() bool {
+			return errors.Is(m.Ready(), ErrShuttingDown)
+		}, time.Second, 10*time.Millisecond)
+
+		assert.False(t, server.shutdown.Load())
+
+		require.NoError(t, <-done)
+		assert.True(t, server.shutdown.Load())
+		assert.ErrorIs(t, readyOnShutdown, ErrShuttingDown)
+		assert.GreaterOrEqual(t, time.Since(start), m.DrainDelay)
+	})
+
+	t.Run("shutdown context is done during the drain delay -> servers are shut down", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.DrainDelay = time.Minute
+		server := &testServer{}
+		m.AddServer(server)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.NoError(t, m.Shutdown(ctx))
+		assert.True(t, server.shutdown.Load())
+	})
+
+	t.Run("workers do not stop in time -> error", func(t *testing.T) {
+		m := NewManager(time.Now)
+		m.DrainDelay = 0
+		m.Go("stuck", func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		require.ErrorContains(t, m.Shutdown(ctx), "workers still running: [stuck]")
+	})
+}
@@ -0,0 +1,21 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+)
+
+// RunEvery calls tick every interval, until the context is done.
+// A tick that is running when the context is done is not interrupted,
+// so workers never stop halfway through their work.
+func RunEvery(ctx context.Context, interval time.Duration, tick func()) {
+	for ctx.Err() == nil {
+		tick()
+		Beat(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package models
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	AgentJobPending   = "pending"
+	AgentJobRunning   = "running"
+	AgentJobFinished  = "finished"
+	AgentJobCancelled = "cancelled"
+)
+
+var ErrAgentJobNotRunning = errors.New("agent job is not running")
+
+// Agents run process executions for a canvas.
+// They connect to Superplane with their token and pull pending jobs.
+// Only a hash of the token is stored.
+type Agent struct {
+	ID         uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	CanvasID   uuid.UUID
+	Name       string
+	TokenHash  string
+	CreatedAt  *time.Time
+	CreatedBy  uuid.UUID
+	LastSeenAt *time.Time
+}
+
+type AgentJob struct {
+	ID          uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	CanvasID    uuid.UUID
+	ExecutionID uuid.UUID
+	AgentName   string
+	AgentID     *uuid.UUID
+	State       string
+	Spec        []byte
+	ExitCode    *int
+	CreatedAt   *time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+type AgentJobSpec struct {
+	Command          string            `json:"command"`
+	Args             []string          `json:"args"`
+	Env              map[string]string `json:"env"`
+	WorkingDirectory string            `json:"working_directory"`
+}
+
+func HashAgentToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// NOTE: the token is only available to the caller,
+// since only its hash is stored.
+func (c *Canvas) CreateAgent(name, createdBy, token string) (*Agent, error) {
+	now := time.Now()
+
+	agent := Agent{
+		CanvasID:  c.ID,
+		Name:      name,
+		TokenHash: HashAgentToken(token),
+		CreatedAt: &now,
+		CreatedBy: uuid.MustParse(createdBy),
+	}
+
+	err := database.Conn().
+		Clauses(clause.Returning{}).
+		Create(&agent).
+		Error
+
+	if err == nil {
+		return &agent, nil
+	}
+
+	if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+		return nil, ErrNameAlreadyUsed
+	}
+
+	return nil, err
+}
+
+func FindAgentByToken(token string) (*Agent, error) {
+	var agent Agent
+
+	err := database.Conn().
+		Where("token_hash = ?", HashAgentToken(token)).
+		First(&agent).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &agent, nil
+}
+
+func (a *Agent) UpdateLastSeen() error {
+	now := time.Now()
+
+	return database.Conn().Model(a).
+		Update("last_seen_at", &now).
+		Error
+}
+
+// The job spec has the resolved secrets and the execution token
+// in its environment, so it is only stored encrypted.
+func CreateAgentJob(encryptor crypto.Encryptor, canvasID, executionID uuid.UUID, agentName string, spec AgentJobSpec) (*AgentJob, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := encryptor.Encrypt(context.TODO(), data, []byte(executionID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting job spec: %v", err)
+	}
+
+	now := time.Now()
+	job := AgentJob{
+		CanvasID:    canvasID,
+		ExecutionID: executionID,
+		AgentName:   agentName,
+		State:       AgentJobPending,
+		Spec:        encrypted,
+		CreatedAt:   &now,
+	}
+
+	err = database.Conn().
+		Clauses(clause.Returning{}).
+		Create(&job).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func FindAgentJobByID(id string) (*AgentJob, error) {
+	var job AgentJob
+
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&job).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// ClaimNextJob gives the oldest pending job for the agent to it.
+// Rows locked by other agents claiming jobs at the same time are skipped.
+// The job spec is decrypted before the claim is committed,
+// so jobs that cannot be read are not left running without an agent.
+func (a *Agent) ClaimNextJob(encryptor crypto.Encryptor) (*AgentJob, *AgentJobSpec, error) {
+	var job AgentJob
+	var spec *AgentJobSpec
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("canvas_id = ?", a.CanvasID).
+			Where("agent_name = ?", a.Name).
+			Where("state = ?", AgentJobPending).
+			Order("created_at ASC").
+			First(&job).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		spec, err = job.GetSpec(encryptor)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(&job).
+			Clauses(clause.Returning{}).
+			Updates(map[string]any{
+				"state":      AgentJobRunning,
+				"agent_id":   a.ID,
+				"started_at": &now,
+			}).
+			Error
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &job, spec, nil
+}
+
+func (j *AgentJob) Finish(exitCode int) error {
+	now := time.Now()
+
+	res := database.Conn().Model(j).
+		Clauses(clause.Returning{}).
+		Where("state = ?", AgentJobRunning).
+		Updates(map[string]any{
+			"state":       AgentJobFinished,
+			"exit_code":   exitCode,
+			"finished_at": &now,
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrAgentJobNotRunning
+	}
+
+	return nil
+}
+
+// Cancelled jobs that are still pending are never given to an agent.
+// Agents running a cancelled job find out about it when they push logs.
+func (j *AgentJob) Cancel() error {
+	now := time.Now()
+
+	return database.Conn().Model(j).
+		Clauses(clause.Returning{}).
+		Where("state IN ?", []string{AgentJobPending, AgentJobRunning}).
+		Updates(map[string]any{
+			"state":       AgentJobCancelled,
+			"finished_at": &now,
+		}).
+		Error
+}
+
+func (j *AgentJob) GetSpec(encryptor crypto.Encryptor) (*AgentJobSpec, error) {
+	data, err := encryptor.Decrypt(context.TODO(), j.Spec, []byte(j.ExecutionID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting job spec: %v", err)
+	}
+
+	var spec AgentJobSpec
+	err = json.Unmarshal(data, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+func (j *AgentJob) IsDone() bool {
+	return j.State == AgentJobFinished || j.State == AgentJobCancelled
+}
+
+func (j *AgentJob) Succeeded() bool {
+	return j.State == AgentJobFinished && j.ExitCode != nil && *j.ExitCode == 0
+}
@@ -30,7 +30,12 @@ func (Canvas) TableName() string {
 }
 
 // NOTE: caller must encrypt the key before calling this method.
-func (c *Canvas) CreateEventSource(name string, key []byte) (*EventSource, error) {
+func (c *Canvas) CreateEventSource(name string, key []byte, spec EventSourceSpec) (*EventSource, error) {
+	return c.CreateEventSourceInTransaction(database.Conn(), name, key, spec)
+}
+
+// NOTE: caller must encrypt the key before calling this method.
+func (c *Canvas) CreateEventSourceInTransaction(tx *gorm.DB, name string, key []byte, spec EventSourceSpec) (*EventSource, error) {
 	now := time.Now()
 
 	eventSource := EventSource{
@@ -39,9 +44,19 @@ func (c *Canvas) CreateEventSource(name string, key []byte) (*EventSource, error
 		CreatedAt: &now,
 		UpdatedAt: &now,
 		Key:       key,
+		Spec:      datatypes.NewJSONType(spec),
 	}
 
-	err := database.Conn().
+	if spec.Schedule != nil {
+		nextFireAt, err := spec.Schedule.NextFireAt(now)
+		if err != nil {
+			return nil, err
+		}
+
+		eventSource.NextFireAt = nextFireAt
+	}
+
+	err := tx.
 		Clauses(clause.Returning{}).
 		Create(&eventSource).
 		Error
@@ -145,45 +160,89 @@ func (c *Canvas) CreateStage(
 	inputMappings []InputMapping,
 	outputs []OutputDefinition,
 	secrets []ValueDefinition,
+	maxConcurrentExecutions int,
+	queuePolicy string,
+	executionTimeout int,
+	retryPolicy RetryPolicy,
 ) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		_, err := c.CreateStageInTransaction(
+			tx,
+			name,
+			createdBy,
+			conditions,
+			executorSpec,
+			connections,
+			inputs,
+			inputMappings,
+			outputs,
+			secrets,
+			maxConcurrentExecutions,
+			queuePolicy,
+			executionTimeout,
+			retryPolicy,
+		)
+
+		return err
+	})
+}
+
+func (c *Canvas) CreateStageInTransaction(
+	tx *gorm.DB,
+	name, createdBy string,
+	conditions []StageCondition,
+	executorSpec ExecutorSpec,
+	connections []StageConnection,
+	inputs []InputDefinition,
+	inputMappings []InputMapping,
+	outputs []OutputDefinition,
+	secrets []ValueDefinition,
+	maxConcurrentExecutions int,
+	queuePolicy string,
+	executionTimeout int,
+	retryPolicy RetryPolicy,
+) (*Stage, error) {
 	now := time.Now()
 	ID := uuid.New()
 
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
-		stage := &Stage{
-			ID:            ID,
-			CanvasID:      c.ID,
-			Name:          name,
-			Conditions:    datatypes.NewJSONSlice(conditions),
-			CreatedAt:     &now,
-			CreatedBy:     uuid.Must(uuid.Parse(createdBy)),
-			ExecutorSpec:  datatypes.NewJSONType(executorSpec),
-			Inputs:        datatypes.NewJSONSlice(inputs),
-			InputMappings: datatypes.NewJSONSlice(inputMappings),
-			Outputs:       datatypes.NewJSONSlice(outputs),
-			Secrets:       datatypes.NewJSONSlice(secrets),
-		}
-
-		err := tx.Clauses(clause.Returning{}).Create(&stage).Error
-		if err != nil {
-			if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-				return ErrNameAlreadyUsed
-			}
+	stage := &Stage{
+		ID:            ID,
+		CanvasID:      c.ID,
+		Name:          name,
+		Conditions:    datatypes.NewJSONSlice(conditions),
+		CreatedAt:     &now,
+		CreatedBy:     uuid.Must(uuid.Parse(createdBy)),
+		ExecutorSpec:  datatypes.NewJSONType(executorSpec),
+		Inputs:        datatypes.NewJSONSlice(inputs),
+		InputMappings: datatypes.NewJSONSlice(inputMappings),
+		Outputs:       datatypes.NewJSONSlice(outputs),
+		Secrets:       datatypes.NewJSONSlice(secrets),
+
+		MaxConcurrentExecutions: maxConcurrentExecutions,
+		QueuePolicy:             queuePolicy,
+		ExecutionTimeout:        executionTimeout,
+		RetryPolicy:             datatypes.NewJSONType(retryPolicy),
+	}
 
-			return err
+	err := tx.Clauses(clause.Returning{}).Create(&stage).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return nil, ErrNameAlreadyUsed
 		}
 
-		for _, i := range connections {
-			c := i
-			c.StageID = ID
-			err := tx.Create(&c).Error
-			if err != nil {
-				return err
-			}
+		return nil, err
+	}
+
+	for _, i := range connections {
+		c := i
+		c.StageID = ID
+		err := tx.Create(&c).Error
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return nil
-	})
+	return stage, nil
 }
 
 func (c *Canvas) UpdateStage(
@@ -195,38 +254,79 @@ func (c *Canvas) UpdateStage(
 	inputMappings []InputMapping,
 	outputs []OutputDefinition,
 	secrets []ValueDefinition,
+	maxConcurrentExecutions int,
+	queuePolicy string,
+	executionTimeout int,
+	retryPolicy RetryPolicy,
 ) error {
 	return database.Conn().Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("stage_id = ?", id).Delete(&StageConnection{}).Error; err != nil {
-			return fmt.Errorf("failed to delete existing connections: %v", err)
-		}
+		return c.UpdateStageInTransaction(
+			tx,
+			id,
+			requesterID,
+			conditions,
+			executorSpec,
+			connections,
+			inputs,
+			inputMappings,
+			outputs,
+			secrets,
+			maxConcurrentExecutions,
+			queuePolicy,
+			executionTimeout,
+			retryPolicy,
+		)
+	})
+}
 
-		for _, connection := range connections {
-			connection.StageID = uuid.Must(uuid.Parse(id))
-			if err := tx.Create(&connection).Error; err != nil {
-				return fmt.Errorf("failed to create connection: %v", err)
-			}
+func (c *Canvas) UpdateStageInTransaction(
+	tx *gorm.DB,
+	id, requesterID string,
+	conditions []StageCondition,
+	executorSpec ExecutorSpec,
+	connections []StageConnection,
+	inputs []InputDefinition,
+	inputMappings []InputMapping,
+	outputs []OutputDefinition,
+	secrets []ValueDefinition,
+	maxConcurrentExecutions int,
+	queuePolicy string,
+	executionTimeout int,
+	retryPolicy RetryPolicy,
+) error {
+	if err := tx.Where("stage_id = ?", id).Delete(&StageConnection{}).Error; err != nil {
+		return fmt.Errorf("failed to delete existing connections: %v", err)
+	}
+
+	for _, connection := range connections {
+		connection.StageID = uuid.Must(uuid.Parse(id))
+		if err := tx.Create(&connection).Error; err != nil {
+			return fmt.Errorf("failed to create connection: %v", err)
 		}
+	}
 
-		now := time.Now()
-		err := tx.Model(&Stage{}).
-			Where("id = ?", id).
-			Update("updated_at", now).
-			Update("updated_by", requesterID).
-			Update("executor_spec", datatypes.NewJSONType(executorSpec)).
-			Update("conditions", datatypes.NewJSONSlice(conditions)).
-			Update("inputs", datatypes.NewJSONSlice(inputs)).
-			Update("input_mappings", datatypes.NewJSONSlice(inputMappings)).
-			Update("outputs", datatypes.NewJSONSlice(outputs)).
-			Update("secrets", datatypes.NewJSONSlice(secrets)).
-			Error
+	now := time.Now()
+	err := tx.Model(&Stage{}).
+		Where("id = ?", id).
+		Update("updated_at", now).
+		Update("updated_by", requesterID).
+		Update("executor_spec", datatypes.NewJSONType(executorSpec)).
+		Update("conditions", datatypes.NewJSONSlice(conditions)).
+		Update("inputs", datatypes.NewJSONSlice(inputs)).
+		Update("input_mappings", datatypes.NewJSONSlice(inputMappings)).
+		Update("outputs", datatypes.NewJSONSlice(outputs)).
+		Update("secrets", datatypes.NewJSONSlice(secrets)).
+		Update("max_concurrent_executions", maxConcurrentExecutions).
+		Update("queue_policy", queuePolicy).
+		Update("execution_timeout", executionTimeout).
+		Update("retry_policy", datatypes.NewJSONType(retryPolicy)).
+		Error
 
-		if err != nil {
-			return fmt.Errorf("failed to update stage timestamp: %v", err)
-		}
+	if err != nil {
+		return fmt.Errorf("failed to update stage timestamp: %v", err)
+	}
 
-		return nil
-	})
+	return nil
 }
 
 func ListCanvases() ([]Canvas, error) {
@@ -36,6 +36,58 @@ type Event struct {
 	ReceivedAt *time.Time
 	Raw        datatypes.JSON
 	Headers    datatypes.JSON
+
+	//
+	// The user who emitted or replayed the event through the API.
+	// Empty for events received from webhooks or schedules.
+	//
+	TriggeredBy *uuid.UUID
+
+	//
+	// Replays are copies of a stored event, processed again by the pending events worker.
+	// They can target a single stage, and either go through the current connection filters and input mappings,
+	// or be re-delivered to the stages that received the original event, with the same inputs.
+	//
+	ReplayOf              *uuid.UUID
+	ReplayStageID         *uuid.UUID
+	ReplayWithCurrentSpec bool
+}
+
+// IsRedelivery returns true for replays that are sent
+// to the same stages, with the same inputs, as the original event.
+func (e *Event) IsRedelivery() bool {
+	return e.ReplayOf != nil && !e.ReplayWithCurrentSpec
+}
+
+// CreateReplay creates a pending copy of the event,
+// so it is processed again by the pending events worker.
+func (e *Event) CreateReplay(stageID *uuid.UUID, withCurrentSpec bool, triggeredBy uuid.UUID) (*Event, error) {
+	now := time.Now()
+
+	replay := Event{
+		SourceID:              e.SourceID,
+		SourceName:            e.SourceName,
+		SourceType:            e.SourceType,
+		State:                 EventStatePending,
+		ReceivedAt:            &now,
+		Raw:                   e.Raw,
+		Headers:               e.Headers,
+		TriggeredBy:           &triggeredBy,
+		ReplayOf:              &e.ID,
+		ReplayStageID:         stageID,
+		ReplayWithCurrentSpec: withCurrentSpec,
+	}
+
+	err := database.Conn().
+		Clauses(clause.Returning{}).
+		Create(&replay).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &replay, nil
 }
 
 type headerVisitor struct{}
@@ -61,7 +113,11 @@ func (v *headerVisitor) Visit(node *ast.Node) {
 }
 
 func (e *Event) Discard() error {
-	return database.Conn().Model(e).
+	return e.DiscardInTransaction(database.Conn())
+}
+
+func (e *Event) DiscardInTransaction(tx *gorm.DB) error {
+	return tx.Model(e).
 		Update("state", EventStateDiscarded).
 		Error
 }
@@ -194,16 +250,27 @@ func CreateEvent(sourceID uuid.UUID, sourceName, sourceType string, raw []byte,
 }
 
 func CreateEventInTransaction(tx *gorm.DB, sourceID uuid.UUID, sourceName, sourceType string, raw []byte, headers []byte) (*Event, error) {
+	return createEvent(tx, sourceID, sourceName, sourceType, raw, headers, nil)
+}
+
+// CreateTriggeredEvent creates an event emitted by a user,
+// instead of being received from the event source.
+func CreateTriggeredEvent(source *EventSource, raw []byte, headers []byte, triggeredBy uuid.UUID) (*Event, error) {
+	return createEvent(database.Conn(), source.ID, source.Name, SourceTypeEventSource, raw, headers, &triggeredBy)
+}
+
+func createEvent(tx *gorm.DB, sourceID uuid.UUID, sourceName, sourceType string, raw []byte, headers []byte, triggeredBy *uuid.UUID) (*Event, error) {
 	now := time.Now()
 
 	event := Event{
-		SourceID:   sourceID,
-		SourceName: sourceName,
-		SourceType: sourceType,
-		State:      EventStatePending,
-		ReceivedAt: &now,
-		Raw:        datatypes.JSON(raw),
-		Headers:    datatypes.JSON(headers),
+		SourceID:    sourceID,
+		SourceName:  sourceName,
+		SourceType:  sourceType,
+		State:       EventStatePending,
+		ReceivedAt:  &now,
+		Raw:         datatypes.JSON(raw),
+		Headers:     datatypes.JSON(headers),
+		TriggeredBy: triggeredBy,
 	}
 
 	err := tx.
@@ -228,6 +295,25 @@ func ListPendingEvents() ([]Event, error) {
 	return events, database.Conn().Where("state = ?", EventStatePending).Find(&events).Error
 }
 
+// LockPendingEvent locks a pending event until the transaction ends.
+// Events locked by other workers, or not pending anymore, are not found.
+func LockPendingEvent(tx *gorm.DB, id uuid.UUID) (*Event, error) {
+	var event Event
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("state = ?", EventStatePending).
+		First(&event).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
 func FindEventByID(id uuid.UUID) (*Event, error) {
 	var event Event
 	return &event, database.Conn().Where("id = ?", id).First(&event).Error
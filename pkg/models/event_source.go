@@ -1,10 +1,37 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/cron"
 	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	WebhookAlgorithmHMACSHA1   = "hmac-sha1"
+	WebhookAlgorithmHMACSHA256 = "hmac-sha256"
+	WebhookAlgorithmHMACSHA512 = "hmac-sha512"
+	WebhookAlgorithmBearer     = "bearer"
+	WebhookAlgorithmBasic      = "basic"
+
+	WebhookEncodingHex    = "hex"
+	WebhookEncodingBase64 = "base64"
+
+	//
+	// What is signed by the sender:
+	// - body: the request body only.
+	// - timestamp-and-body: the timestamp header, a separator and the body, like Stripe and Slack do.
+	//
+	WebhookSignedContentBody             = "body"
+	WebhookSignedContentTimestampAndBody = "timestamp-and-body"
 )
 
 type EventSource struct {
@@ -14,6 +41,161 @@ type EventSource struct {
 	Key       []byte
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
+
+	Spec datatypes.JSONType[EventSourceSpec]
+
+	//
+	// Only used by scheduled event sources.
+	// The last time the schedule fired is kept,
+	// so restarts of the schedule worker do not fire twice or skip runs.
+	//
+	LastFiredAt *time.Time
+	NextFireAt  *time.Time
+}
+
+type EventSourceSpec struct {
+	Webhook  *WebhookSpec  `json:"webhook,omitempty"`
+	Schedule *ScheduleSpec `json:"schedule,omitempty"`
+}
+
+// ScheduleSpec describes an event source that emits events on a cron schedule.
+// The payload can use ${{ schedule.time }}, ${{ schedule.date }}
+// and ${{ schedule.timestamp }} for the time the schedule fired.
+type ScheduleSpec struct {
+	Cron     string         `json:"cron"`
+	Timezone string         `json:"timezone"`
+	Payload  map[string]any `json:"payload"`
+}
+
+var scheduleExpressionRegex = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
+
+func (s *ScheduleSpec) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(s.Timezone)
+}
+
+// NextFireAt returns when the schedule fires next, after the time given.
+// The time returned is in UTC, since fire times are stored
+// in timestamp columns, which do not keep the time zone.
+func (s *ScheduleSpec) NextFireAt(after time.Time) (*time.Time, error) {
+	schedule, err := cron.Parse(s.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %v", err)
+	}
+
+	loc, err := s.Location()
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %v", err)
+	}
+
+	next := schedule.Next(after.In(loc))
+	if next.IsZero() {
+		return nil, fmt.Errorf("cron expression %s never matches", s.Cron)
+	}
+
+	next = next.UTC()
+	return &next, nil
+}
+
+// BuildPayload returns the payload of the event
+// emitted when the schedule fires at the time given.
+func (s *ScheduleSpec) BuildPayload(firedAt time.Time) ([]byte, error) {
+	loc, err := s.Location()
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %v", err)
+	}
+
+	firedAt = firedAt.In(loc)
+	variables := map[string]string{
+		"schedule.time":      firedAt.Format(time.RFC3339),
+		"schedule.date":      firedAt.Format(time.DateOnly),
+		"schedule.timestamp": fmt.Sprintf("%d", firedAt.Unix()),
+	}
+
+	payload, err := resolveScheduleValue(s.Payload, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	return json.Marshal(payload)
+}
+
+func resolveScheduleValue(value any, variables map[string]string) (any, error) {
+	switch v := value.(type) {
+	case string:
+		var err error
+		resolved := scheduleExpressionRegex.ReplaceAllStringFunc(v, func(match string) string {
+			name := strings.TrimSpace(scheduleExpressionRegex.FindStringSubmatch(match)[1])
+			variable, ok := variables[name]
+			if !ok {
+				err = fmt.Errorf("unknown variable %s", name)
+			}
+
+			return variable
+		})
+
+		return resolved, err
+
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, item := range v {
+			resolved, err := resolveScheduleValue(item, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			result[k] = resolved
+		}
+
+		return result, nil
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := resolveScheduleValue(item, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = resolved
+		}
+
+		return result, nil
+
+	default:
+		return v, nil
+	}
+}
+
+// WebhookSpec describes how requests sent to the generic webhook endpoint
+// of an event source are authenticated.
+type WebhookSpec struct {
+	Algorithm       string `json:"algorithm"`
+	SignatureHeader string `json:"signature_header"`
+	SignaturePrefix string `json:"signature_prefix"`
+	Encoding        string `json:"encoding"`
+	SignedContent   string `json:"signed_content"`
+
+	//
+	// Only used when the signed content includes the timestamp.
+	// The content signed is <prefix><timestamp><separator><body>.
+	//
+	SignedContentPrefix string `json:"signed_content_prefix"`
+	TimestampHeader     string `json:"timestamp_header"`
+	TimestampSeparator  string `json:"timestamp_separator"`
+
+	//
+	// How old, in seconds, a request can be.
+	// Zero means requests are accepted no matter when they were sent.
+	//
+	ReplayWindow int `json:"replay_window"`
 }
 
 func FindEventSource(id uuid.UUID) (*EventSource, error) {
@@ -43,3 +225,36 @@ func (c *Canvas) ListEventSources() ([]EventSource, error) {
 
 	return sources, nil
 }
+
+// LockDueScheduledEventSources locks the scheduled event sources
+// that should have fired at the time given.
+func LockDueScheduledEventSources(tx *gorm.DB, now time.Time, limit int) ([]EventSource, error) {
+	var sources []EventSource
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("next_fire_at <= ?", now.UTC()).
+		Order("next_fire_at ASC").
+		Limit(limit).
+		Find(&sources).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+func (s *EventSource) UpdateFireTimesInTransaction(tx *gorm.DB, lastFiredAt time.Time, nextFireAt *time.Time) error {
+	s.LastFiredAt = &lastFiredAt
+	s.NextFireAt = nextFireAt
+
+	return tx.Model(s).
+		Updates(map[string]any{
+			"last_fired_at": s.LastFiredAt,
+			"next_fire_at":  s.NextFireAt,
+			"updated_at":    time.Now(),
+		}).
+		Error
+}
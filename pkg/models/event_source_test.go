@@ -0,0 +1,78 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ScheduleSpec(t *testing.T) {
+	t.Run("next fire time uses the schedule timezone", func(t *testing.T) {
+		spec := ScheduleSpec{Cron: "0 2 * * *", Timezone: "Europe/Belgrade"}
+
+		next, err := spec.NextFireAt(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC), next.UTC())
+	})
+
+	t.Run("next fire time is in UTC", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		spec := ScheduleSpec{Cron: "0 2 * * *", Timezone: "America/New_York"}
+		next, err := spec.NextFireAt(time.Date(2025, 1, 1, 12, 0, 0, 0, loc))
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, next.Location())
+		assert.Equal(t, time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC), *next)
+	})
+
+	t.Run("invalid timezone -> error", func(t *testing.T) {
+		spec := ScheduleSpec{Cron: "0 2 * * *", Timezone: "Mars/Olympus"}
+		_, err := spec.NextFireAt(time.Now())
+		require.ErrorContains(t, err, "invalid timezone")
+	})
+
+	t.Run("invalid cron expression -> error", func(t *testing.T) {
+		spec := ScheduleSpec{Cron: "every day"}
+		_, err := spec.NextFireAt(time.Now())
+		require.ErrorContains(t, err, "invalid cron expression")
+	})
+
+	t.Run("payload is templated with the time the schedule fired", func(t *testing.T) {
+		spec := ScheduleSpec{
+			Cron:     "0 2 * * *",
+			Timezone: "Europe/Belgrade",
+			Payload: map[string]any{
+				"type": "nightly",
+				"run": map[string]any{
+					"date": "${{ schedule.date }}",
+					"tags": []any{"at-${{ schedule.time }}", 1},
+				},
+				"timestamp": "${{ schedule.timestamp }}",
+			},
+		}
+
+		payload, err := spec.BuildPayload(time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": "nightly",
+			"run": {"date": "2025-01-02", "tags": ["at-2025-01-02T02:00:00+01:00", 1]},
+			"timestamp": "1735779600"
+		}`, string(payload))
+	})
+
+	t.Run("no payload -> empty object", func(t *testing.T) {
+		spec := ScheduleSpec{Cron: "0 2 * * *"}
+		payload, err := spec.BuildPayload(time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(payload))
+	})
+
+	t.Run("unknown variable in payload -> error", func(t *testing.T) {
+		spec := ScheduleSpec{Cron: "0 2 * * *", Payload: map[string]any{"a": "${{ inputs.A }}"}}
+		_, err := spec.BuildPayload(time.Now())
+		require.ErrorContains(t, err, "unknown variable inputs.A")
+	})
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	ExecutionLogStreamStdout = "stdout"
+	ExecutionLogStreamStderr = "stderr"
+
+	// Logs written by Superplane itself,
+	// like the requests made by the HTTP executor.
+	ExecutionLogStreamSystem = "system"
+)
+
+// Execution logs are stored in chunks.
+// The ID is sequential, so it can be used to read the logs in order,
+// and to continue reading from where the last read stopped.
+// Writes for the same execution never run at the same time,
+// so they are committed in ID order, and a reader never skips a chunk
+// that was committed after a chunk with a bigger ID.
+type ExecutionLog struct {
+	ID          int64 `gorm:"primary_key"`
+	ExecutionID uuid.UUID
+	Stream      string
+	Content     string
+	CreatedAt   *time.Time
+}
+
+func CreateExecutionLogs(executionID uuid.UUID, logs []ExecutionLog) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return CreateExecutionLogsInTransaction(tx, executionID, logs)
+	})
+}
+
+// The lock is held until the transaction ends,
+// so the IDs given to the next write for the execution are always bigger.
+func CreateExecutionLogsInTransaction(tx *gorm.DB, executionID uuid.UUID, logs []ExecutionLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", executionID.String()).Error
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range logs {
+		logs[i].ExecutionID = executionID
+		logs[i].CreatedAt = &now
+	}
+
+	return tx.Create(&logs).Error
+}
+
+func ListExecutionLogs(executionID uuid.UUID, afterID int64, limit int) ([]ExecutionLog, error) {
+	var logs []ExecutionLog
+
+	err := database.Conn().
+		Where("execution_id = ?", executionID).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&logs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Messages are written to the outbox in the same transaction
+// as the changes they are about, and published by the outbox relay
+// after that transaction commits.
+type OutboxMessage struct {
+	ID            uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	Exchange      string
+	RoutingKey    string
+	Body          []byte
+	Attempts      int
+	LastError     string
+	CreatedAt     *time.Time
+	NextAttemptAt *time.Time
+	SentAt        *time.Time
+}
+
+func CreateOutboxMessage(exchange, routingKey string, body []byte) (*OutboxMessage, error) {
+	return CreateOutboxMessageInTransaction(database.Conn(), exchange, routingKey, body)
+}
+
+func CreateOutboxMessageInTransaction(tx *gorm.DB, exchange, routingKey string, body []byte) (*OutboxMessage, error) {
+	now := time.Now()
+	message := OutboxMessage{
+		Exchange:      exchange,
+		RoutingKey:    routingKey,
+		Body:          body,
+		CreatedAt:     &now,
+		NextAttemptAt: &now,
+	}
+
+	err := tx.
+		Clauses(clause.Returning{}).
+		Create(&message).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// LockUnsentOutboxMessages locks the oldest messages that are due to be sent,
+// until the transaction ends. Messages locked by other relays are skipped.
+func LockUnsentOutboxMessages(tx *gorm.DB, now time.Time, limit int) ([]OutboxMessage, error) {
+	var messages []OutboxMessage
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("sent_at IS NULL").
+		Where("next_attempt_at <= ?", now).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&messages).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func ListUnsentOutboxMessages() ([]OutboxMessage, error) {
+	var messages []OutboxMessage
+
+	err := database.Conn().
+		Where("sent_at IS NULL").
+		Order("created_at ASC").
+		Find(&messages).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (m *OutboxMessage) MarkAsSentInTransaction(tx *gorm.DB, now time.Time) error {
+	return tx.Model(m).
+		Clauses(clause.Returning{}).
+		Update("sent_at", &now).
+		Update("attempts", m.Attempts+1).
+		Error
+}
+
+func (m *OutboxMessage) MarkAsFailedInTransaction(tx *gorm.DB, reason string, nextAttemptAt time.Time) error {
+	return tx.Model(m).
+		Clauses(clause.Returning{}).
+		Update("last_error", reason).
+		Update("attempts", m.Attempts+1).
+		Update("next_attempt_at", &nextAttemptAt).
+		Error
+}
+
+// DeleteSentOutboxMessages removes messages sent before the time given.
+func DeleteSentOutboxMessages(before time.Time) error {
+	return database.Conn().
+		Where("sent_at IS NOT NULL").
+		Where("sent_at < ?", before).
+		Delete(&OutboxMessage{}).
+		Error
+}
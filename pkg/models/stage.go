@@ -12,14 +12,56 @@ import (
 	"github.com/superplanehq/superplane/pkg/secrets"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
-	ExecutorSpecTypeSemaphore = "semaphore"
-	ExecutorSpecTypeHTTP      = "http"
+	ExecutorSpecTypeSemaphore  = "semaphore"
+	ExecutorSpecTypeHTTP       = "http"
+	ExecutorSpecTypeGitHub     = "github"
+	ExecutorSpecTypeGitLab     = "gitlab"
+	ExecutorSpecTypeKubernetes = "kubernetes"
+	ExecutorSpecTypePlugin     = "plugin"
+	ExecutorSpecTypeProcess    = "process"
+	ExecutorSpecTypeNoop       = "noop"
 
 	StageConditionTypeApproval   = "approval"
 	StageConditionTypeTimeWindow = "time-window"
+
+	DefaultMaxConcurrentExecutions = 1
+
+	//
+	// Queue policies control what happens to the stage queue when new events arrive.
+	// - fifo: events are processed in the order they arrive.
+	// - latest: only the newest pending event is processed, older pending events are cancelled.
+	// - cancel-running: same as latest, but executions in progress are also cancelled.
+	//
+	StageQueuePolicyFIFO          = "fifo"
+	StageQueuePolicyLatest        = "latest"
+	StageQueuePolicyCancelRunning = "cancel-running"
+
+	//
+	// Kinds of failures that can be retried by a stage retry policy.
+	// - failed: the executor reported that the execution failed.
+	// - timeout: the execution ran for longer than the stage execution timeout.
+	// - error: the executor could not be called.
+	//
+	RetryOnFailed  = "failed"
+	RetryOnTimeout = "timeout"
+	RetryOnError   = "error"
+
+	BackoffStrategyFixed       = "fixed"
+	BackoffStrategyExponential = "exponential"
+
+	//
+	// Modes for the HTTP executor.
+	// - sync: the execution finishes when the HTTP request completes.
+	// - polling: the response holds an ID, and a status URL is polled until the execution finishes.
+	// - callback: the remote system reports the execution result to Superplane.
+	//
+	HTTPExecutorModeSync     = "sync"
+	HTTPExecutorModePolling  = "polling"
+	HTTPExecutorModeCallback = "callback"
 )
 
 type Stage struct {
@@ -37,6 +79,75 @@ type Stage struct {
 	InputMappings datatypes.JSONSlice[InputMapping]
 	Outputs       datatypes.JSONSlice[OutputDefinition]
 	Secrets       datatypes.JSONSlice[ValueDefinition]
+
+	//
+	// How many executions can be pending or started for this stage at the same time.
+	//
+	MaxConcurrentExecutions int
+
+	//
+	// What to do with older events in the queue when a newer one arrives.
+	//
+	QueuePolicy string
+
+	//
+	// How long, in seconds, an execution for this stage can run.
+	// Zero means executions never time out.
+	//
+	ExecutionTimeout int
+
+	//
+	// If and how failed executions are automatically retried.
+	//
+	RetryPolicy datatypes.JSONType[RetryPolicy]
+}
+
+type RetryPolicy struct {
+	MaxAttempts     int      `json:"max_attempts"`
+	Backoff         int      `json:"backoff"`
+	BackoffStrategy string   `json:"backoff_strategy"`
+	RetryOn         []string `json:"retry_on"`
+}
+
+// ShouldRetry returns true if an execution on its Nth attempt,
+// which finished with the result and reason given, should be attempted again.
+func (p *RetryPolicy) ShouldRetry(attempt int, result, reason string) bool {
+	if attempt >= p.MaxAttempts || result != StageExecutionResultFailed {
+		return false
+	}
+
+	kind := RetryOnFailed
+	switch reason {
+	case StageExecutionResultReasonTimeout:
+		kind = RetryOnTimeout
+	case StageExecutionResultReasonError:
+		kind = RetryOnError
+	}
+
+	return slices.Contains(p.RetryOn, kind)
+}
+
+// Backoffs never get longer than this,
+// even if they keep doubling with the exponential strategy.
+const MaxRetryBackoff = 24 * time.Hour
+
+// BackoffFor returns how long to wait before running
+// the attempt that comes after the Nth attempt.
+func (p *RetryPolicy) BackoffFor(attempt int) time.Duration {
+	if p.Backoff >= int(MaxRetryBackoff/time.Second) {
+		return MaxRetryBackoff
+	}
+
+	backoff := time.Duration(p.Backoff) * time.Second
+	if p.BackoffStrategy != BackoffStrategyExponential {
+		return backoff
+	}
+
+	for i := 1; i < attempt && backoff < MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, MaxRetryBackoff)
 }
 
 type InputDefinition struct {
@@ -191,9 +302,15 @@ type ApprovalCondition struct {
 }
 
 type ExecutorSpec struct {
-	Type      string                 `json:"type"`
-	Semaphore *SemaphoreExecutorSpec `json:"semaphore,omitempty"`
-	HTTP      *HTTPExecutorSpec      `json:"http,omitempty"`
+	Type       string                  `json:"type"`
+	Semaphore  *SemaphoreExecutorSpec  `json:"semaphore,omitempty"`
+	HTTP       *HTTPExecutorSpec       `json:"http,omitempty"`
+	GitHub     *GitHubExecutorSpec     `json:"github,omitempty"`
+	GitLab     *GitLabExecutorSpec     `json:"gitlab,omitempty"`
+	Kubernetes *KubernetesExecutorSpec `json:"kubernetes,omitempty"`
+	Plugin     *PluginExecutorSpec     `json:"plugin,omitempty"`
+	Process    *ProcessExecutorSpec    `json:"process,omitempty"`
+	Noop       *NoopExecutorSpec       `json:"noop,omitempty"`
 }
 
 type SemaphoreExecutorSpec struct {
@@ -204,19 +321,108 @@ type SemaphoreExecutorSpec struct {
 	PipelineFile    string            `json:"pipeline_file"`
 	Parameters      map[string]string `json:"parameters"`
 	TaskID          string            `json:"task_id"`
+	WorkflowID      string            `json:"workflow_id,omitempty"`
+	Promotion       string            `json:"promotion,omitempty"`
+}
+
+// Semaphore executions run a task if a task ID is given,
+// promote a pipeline if a promotion name is given,
+// and run a workflow for the branch otherwise.
+func (s *SemaphoreExecutorSpec) IsPromotion() bool {
+	return s.TaskID == "" && s.Promotion != ""
+}
+
+type GitHubExecutorSpec struct {
+	URL        string            `json:"url,omitempty"`
+	Token      string            `json:"token"`
+	Owner      string            `json:"owner"`
+	Repository string            `json:"repository"`
+	Workflow   string            `json:"workflow"`
+	Ref        string            `json:"ref"`
+	Inputs     map[string]string `json:"inputs"`
+
+	//
+	// Sending the SUPERPLANE_* inputs is opt-in,
+	// since GitHub rejects inputs not declared by the workflow,
+	// and shows the inputs of a run in its UI.
+	//
+	ExecutionInputs bool `json:"execution_inputs,omitempty"`
+}
+
+type GitLabExecutorSpec struct {
+	URL       string            `json:"url,omitempty"`
+	Token     string            `json:"token"`
+	ProjectID string            `json:"project_id"`
+	Ref       string            `json:"ref"`
+	Variables map[string]string `json:"variables"`
+}
+
+type KubernetesExecutorSpec struct {
+	URL                     string `json:"url,omitempty"`
+	Token                   string `json:"token,omitempty"`
+	CACertificate           string `json:"ca_certificate,omitempty"`
+	Namespace               string `json:"namespace"`
+	Manifest                string `json:"manifest"`
+	TTLSecondsAfterFinished int32  `json:"ttl_seconds_after_finished"`
+}
+
+type PluginExecutorSpec struct {
+	Name   string         `json:"name"`
+	Config map[string]any `json:"config"`
+}
+
+type ProcessExecutorSpec struct {
+	Agent            string            `json:"agent"`
+	Command          string            `json:"command"`
+	Args             []string          `json:"args"`
+	Env              map[string]string `json:"env"`
+	WorkingDirectory string            `json:"working_directory,omitempty"`
+}
+
+// Noop executors do not run anything.
+// Their outputs can use expressions, so inputs can be passed along.
+type NoopExecutorSpec struct {
+	Outputs map[string]string `json:"outputs"`
 }
 
 type HTTPExecutorSpec struct {
 	URL            string              `json:"url"`
+	Method         string              `json:"method,omitempty"`
+	QueryParams    map[string]string   `json:"query_params,omitempty"`
 	Payload        map[string]string   `json:"payload"`
+	Body           string              `json:"body,omitempty"`
 	Headers        map[string]string   `json:"headers"`
 	ResponsePolicy *HTTPResponsePolicy `json:"success_policy"`
+	Mode           string              `json:"mode,omitempty"`
+	StatusPolicy   *HTTPStatusPolicy   `json:"status_policy,omitempty"`
+	Outputs        []HTTPOutput        `json:"outputs,omitempty"`
+}
+
+func (s *HTTPExecutorSpec) IsAsync() bool {
+	return s.Mode == HTTPExecutorModePolling || s.Mode == HTTPExecutorModeCallback
 }
 
 type HTTPResponsePolicy struct {
 	StatusCodes []uint32 `json:"status_codes"`
 }
 
+// HTTPOutput extracts a stage output from the HTTP response.
+// The expression can use the response body, headers and status code.
+type HTTPOutput struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+// HTTPStatusPolicy describes how the status of an execution
+// started by an HTTP executor in polling mode is checked.
+// The expressions are evaluated against the JSON response bodies.
+type HTTPStatusPolicy struct {
+	IDExpression      string `json:"id_expression"`
+	URL               string `json:"url"`
+	SuccessExpression string `json:"success_expression"`
+	FailureExpression string `json:"failure_expression"`
+}
+
 func FindStageByID(id string) (*Stage, error) {
 	return FindStageByIDInTransaction(database.Conn(), id)
 }
@@ -236,6 +442,26 @@ func FindStageByIDInTransaction(tx *gorm.DB, id string) (*Stage, error) {
 	return &stage, nil
 }
 
+// LockStage locks the stage until the transaction ends,
+// so only one worker creates executions for it at a time.
+// FOR NO KEY UPDATE still allows rows referencing the stage to be created.
+// Stages locked by other workers are not found.
+func LockStage(tx *gorm.DB, id uuid.UUID) (*Stage, error) {
+	var stage Stage
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "NO KEY UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		First(&stage).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &stage, nil
+}
+
 func FindStage(id, canvasID uuid.UUID) (*Stage, error) {
 	var stage Stage
 
@@ -262,6 +488,32 @@ func (s *Stage) ApprovalsRequired() int {
 	return 0
 }
 
+// ConcurrencyLimit returns how many executions can be in progress
+// for this stage at the same time. It is never lower than 1.
+func (s *Stage) ConcurrencyLimit() int {
+	if s.MaxConcurrentExecutions < 1 {
+		return DefaultMaxConcurrentExecutions
+	}
+
+	return s.MaxConcurrentExecutions
+}
+
+// SupersedesOlderEvents returns true if older pending events
+// should be discarded when a newer event arrives for this stage.
+func (s *Stage) SupersedesOlderEvents() bool {
+	return s.QueuePolicy == StageQueuePolicyLatest || s.QueuePolicy == StageQueuePolicyCancelRunning
+}
+
+// ExecutionTimeoutDuration returns how long an execution for this stage
+// can run before being timed out, or zero if it can run forever.
+func (s *Stage) ExecutionTimeoutDuration() time.Duration {
+	if s.ExecutionTimeout <= 0 {
+		return 0
+	}
+
+	return time.Duration(s.ExecutionTimeout) * time.Second
+}
+
 func (s *Stage) HasApprovalCondition() bool {
 	for _, condition := range s.Conditions {
 		if condition.Type == StageConditionTypeApproval {
@@ -24,11 +24,13 @@ type StageInEvent struct {
 }
 
 type ExecutionInEvent struct {
-	ID         string     `json:"id"`
-	Result     string     `json:"result"`
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	StartedAt  *time.Time `json:"started_at,omitempty"`
-	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	ID           string     `json:"id"`
+	Result       string     `json:"result"`
+	ResultReason string     `json:"result_reason,omitempty"`
+	Attempt      int        `json:"attempt,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
 }
 
 func NewStageExecutionCompletion(execution *StageExecution, outputs map[string]any) (*StageExecutionCompletion, error) {
@@ -38,11 +40,13 @@ func NewStageExecutionCompletion(execution *StageExecution, outputs map[string]a
 			ID: execution.StageID.String(),
 		},
 		Execution: &ExecutionInEvent{
-			ID:         execution.ID.String(),
-			Result:     execution.Result,
-			CreatedAt:  execution.CreatedAt,
-			StartedAt:  execution.StartedAt,
-			FinishedAt: execution.FinishedAt,
+			ID:           execution.ID.String(),
+			Result:       execution.Result,
+			ResultReason: execution.ResultReason,
+			Attempt:      execution.Attempt,
+			CreatedAt:    execution.CreatedAt,
+			StartedAt:    execution.StartedAt,
+			FinishedAt:   execution.FinishedAt,
 		},
 		Outputs: outputs,
 	}, nil
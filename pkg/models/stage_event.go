@@ -63,6 +63,10 @@ func UpdateStageEventsInTransaction(tx *gorm.DB, ids []string, state, reason str
 }
 
 func (e *StageEvent) Approve(requesterID uuid.UUID) error {
+	return e.ApproveInTransaction(database.Conn(), requesterID)
+}
+
+func (e *StageEvent) ApproveInTransaction(tx *gorm.DB, requesterID uuid.UUID) error {
 	now := time.Now()
 
 	approval := StageEventApproval{
@@ -71,7 +75,7 @@ func (e *StageEvent) Approve(requesterID uuid.UUID) error {
 		ApprovedBy:   &requesterID,
 	}
 
-	err := database.Conn().Create(&approval).Error
+	err := tx.Create(&approval).Error
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
 			return ErrEventAlreadyApprovedByRequester
@@ -142,13 +146,49 @@ func CreateStageEventInTransaction(tx *gorm.DB, stageID uuid.UUID, event *Event,
 	return &stageEvent, nil
 }
 
-func FindOldestPendingStageEvent(stageID uuid.UUID) (*StageEvent, error) {
-	var event StageEvent
+// ListStageEventsForEvent returns the stage events created for an event.
+func ListStageEventsForEvent(eventID uuid.UUID) ([]StageEvent, error) {
+	var stageEvents []StageEvent
+
+	err := database.Conn().
+		Where("event_id = ?", eventID).
+		Find(&stageEvents).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stageEvents, nil
+}
+
+func ListOldestPendingStageEvents(stageID uuid.UUID, limit int) ([]StageEvent, error) {
+	var events []StageEvent
 
 	err := database.Conn().
 		Where("state = ?", StageEventStatePending).
 		Where("stage_id = ?", stageID).
 		Order("created_at ASC").
+		Limit(limit).
+		Find(&events).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// LockPendingStageEvent locks a pending stage event until the transaction ends.
+// Stage events locked by other workers, or not pending anymore, are not found.
+func LockPendingStageEvent(tx *gorm.DB, id uuid.UUID) (*StageEvent, error) {
+	var event StageEvent
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("state = ?", StageEventStatePending).
 		First(&event).
 		Error
 
@@ -17,8 +17,16 @@ const (
 	StageExecutionStarted  = "started"
 	StageExecutionFinished = "finished"
 
-	StageExecutionResultPassed = "passed"
-	StageExecutionResultFailed = "failed"
+	StageExecutionResultPassed    = "passed"
+	StageExecutionResultFailed    = "failed"
+	StageExecutionResultCancelled = "cancelled"
+
+	//
+	// Result reasons give more details about why an execution
+	// finished with a specific result. Empty means no specific reason.
+	//
+	StageExecutionResultReasonTimeout = "timeout"
+	StageExecutionResultReasonError   = "error"
 )
 
 type StageExecution struct {
@@ -27,12 +35,22 @@ type StageExecution struct {
 	StageEventID uuid.UUID
 	State        string
 	Result       string
+	ResultReason string
 	CreatedAt    *time.Time
 	UpdatedAt    *time.Time
 	StartedAt    *time.Time
 	FinishedAt   *time.Time
 	Outputs      datatypes.JSONType[map[string]any]
 
+	//
+	// Executions for the same stage event are attempts.
+	// Each attempt points to the one before it, and retried attempts
+	// are only picked up after their scheduled time.
+	//
+	Attempt             int
+	PreviousExecutionID *uuid.UUID
+	ScheduledAt         *time.Time
+
 	//
 	// TODO: not so sure about this column
 	// TODO: maybe we can use a special execution tag for this?
@@ -90,10 +108,34 @@ func (e *StageExecution) Start() error {
 		Error
 }
 
+// Claim moves a pending execution to the started state.
+// If another worker is claiming it at the same time,
+// or it is not pending anymore, gorm.ErrRecordNotFound is returned.
+func (e *StageExecution) Claim() error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		_, err := LockExecutionInState(tx, e.ID, StageExecutionPending)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(e).
+			Clauses(clause.Returning{}).
+			Update("state", StageExecutionStarted).
+			Update("started_at", &now).
+			Update("updated_at", &now).
+			Error
+	})
+}
+
 func (e *StageExecution) StartWithReferenceID(referenceID string) error {
+	return e.StartWithReferenceIDInTransaction(database.Conn(), referenceID)
+}
+
+func (e *StageExecution) StartWithReferenceIDInTransaction(tx *gorm.DB, referenceID string) error {
 	now := time.Now()
 
-	return database.Conn().Model(e).
+	return tx.Model(e).
 		Update("reference_id", referenceID).
 		Update("state", StageExecutionStarted).
 		Update("started_at", &now).
@@ -108,6 +150,16 @@ func (e *StageExecution) Finish(stage *Stage, result string) error {
 }
 
 func (e *StageExecution) FinishInTransaction(tx *gorm.DB, stage *Stage, result string) error {
+	return e.FinishWithReasonInTransaction(tx, stage, result, "")
+}
+
+func (e *StageExecution) FinishWithReason(stage *Stage, result, reason string) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return e.FinishWithReasonInTransaction(tx, stage, result, reason)
+	})
+}
+
+func (e *StageExecution) FinishWithReasonInTransaction(tx *gorm.DB, stage *Stage, result, reason string) error {
 	now := time.Now()
 
 	//
@@ -116,6 +168,7 @@ func (e *StageExecution) FinishInTransaction(tx *gorm.DB, stage *Stage, result s
 	err := tx.Model(e).
 		Clauses(clause.Returning{}).
 		Update("result", result).
+		Update("result_reason", reason).
 		Update("state", StageExecutionFinished).
 		Update("updated_at", &now).
 		Update("finished_at", &now).
@@ -125,6 +178,18 @@ func (e *StageExecution) FinishInTransaction(tx *gorm.DB, stage *Stage, result s
 		return err
 	}
 
+	//
+	// If the stage retry policy allows it, we schedule another attempt for the same event.
+	// The stage event is only processed and the completion event only emitted
+	// when no more attempts will be made.
+	//
+	retryPolicy := stage.RetryPolicy.Data()
+	if retryPolicy.ShouldRetry(e.Attempt, result, reason) {
+		scheduledAt := now.Add(retryPolicy.BackoffFor(e.Attempt))
+		_, err := CreateNextAttemptInTransaction(tx, e, &scheduledAt)
+		return err
+	}
+
 	//
 	// Update stage event state.
 	//
@@ -157,14 +222,71 @@ func (e *StageExecution) FinishInTransaction(tx *gorm.DB, stage *Stage, result s
 	return nil
 }
 
+// Retry creates a new attempt for the same stage event, which runs as soon as possible.
+// The stage event goes back to waiting for an execution to finish.
+func (e *StageExecution) Retry() (*StageExecution, error) {
+	var execution *StageExecution
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		execution, err = e.RetryInTransaction(tx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execution, nil
+}
+
+func (e *StageExecution) RetryInTransaction(tx *gorm.DB) (*StageExecution, error) {
+	execution, err := CreateNextAttemptInTransaction(tx, e, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = UpdateStageEventsInTransaction(
+		tx, []string{e.StageEventID.String()}, StageEventStateWaiting, StageEventStateReasonExecution,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execution, nil
+}
+
 func (e *StageExecution) UpdateOutputs(outputs map[string]any) error {
-	return database.Conn().Model(e).
+	return e.UpdateOutputsInTransaction(database.Conn(), outputs)
+}
+
+func (e *StageExecution) UpdateOutputsInTransaction(tx *gorm.DB, outputs map[string]any) error {
+	return tx.Model(e).
 		Clauses(clause.Returning{}).
 		Update("outputs", datatypes.NewJSONType(outputs)).
 		Update("updated_at", time.Now()).
 		Error
 }
 
+// LockExecutionInState locks an execution until the transaction ends.
+// Executions locked by other workers, or in other states, are not found.
+func LockExecutionInState(tx *gorm.DB, id uuid.UUID, state string) (*StageExecution, error) {
+	var execution StageExecution
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("state = ?", state).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
 func FindExecutionByReference(referenceId string) (*StageExecution, error) {
 	var execution StageExecution
 
@@ -195,11 +317,17 @@ func FindExecutionByID(id uuid.UUID) (*StageExecution, error) {
 	return &execution, nil
 }
 
+// FindExecutionByStageEventID returns the latest attempt for the stage event.
 func FindExecutionByStageEventID(id uuid.UUID) (*StageExecution, error) {
+	return FindExecutionByStageEventIDInTransaction(database.Conn(), id)
+}
+
+func FindExecutionByStageEventIDInTransaction(tx *gorm.DB, id uuid.UUID) (*StageExecution, error) {
 	var execution StageExecution
 
-	err := database.Conn().
+	err := tx.
 		Where("stage_event_id = ?", id).
+		Order("attempt DESC").
 		First(&execution).
 		Error
 
@@ -226,6 +354,102 @@ func FindExecutionInState(stageID uuid.UUID, states []string) (*StageExecution,
 	return &execution, nil
 }
 
+func CountExecutionsInState(stageID uuid.UUID, states []string) (int64, error) {
+	return CountExecutionsInStateInTransaction(database.Conn(), stageID, states)
+}
+
+func CountExecutionsInStateInTransaction(tx *gorm.DB, stageID uuid.UUID, states []string) (int64, error) {
+	var count int64
+
+	err := tx.
+		Model(&StageExecution{}).
+		Where("stage_id = ?", stageID).
+		Where("state IN ?", states).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func ListExecutionsInStateInTransaction(tx *gorm.DB, stageID uuid.UUID, states []string) ([]StageExecution, error) {
+	var executions []StageExecution
+
+	err := tx.
+		Where("stage_id = ?", stageID).
+		Where("state IN ?", states).
+		Order("created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// ListTimedOutExecutions lists executions in progress that are running for longer
+// than the execution timeout configured on their stages.
+// Executions that never started are measured from when they were scheduled to run.
+func ListTimedOutExecutions(now time.Time) ([]StageExecution, error) {
+	var executions []StageExecution
+
+	err := database.Conn().
+		Table("stage_executions").
+		Select("stage_executions.*").
+		Joins("inner join stages ON stages.id = stage_executions.stage_id").
+		Where("stage_executions.state IN ?", []string{StageExecutionPending, StageExecutionStarted}).
+		Where("stages.execution_timeout > 0").
+		Where("COALESCE(stage_executions.started_at, stage_executions.scheduled_at, stage_executions.created_at) + stages.execution_timeout * interval '1 second' < ?", now).
+		Order("stage_executions.created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+func ListExecutionAttempts(stageEventID uuid.UUID) ([]StageExecution, error) {
+	var executions []StageExecution
+
+	err := database.Conn().
+		Where("stage_event_id = ?", stageEventID).
+		Order("attempt ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// ListPendingStageExecutions lists pending executions
+// that are not scheduled to run after the time given.
+func ListPendingStageExecutions(now time.Time) ([]StageExecution, error) {
+	var executions []StageExecution
+
+	err := database.Conn().
+		Where("state = ?", StageExecutionPending).
+		Where("scheduled_at IS NULL OR scheduled_at <= ?", now).
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func ListStageExecutionsInState(state string) ([]StageExecution, error) {
 	var executions []StageExecution
 
@@ -251,6 +475,7 @@ func CreateStageExecutionInTransaction(tx *gorm.DB, stageID, stageEventID uuid.U
 		StageID:      stageID,
 		StageEventID: stageEventID,
 		State:        StageExecutionPending,
+		Attempt:      1,
 		CreatedAt:    &now,
 		UpdatedAt:    &now,
 	}
@@ -266,3 +491,30 @@ func CreateStageExecutionInTransaction(tx *gorm.DB, stageID, stageEventID uuid.U
 
 	return &execution, nil
 }
+
+// CreateNextAttemptInTransaction creates a new pending execution for the same stage event,
+// linked to the previous one. If scheduledAt is set, the attempt only runs after it.
+func CreateNextAttemptInTransaction(tx *gorm.DB, previous *StageExecution, scheduledAt *time.Time) (*StageExecution, error) {
+	now := time.Now()
+	execution := StageExecution{
+		StageID:             previous.StageID,
+		StageEventID:        previous.StageEventID,
+		State:               StageExecutionPending,
+		Attempt:             previous.Attempt + 1,
+		PreviousExecutionID: &previous.ID,
+		ScheduledAt:         scheduledAt,
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
+	}
+
+	err := tx.
+		Clauses(clause.Returning{}).
+		Create(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
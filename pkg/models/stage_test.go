@@ -1,6 +1,7 @@
 package models
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -76,3 +77,57 @@ func Test__TimeWindowCondition(t *testing.T) {
 		})
 	}
 }
+
+func Test__RetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:     3,
+		Backoff:         10,
+		BackoffStrategy: BackoffStrategyExponential,
+		RetryOn:         []string{RetryOnFailed, RetryOnTimeout},
+	}
+
+	t.Run("failure kind in policy -> retry", func(t *testing.T) {
+		require.True(t, policy.ShouldRetry(1, StageExecutionResultFailed, ""))
+		require.True(t, policy.ShouldRetry(2, StageExecutionResultFailed, StageExecutionResultReasonTimeout))
+	})
+
+	t.Run("failure kind not in policy -> no retry", func(t *testing.T) {
+		require.False(t, policy.ShouldRetry(1, StageExecutionResultFailed, StageExecutionResultReasonError))
+	})
+
+	t.Run("execution did not fail -> no retry", func(t *testing.T) {
+		require.False(t, policy.ShouldRetry(1, StageExecutionResultPassed, ""))
+		require.False(t, policy.ShouldRetry(1, StageExecutionResultCancelled, ""))
+	})
+
+	t.Run("max attempts reached -> no retry", func(t *testing.T) {
+		require.False(t, policy.ShouldRetry(3, StageExecutionResultFailed, ""))
+	})
+
+	t.Run("empty policy -> no retry", func(t *testing.T) {
+		empty := RetryPolicy{}
+		require.False(t, empty.ShouldRetry(1, StageExecutionResultFailed, ""))
+	})
+
+	t.Run("exponential backoff", func(t *testing.T) {
+		require.Equal(t, 10*time.Second, policy.BackoffFor(1))
+		require.Equal(t, 20*time.Second, policy.BackoffFor(2))
+		require.Equal(t, 40*time.Second, policy.BackoffFor(3))
+	})
+
+	t.Run("backoff is capped", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 10, Backoff: 3600, BackoffStrategy: BackoffStrategyExponential}
+		require.Equal(t, 16*time.Hour, policy.BackoffFor(5))
+		require.Equal(t, MaxRetryBackoff, policy.BackoffFor(6))
+		require.Equal(t, MaxRetryBackoff, policy.BackoffFor(100))
+
+		long := RetryPolicy{MaxAttempts: 3, Backoff: math.MaxInt, BackoffStrategy: BackoffStrategyFixed}
+		require.Equal(t, MaxRetryBackoff, long.BackoffFor(1))
+	})
+
+	t.Run("fixed backoff", func(t *testing.T) {
+		fixed := RetryPolicy{MaxAttempts: 3, Backoff: 10, BackoffStrategy: BackoffStrategyFixed}
+		require.Equal(t, 10*time.Second, fixed.BackoffFor(1))
+		require.Equal(t, 10*time.Second, fixed.BackoffFor(2))
+	})
+}
@@ -155,6 +155,132 @@ func (a *EventAPIService) SuperplaneApproveStageEventExecute(r ApiSuperplaneAppr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiSuperplaneCreateEventRequest struct {
+	ctx context.Context
+	ApiService *EventAPIService
+	canvasIdOrName string
+	eventSourceIdOrName string
+	body *SuperplaneCreateEventBody
+}
+
+func (r ApiSuperplaneCreateEventRequest) Body(body SuperplaneCreateEventBody) ApiSuperplaneCreateEventRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiSuperplaneCreateEventRequest) Execute() (*SuperplaneCreateEventResponse, *http.Response, error) {
+	return r.ApiService.SuperplaneCreateEventExecute(r)
+}
+
+/*
+SuperplaneCreateEvent Emit an event
+
+Emits an event from the specified event source, as if it was received through its webhook, and records who emitted it (canvas can be referenced by ID or name)
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param canvasIdOrName
+ @param eventSourceIdOrName
+ @return ApiSuperplaneCreateEventRequest
+*/
+func (a *EventAPIService) SuperplaneCreateEvent(ctx context.Context, canvasIdOrName string, eventSourceIdOrName string) ApiSuperplaneCreateEventRequest {
+	return ApiSuperplaneCreateEventRequest{
+		ApiService: a,
+		ctx: ctx,
+		canvasIdOrName: canvasIdOrName,
+		eventSourceIdOrName: eventSourceIdOrName,
+	}
+}
+
+// Execute executes the request
+//  @return SuperplaneCreateEventResponse
+func (a *EventAPIService) SuperplaneCreateEventExecute(r ApiSuperplaneCreateEventRequest) (*SuperplaneCreateEventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuperplaneCreateEventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventAPIService.SuperplaneCreateEvent")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasIdOrName}/event-sources/{eventSourceIdOrName}/events"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasIdOrName"+"}", url.PathEscape(parameterValueToString(r.canvasIdOrName, "canvasIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"eventSourceIdOrName"+"}", url.PathEscape(parameterValueToString(r.eventSourceIdOrName, "eventSourceIdOrName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSuperplaneListStageEventsRequest struct {
 	ctx context.Context
 	ApiService *EventAPIService
@@ -303,3 +429,129 @@ func (a *EventAPIService) SuperplaneListStageEventsExecute(r ApiSuperplaneListSt
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiSuperplaneReplayEventRequest struct {
+	ctx context.Context
+	ApiService *EventAPIService
+	canvasIdOrName string
+	eventId string
+	body *SuperplaneReplayEventBody
+}
+
+func (r ApiSuperplaneReplayEventRequest) Body(body SuperplaneReplayEventBody) ApiSuperplaneReplayEventRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiSuperplaneReplayEventRequest) Execute() (*SuperplaneReplayEventResponse, *http.Response, error) {
+	return r.ApiService.SuperplaneReplayEventExecute(r)
+}
+
+/*
+SuperplaneReplayEvent Replay an event
+
+Processes a stored event again, sending it to all connected stages or to a specific one. By default, the event is re-delivered to the stages that received it with the same inputs; use_current_spec uses the current connection filters and input mappings instead (canvas can be referenced by ID or name)
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param canvasIdOrName
+ @param eventId
+ @return ApiSuperplaneReplayEventRequest
+*/
+func (a *EventAPIService) SuperplaneReplayEvent(ctx context.Context, canvasIdOrName string, eventId string) ApiSuperplaneReplayEventRequest {
+	return ApiSuperplaneReplayEventRequest{
+		ApiService: a,
+		ctx: ctx,
+		canvasIdOrName: canvasIdOrName,
+		eventId: eventId,
+	}
+}
+
+// Execute executes the request
+//  @return SuperplaneReplayEventResponse
+func (a *EventAPIService) SuperplaneReplayEventExecute(r ApiSuperplaneReplayEventRequest) (*SuperplaneReplayEventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuperplaneReplayEventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventAPIService.SuperplaneReplayEvent")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasIdOrName}/events/{eventId}/replay"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasIdOrName"+"}", url.PathEscape(parameterValueToString(r.canvasIdOrName, "canvasIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -24,6 +24,136 @@ import (
 // StageAPIService StageAPI service
 type StageAPIService service
 
+type ApiSuperplaneCancelExecutionRequest struct {
+	ctx context.Context
+	ApiService *StageAPIService
+	canvasIdOrName string
+	stageIdOrName string
+	executionId string
+	body *SuperplaneCancelExecutionBody
+}
+
+func (r ApiSuperplaneCancelExecutionRequest) Body(body SuperplaneCancelExecutionBody) ApiSuperplaneCancelExecutionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiSuperplaneCancelExecutionRequest) Execute() (*SuperplaneCancelExecutionResponse, *http.Response, error) {
+	return r.ApiService.SuperplaneCancelExecutionExecute(r)
+}
+
+/*
+SuperplaneCancelExecution Cancel a stage execution
+
+Cancels the specified stage execution, stopping it in the executor if possible (canvas can be referenced by ID or name)
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param canvasIdOrName
+ @param stageIdOrName
+ @param executionId
+ @return ApiSuperplaneCancelExecutionRequest
+*/
+func (a *StageAPIService) SuperplaneCancelExecution(ctx context.Context, canvasIdOrName string, stageIdOrName string, executionId string) ApiSuperplaneCancelExecutionRequest {
+	return ApiSuperplaneCancelExecutionRequest{
+		ApiService: a,
+		ctx: ctx,
+		canvasIdOrName: canvasIdOrName,
+		stageIdOrName: stageIdOrName,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//  @return SuperplaneCancelExecutionResponse
+func (a *StageAPIService) SuperplaneCancelExecutionExecute(r ApiSuperplaneCancelExecutionRequest) (*SuperplaneCancelExecutionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuperplaneCancelExecutionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "StageAPIService.SuperplaneCancelExecution")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasIdOrName}/stages/{stageIdOrName}/executions/{executionId}/cancel"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasIdOrName"+"}", url.PathEscape(parameterValueToString(r.canvasIdOrName, "canvasIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"stageIdOrName"+"}", url.PathEscape(parameterValueToString(r.stageIdOrName, "stageIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSuperplaneCreateStageRequest struct {
 	ctx context.Context
 	ApiService *StageAPIService
@@ -270,6 +400,143 @@ func (a *StageAPIService) SuperplaneDescribeStageExecute(r ApiSuperplaneDescribe
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiSuperplaneGetExecutionLogsRequest struct {
+	ctx context.Context
+	ApiService *StageAPIService
+	canvasIdOrName string
+	stageIdOrName string
+	executionId string
+	afterId *string
+	follow *bool
+}
+
+func (r ApiSuperplaneGetExecutionLogsRequest) AfterId(afterId string) ApiSuperplaneGetExecutionLogsRequest {
+	r.afterId = &afterId
+	return r
+}
+
+func (r ApiSuperplaneGetExecutionLogsRequest) Follow(follow bool) ApiSuperplaneGetExecutionLogsRequest {
+	r.follow = &follow
+	return r
+}
+
+func (r ApiSuperplaneGetExecutionLogsRequest) Execute() (*SuperplaneGetExecutionLogsResponse, *http.Response, error) {
+	return r.ApiService.SuperplaneGetExecutionLogsExecute(r)
+}
+
+/*
+SuperplaneGetExecutionLogs Get the logs of a stage execution
+
+Returns the logs of the specified stage execution after the given log ID. In follow mode, waits for new logs while the execution is running (canvas can be referenced by ID or name)
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param canvasIdOrName
+ @param stageIdOrName
+ @param executionId
+ @return ApiSuperplaneGetExecutionLogsRequest
+*/
+func (a *StageAPIService) SuperplaneGetExecutionLogs(ctx context.Context, canvasIdOrName string, stageIdOrName string, executionId string) ApiSuperplaneGetExecutionLogsRequest {
+	return ApiSuperplaneGetExecutionLogsRequest{
+		ApiService: a,
+		ctx: ctx,
+		canvasIdOrName: canvasIdOrName,
+		stageIdOrName: stageIdOrName,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//  @return SuperplaneGetExecutionLogsResponse
+func (a *StageAPIService) SuperplaneGetExecutionLogsExecute(r ApiSuperplaneGetExecutionLogsRequest) (*SuperplaneGetExecutionLogsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuperplaneGetExecutionLogsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "StageAPIService.SuperplaneGetExecutionLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasIdOrName}/stages/{stageIdOrName}/executions/{executionId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasIdOrName"+"}", url.PathEscape(parameterValueToString(r.canvasIdOrName, "canvasIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"stageIdOrName"+"}", url.PathEscape(parameterValueToString(r.stageIdOrName, "stageIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.afterId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "afterId", r.afterId, "", "")
+	}
+	if r.follow != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "follow", r.follow, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSuperplaneListStagesRequest struct {
 	ctx context.Context
 	ApiService *StageAPIService
@@ -381,6 +648,136 @@ func (a *StageAPIService) SuperplaneListStagesExecute(r ApiSuperplaneListStagesR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiSuperplaneRetryExecutionRequest struct {
+	ctx context.Context
+	ApiService *StageAPIService
+	canvasIdOrName string
+	stageIdOrName string
+	executionId string
+	body *SuperplaneRetryExecutionBody
+}
+
+func (r ApiSuperplaneRetryExecutionRequest) Body(body SuperplaneRetryExecutionBody) ApiSuperplaneRetryExecutionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiSuperplaneRetryExecutionRequest) Execute() (*SuperplaneRetryExecutionResponse, *http.Response, error) {
+	return r.ApiService.SuperplaneRetryExecutionExecute(r)
+}
+
+/*
+SuperplaneRetryExecution Retry a stage execution
+
+Creates a new attempt for a finished stage execution, using the same event and inputs (canvas can be referenced by ID or name)
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param canvasIdOrName
+ @param stageIdOrName
+ @param executionId
+ @return ApiSuperplaneRetryExecutionRequest
+*/
+func (a *StageAPIService) SuperplaneRetryExecution(ctx context.Context, canvasIdOrName string, stageIdOrName string, executionId string) ApiSuperplaneRetryExecutionRequest {
+	return ApiSuperplaneRetryExecutionRequest{
+		ApiService: a,
+		ctx: ctx,
+		canvasIdOrName: canvasIdOrName,
+		stageIdOrName: stageIdOrName,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//  @return SuperplaneRetryExecutionResponse
+func (a *StageAPIService) SuperplaneRetryExecutionExecute(r ApiSuperplaneRetryExecutionRequest) (*SuperplaneRetryExecutionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuperplaneRetryExecutionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "StageAPIService.SuperplaneRetryExecution")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasIdOrName}/stages/{stageIdOrName}/executions/{executionId}/retry"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasIdOrName"+"}", url.PathEscape(parameterValueToString(r.canvasIdOrName, "canvasIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"stageIdOrName"+"}", url.PathEscape(parameterValueToString(r.stageIdOrName, "stageIdOrName")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSuperplaneUpdateStageRequest struct {
 	ctx context.Context
 	ApiService *StageAPIService
@@ -50,6 +50,8 @@ type APIClient struct {
 
 	// API Services
 
+	AgentAPI *AgentAPIService
+
 	AuthorizationAPI *AuthorizationAPIService
 
 	CanvasAPI *CanvasAPIService
@@ -81,6 +83,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AgentAPI = (*AgentAPIService)(&c.common)
 	c.AuthorizationAPI = (*AuthorizationAPIService)(&c.common)
 	c.CanvasAPI = (*CanvasAPIService)(&c.common)
 	c.EventAPI = (*EventAPIService)(&c.common)
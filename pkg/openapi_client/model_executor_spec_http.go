@@ -24,6 +24,12 @@ type ExecutorSpecHTTP struct {
 	Headers *map[string]string `json:"headers,omitempty"`
 	Payload *map[string]string `json:"payload,omitempty"`
 	ResponsePolicy *ExecutorSpecHTTPResponsePolicy `json:"responsePolicy,omitempty"`
+	Mode *ExecutorSpecHTTPMode `json:"mode,omitempty"`
+	StatusPolicy *ExecutorSpecHTTPStatusPolicy `json:"statusPolicy,omitempty"`
+	Method *string `json:"method,omitempty"`
+	QueryParams *map[string]string `json:"queryParams,omitempty"`
+	Body *string `json:"body,omitempty"`
+	Outputs []ExecutorSpecHTTPOutput `json:"outputs,omitempty"`
 }
 
 // NewExecutorSpecHTTP instantiates a new ExecutorSpecHTTP object
@@ -32,6 +38,8 @@ type ExecutorSpecHTTP struct {
 // will change when the set of required properties is changed
 func NewExecutorSpecHTTP() *ExecutorSpecHTTP {
 	this := ExecutorSpecHTTP{}
+	var mode ExecutorSpecHTTPMode = EXECUTORSPECHTTPMODE_HTTP_MODE_SYNC
+	this.Mode = &mode
 	return &this
 }
 
@@ -40,6 +48,8 @@ func NewExecutorSpecHTTP() *ExecutorSpecHTTP {
 // but it doesn't guarantee that properties required by API are set
 func NewExecutorSpecHTTPWithDefaults() *ExecutorSpecHTTP {
 	this := ExecutorSpecHTTP{}
+	var mode ExecutorSpecHTTPMode = EXECUTORSPECHTTPMODE_HTTP_MODE_SYNC
+	this.Mode = &mode
 	return &this
 }
 
@@ -171,6 +181,198 @@ func (o *ExecutorSpecHTTP) SetResponsePolicy(v ExecutorSpecHTTPResponsePolicy) {
 	o.ResponsePolicy = &v
 }
 
+// GetMode returns the Mode field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetMode() ExecutorSpecHTTPMode {
+	if o == nil || IsNil(o.Mode) {
+		var ret ExecutorSpecHTTPMode
+		return ret
+	}
+	return *o.Mode
+}
+
+// GetModeOk returns a tuple with the Mode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetModeOk() (*ExecutorSpecHTTPMode, bool) {
+	if o == nil || IsNil(o.Mode) {
+		return nil, false
+	}
+	return o.Mode, true
+}
+
+// HasMode returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasMode() bool {
+	if o != nil && !IsNil(o.Mode) {
+		return true
+	}
+
+	return false
+}
+
+// SetMode gets a reference to the given ExecutorSpecHTTPMode and assigns it to the Mode field.
+func (o *ExecutorSpecHTTP) SetMode(v ExecutorSpecHTTPMode) {
+	o.Mode = &v
+}
+
+// GetStatusPolicy returns the StatusPolicy field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetStatusPolicy() ExecutorSpecHTTPStatusPolicy {
+	if o == nil || IsNil(o.StatusPolicy) {
+		var ret ExecutorSpecHTTPStatusPolicy
+		return ret
+	}
+	return *o.StatusPolicy
+}
+
+// GetStatusPolicyOk returns a tuple with the StatusPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetStatusPolicyOk() (*ExecutorSpecHTTPStatusPolicy, bool) {
+	if o == nil || IsNil(o.StatusPolicy) {
+		return nil, false
+	}
+	return o.StatusPolicy, true
+}
+
+// HasStatusPolicy returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasStatusPolicy() bool {
+	if o != nil && !IsNil(o.StatusPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatusPolicy gets a reference to the given ExecutorSpecHTTPStatusPolicy and assigns it to the StatusPolicy field.
+func (o *ExecutorSpecHTTP) SetStatusPolicy(v ExecutorSpecHTTPStatusPolicy) {
+	o.StatusPolicy = &v
+}
+
+// GetMethod returns the Method field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetMethod() string {
+	if o == nil || IsNil(o.Method) {
+		var ret string
+		return ret
+	}
+	return *o.Method
+}
+
+// GetMethodOk returns a tuple with the Method field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetMethodOk() (*string, bool) {
+	if o == nil || IsNil(o.Method) {
+		return nil, false
+	}
+	return o.Method, true
+}
+
+// HasMethod returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasMethod() bool {
+	if o != nil && !IsNil(o.Method) {
+		return true
+	}
+
+	return false
+}
+
+// SetMethod gets a reference to the given string and assigns it to the Method field.
+func (o *ExecutorSpecHTTP) SetMethod(v string) {
+	o.Method = &v
+}
+
+// GetQueryParams returns the QueryParams field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetQueryParams() map[string]string {
+	if o == nil || IsNil(o.QueryParams) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.QueryParams
+}
+
+// GetQueryParamsOk returns a tuple with the QueryParams field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetQueryParamsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.QueryParams) {
+		return nil, false
+	}
+	return o.QueryParams, true
+}
+
+// HasQueryParams returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasQueryParams() bool {
+	if o != nil && !IsNil(o.QueryParams) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueryParams gets a reference to the given map[string]string and assigns it to the QueryParams field.
+func (o *ExecutorSpecHTTP) SetQueryParams(v map[string]string) {
+	o.QueryParams = &v
+}
+
+// GetBody returns the Body field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetBody() string {
+	if o == nil || IsNil(o.Body) {
+		var ret string
+		return ret
+	}
+	return *o.Body
+}
+
+// GetBodyOk returns a tuple with the Body field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetBodyOk() (*string, bool) {
+	if o == nil || IsNil(o.Body) {
+		return nil, false
+	}
+	return o.Body, true
+}
+
+// HasBody returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasBody() bool {
+	if o != nil && !IsNil(o.Body) {
+		return true
+	}
+
+	return false
+}
+
+// SetBody gets a reference to the given string and assigns it to the Body field.
+func (o *ExecutorSpecHTTP) SetBody(v string) {
+	o.Body = &v
+}
+
+// GetOutputs returns the Outputs field value if set, zero value otherwise.
+func (o *ExecutorSpecHTTP) GetOutputs() []ExecutorSpecHTTPOutput {
+	if o == nil || IsNil(o.Outputs) {
+		var ret []ExecutorSpecHTTPOutput
+		return ret
+	}
+	return o.Outputs
+}
+
+// GetOutputsOk returns a tuple with the Outputs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecHTTP) GetOutputsOk() ([]ExecutorSpecHTTPOutput, bool) {
+	if o == nil || IsNil(o.Outputs) {
+		return nil, false
+	}
+	return o.Outputs, true
+}
+
+// HasOutputs returns a boolean if a field has been set.
+func (o *ExecutorSpecHTTP) HasOutputs() bool {
+	if o != nil && !IsNil(o.Outputs) {
+		return true
+	}
+
+	return false
+}
+
+// SetOutputs gets a reference to the given []ExecutorSpecHTTPOutput and assigns it to the Outputs field.
+func (o *ExecutorSpecHTTP) SetOutputs(v []ExecutorSpecHTTPOutput) {
+	o.Outputs = v
+}
+
 func (o ExecutorSpecHTTP) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -193,6 +395,24 @@ func (o ExecutorSpecHTTP) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ResponsePolicy) {
 		toSerialize["responsePolicy"] = o.ResponsePolicy
 	}
+	if !IsNil(o.Mode) {
+		toSerialize["mode"] = o.Mode
+	}
+	if !IsNil(o.StatusPolicy) {
+		toSerialize["statusPolicy"] = o.StatusPolicy
+	}
+	if !IsNil(o.Method) {
+		toSerialize["method"] = o.Method
+	}
+	if !IsNil(o.QueryParams) {
+		toSerialize["queryParams"] = o.QueryParams
+	}
+	if !IsNil(o.Body) {
+		toSerialize["body"] = o.Body
+	}
+	if !IsNil(o.Outputs) {
+		toSerialize["outputs"] = o.Outputs
+	}
 	return toSerialize, nil
 }
 
@@ -27,6 +27,8 @@ type ExecutorSpecSemaphore struct {
 	Parameters *map[string]string `json:"parameters,omitempty"`
 	ApiToken *string `json:"apiToken,omitempty"`
 	OrganizationUrl *string `json:"organizationUrl,omitempty"`
+	WorkflowId *string `json:"workflowId,omitempty"`
+	Promotion *string `json:"promotion,omitempty"`
 }
 
 // NewExecutorSpecSemaphore instantiates a new ExecutorSpecSemaphore object
@@ -270,6 +272,70 @@ func (o *ExecutorSpecSemaphore) SetOrganizationUrl(v string) {
 	o.OrganizationUrl = &v
 }
 
+// GetWorkflowId returns the WorkflowId field value if set, zero value otherwise.
+func (o *ExecutorSpecSemaphore) GetWorkflowId() string {
+	if o == nil || IsNil(o.WorkflowId) {
+		var ret string
+		return ret
+	}
+	return *o.WorkflowId
+}
+
+// GetWorkflowIdOk returns a tuple with the WorkflowId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecSemaphore) GetWorkflowIdOk() (*string, bool) {
+	if o == nil || IsNil(o.WorkflowId) {
+		return nil, false
+	}
+	return o.WorkflowId, true
+}
+
+// HasWorkflowId returns a boolean if a field has been set.
+func (o *ExecutorSpecSemaphore) HasWorkflowId() bool {
+	if o != nil && !IsNil(o.WorkflowId) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorkflowId gets a reference to the given string and assigns it to the WorkflowId field.
+func (o *ExecutorSpecSemaphore) SetWorkflowId(v string) {
+	o.WorkflowId = &v
+}
+
+// GetPromotion returns the Promotion field value if set, zero value otherwise.
+func (o *ExecutorSpecSemaphore) GetPromotion() string {
+	if o == nil || IsNil(o.Promotion) {
+		var ret string
+		return ret
+	}
+	return *o.Promotion
+}
+
+// GetPromotionOk returns a tuple with the Promotion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ExecutorSpecSemaphore) GetPromotionOk() (*string, bool) {
+	if o == nil || IsNil(o.Promotion) {
+		return nil, false
+	}
+	return o.Promotion, true
+}
+
+// HasPromotion returns a boolean if a field has been set.
+func (o *ExecutorSpecSemaphore) HasPromotion() bool {
+	if o != nil && !IsNil(o.Promotion) {
+		return true
+	}
+
+	return false
+}
+
+// SetPromotion gets a reference to the given string and assigns it to the Promotion field.
+func (o *ExecutorSpecSemaphore) SetPromotion(v string) {
+	o.Promotion = &v
+}
+
 func (o ExecutorSpecSemaphore) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -301,6 +367,12 @@ func (o ExecutorSpecSemaphore) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.OrganizationUrl) {
 		toSerialize["organizationUrl"] = o.OrganizationUrl
 	}
+	if !IsNil(o.WorkflowId) {
+		toSerialize["workflowId"] = o.WorkflowId
+	}
+	if !IsNil(o.Promotion) {
+		toSerialize["promotion"] = o.Promotion
+	}
 	return toSerialize, nil
 }
 
@@ -29,6 +29,10 @@ type SuperplaneExecution struct {
 	StartedAt *time.Time `json:"startedAt,omitempty"`
 	FinishedAt *time.Time `json:"finishedAt,omitempty"`
 	Outputs []SuperplaneOutputValue `json:"outputs,omitempty"`
+	ResultReason *ExecutionResultReason `json:"resultReason,omitempty"`
+	Attempt *int64 `json:"attempt,omitempty"`
+	PreviousExecutionId *string `json:"previousExecutionId,omitempty"`
+	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
 }
 
 // NewSuperplaneExecution instantiates a new SuperplaneExecution object
@@ -41,6 +45,8 @@ func NewSuperplaneExecution() *SuperplaneExecution {
 	this.State = &state
 	var result ExecutionResult = EXECUTIONRESULT_RESULT_UNKNOWN
 	this.Result = &result
+	var resultReason ExecutionResultReason = EXECUTIONRESULTREASON_RESULT_REASON_NONE
+	this.ResultReason = &resultReason
 	return &this
 }
 
@@ -53,6 +59,8 @@ func NewSuperplaneExecutionWithDefaults() *SuperplaneExecution {
 	this.State = &state
 	var result ExecutionResult = EXECUTIONRESULT_RESULT_UNKNOWN
 	this.Result = &result
+	var resultReason ExecutionResultReason = EXECUTIONRESULTREASON_RESULT_REASON_NONE
+	this.ResultReason = &resultReason
 	return &this
 }
 
@@ -312,6 +320,134 @@ func (o *SuperplaneExecution) SetOutputs(v []SuperplaneOutputValue) {
 	o.Outputs = v
 }
 
+// GetResultReason returns the ResultReason field value if set, zero value otherwise.
+func (o *SuperplaneExecution) GetResultReason() ExecutionResultReason {
+	if o == nil || IsNil(o.ResultReason) {
+		var ret ExecutionResultReason
+		return ret
+	}
+	return *o.ResultReason
+}
+
+// GetResultReasonOk returns a tuple with the ResultReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecution) GetResultReasonOk() (*ExecutionResultReason, bool) {
+	if o == nil || IsNil(o.ResultReason) {
+		return nil, false
+	}
+	return o.ResultReason, true
+}
+
+// HasResultReason returns a boolean if a field has been set.
+func (o *SuperplaneExecution) HasResultReason() bool {
+	if o != nil && !IsNil(o.ResultReason) {
+		return true
+	}
+
+	return false
+}
+
+// SetResultReason gets a reference to the given ExecutionResultReason and assigns it to the ResultReason field.
+func (o *SuperplaneExecution) SetResultReason(v ExecutionResultReason) {
+	o.ResultReason = &v
+}
+
+// GetAttempt returns the Attempt field value if set, zero value otherwise.
+func (o *SuperplaneExecution) GetAttempt() int64 {
+	if o == nil || IsNil(o.Attempt) {
+		var ret int64
+		return ret
+	}
+	return *o.Attempt
+}
+
+// GetAttemptOk returns a tuple with the Attempt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecution) GetAttemptOk() (*int64, bool) {
+	if o == nil || IsNil(o.Attempt) {
+		return nil, false
+	}
+	return o.Attempt, true
+}
+
+// HasAttempt returns a boolean if a field has been set.
+func (o *SuperplaneExecution) HasAttempt() bool {
+	if o != nil && !IsNil(o.Attempt) {
+		return true
+	}
+
+	return false
+}
+
+// SetAttempt gets a reference to the given int64 and assigns it to the Attempt field.
+func (o *SuperplaneExecution) SetAttempt(v int64) {
+	o.Attempt = &v
+}
+
+// GetPreviousExecutionId returns the PreviousExecutionId field value if set, zero value otherwise.
+func (o *SuperplaneExecution) GetPreviousExecutionId() string {
+	if o == nil || IsNil(o.PreviousExecutionId) {
+		var ret string
+		return ret
+	}
+	return *o.PreviousExecutionId
+}
+
+// GetPreviousExecutionIdOk returns a tuple with the PreviousExecutionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecution) GetPreviousExecutionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviousExecutionId) {
+		return nil, false
+	}
+	return o.PreviousExecutionId, true
+}
+
+// HasPreviousExecutionId returns a boolean if a field has been set.
+func (o *SuperplaneExecution) HasPreviousExecutionId() bool {
+	if o != nil && !IsNil(o.PreviousExecutionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviousExecutionId gets a reference to the given string and assigns it to the PreviousExecutionId field.
+func (o *SuperplaneExecution) SetPreviousExecutionId(v string) {
+	o.PreviousExecutionId = &v
+}
+
+// GetScheduledAt returns the ScheduledAt field value if set, zero value otherwise.
+func (o *SuperplaneExecution) GetScheduledAt() time.Time {
+	if o == nil || IsNil(o.ScheduledAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ScheduledAt
+}
+
+// GetScheduledAtOk returns a tuple with the ScheduledAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecution) GetScheduledAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ScheduledAt) {
+		return nil, false
+	}
+	return o.ScheduledAt, true
+}
+
+// HasScheduledAt returns a boolean if a field has been set.
+func (o *SuperplaneExecution) HasScheduledAt() bool {
+	if o != nil && !IsNil(o.ScheduledAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetScheduledAt gets a reference to the given time.Time and assigns it to the ScheduledAt field.
+func (o *SuperplaneExecution) SetScheduledAt(v time.Time) {
+	o.ScheduledAt = &v
+}
+
 func (o SuperplaneExecution) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -346,6 +482,18 @@ func (o SuperplaneExecution) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Outputs) {
 		toSerialize["outputs"] = o.Outputs
 	}
+	if !IsNil(o.ResultReason) {
+		toSerialize["resultReason"] = o.ResultReason
+	}
+	if !IsNil(o.Attempt) {
+		toSerialize["attempt"] = o.Attempt
+	}
+	if !IsNil(o.PreviousExecutionId) {
+		toSerialize["previousExecutionId"] = o.PreviousExecutionId
+	}
+	if !IsNil(o.ScheduledAt) {
+		toSerialize["scheduledAt"] = o.ScheduledAt
+	}
 	return toSerialize, nil
 }
 
@@ -23,6 +23,12 @@ type SuperplaneExecutorSpec struct {
 	Type *SuperplaneExecutorSpecType `json:"type,omitempty"`
 	Semaphore *ExecutorSpecSemaphore `json:"semaphore,omitempty"`
 	Http *ExecutorSpecHTTP `json:"http,omitempty"`
+	Github *ExecutorSpecGitHub `json:"github,omitempty"`
+	Gitlab *ExecutorSpecGitLab `json:"gitlab,omitempty"`
+	Kubernetes *ExecutorSpecKubernetes `json:"kubernetes,omitempty"`
+	Plugin *ExecutorSpecPlugin `json:"plugin,omitempty"`
+	Process *ExecutorSpecProcess `json:"process,omitempty"`
+	Noop *ExecutorSpecNoop `json:"noop,omitempty"`
 }
 
 // NewSuperplaneExecutorSpec instantiates a new SuperplaneExecutorSpec object
@@ -142,6 +148,198 @@ func (o *SuperplaneExecutorSpec) SetHttp(v ExecutorSpecHTTP) {
 	o.Http = &v
 }
 
+// GetGithub returns the Github field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetGithub() ExecutorSpecGitHub {
+	if o == nil || IsNil(o.Github) {
+		var ret ExecutorSpecGitHub
+		return ret
+	}
+	return *o.Github
+}
+
+// GetGithubOk returns a tuple with the Github field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetGithubOk() (*ExecutorSpecGitHub, bool) {
+	if o == nil || IsNil(o.Github) {
+		return nil, false
+	}
+	return o.Github, true
+}
+
+// HasGithub returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasGithub() bool {
+	if o != nil && !IsNil(o.Github) {
+		return true
+	}
+
+	return false
+}
+
+// SetGithub gets a reference to the given ExecutorSpecGitHub and assigns it to the Github field.
+func (o *SuperplaneExecutorSpec) SetGithub(v ExecutorSpecGitHub) {
+	o.Github = &v
+}
+
+// GetGitlab returns the Gitlab field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetGitlab() ExecutorSpecGitLab {
+	if o == nil || IsNil(o.Gitlab) {
+		var ret ExecutorSpecGitLab
+		return ret
+	}
+	return *o.Gitlab
+}
+
+// GetGitlabOk returns a tuple with the Gitlab field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetGitlabOk() (*ExecutorSpecGitLab, bool) {
+	if o == nil || IsNil(o.Gitlab) {
+		return nil, false
+	}
+	return o.Gitlab, true
+}
+
+// HasGitlab returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasGitlab() bool {
+	if o != nil && !IsNil(o.Gitlab) {
+		return true
+	}
+
+	return false
+}
+
+// SetGitlab gets a reference to the given ExecutorSpecGitLab and assigns it to the Gitlab field.
+func (o *SuperplaneExecutorSpec) SetGitlab(v ExecutorSpecGitLab) {
+	o.Gitlab = &v
+}
+
+// GetKubernetes returns the Kubernetes field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetKubernetes() ExecutorSpecKubernetes {
+	if o == nil || IsNil(o.Kubernetes) {
+		var ret ExecutorSpecKubernetes
+		return ret
+	}
+	return *o.Kubernetes
+}
+
+// GetKubernetesOk returns a tuple with the Kubernetes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetKubernetesOk() (*ExecutorSpecKubernetes, bool) {
+	if o == nil || IsNil(o.Kubernetes) {
+		return nil, false
+	}
+	return o.Kubernetes, true
+}
+
+// HasKubernetes returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasKubernetes() bool {
+	if o != nil && !IsNil(o.Kubernetes) {
+		return true
+	}
+
+	return false
+}
+
+// SetKubernetes gets a reference to the given ExecutorSpecKubernetes and assigns it to the Kubernetes field.
+func (o *SuperplaneExecutorSpec) SetKubernetes(v ExecutorSpecKubernetes) {
+	o.Kubernetes = &v
+}
+
+// GetPlugin returns the Plugin field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetPlugin() ExecutorSpecPlugin {
+	if o == nil || IsNil(o.Plugin) {
+		var ret ExecutorSpecPlugin
+		return ret
+	}
+	return *o.Plugin
+}
+
+// GetPluginOk returns a tuple with the Plugin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetPluginOk() (*ExecutorSpecPlugin, bool) {
+	if o == nil || IsNil(o.Plugin) {
+		return nil, false
+	}
+	return o.Plugin, true
+}
+
+// HasPlugin returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasPlugin() bool {
+	if o != nil && !IsNil(o.Plugin) {
+		return true
+	}
+
+	return false
+}
+
+// SetPlugin gets a reference to the given ExecutorSpecPlugin and assigns it to the Plugin field.
+func (o *SuperplaneExecutorSpec) SetPlugin(v ExecutorSpecPlugin) {
+	o.Plugin = &v
+}
+
+// GetProcess returns the Process field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetProcess() ExecutorSpecProcess {
+	if o == nil || IsNil(o.Process) {
+		var ret ExecutorSpecProcess
+		return ret
+	}
+	return *o.Process
+}
+
+// GetProcessOk returns a tuple with the Process field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetProcessOk() (*ExecutorSpecProcess, bool) {
+	if o == nil || IsNil(o.Process) {
+		return nil, false
+	}
+	return o.Process, true
+}
+
+// HasProcess returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasProcess() bool {
+	if o != nil && !IsNil(o.Process) {
+		return true
+	}
+
+	return false
+}
+
+// SetProcess gets a reference to the given ExecutorSpecProcess and assigns it to the Process field.
+func (o *SuperplaneExecutorSpec) SetProcess(v ExecutorSpecProcess) {
+	o.Process = &v
+}
+
+// GetNoop returns the Noop field value if set, zero value otherwise.
+func (o *SuperplaneExecutorSpec) GetNoop() ExecutorSpecNoop {
+	if o == nil || IsNil(o.Noop) {
+		var ret ExecutorSpecNoop
+		return ret
+	}
+	return *o.Noop
+}
+
+// GetNoopOk returns a tuple with the Noop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneExecutorSpec) GetNoopOk() (*ExecutorSpecNoop, bool) {
+	if o == nil || IsNil(o.Noop) {
+		return nil, false
+	}
+	return o.Noop, true
+}
+
+// HasNoop returns a boolean if a field has been set.
+func (o *SuperplaneExecutorSpec) HasNoop() bool {
+	if o != nil && !IsNil(o.Noop) {
+		return true
+	}
+
+	return false
+}
+
+// SetNoop gets a reference to the given ExecutorSpecNoop and assigns it to the Noop field.
+func (o *SuperplaneExecutorSpec) SetNoop(v ExecutorSpecNoop) {
+	o.Noop = &v
+}
+
 func (o SuperplaneExecutorSpec) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -161,6 +359,24 @@ func (o SuperplaneExecutorSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Http) {
 		toSerialize["http"] = o.Http
 	}
+	if !IsNil(o.Github) {
+		toSerialize["github"] = o.Github
+	}
+	if !IsNil(o.Gitlab) {
+		toSerialize["gitlab"] = o.Gitlab
+	}
+	if !IsNil(o.Kubernetes) {
+		toSerialize["kubernetes"] = o.Kubernetes
+	}
+	if !IsNil(o.Plugin) {
+		toSerialize["plugin"] = o.Plugin
+	}
+	if !IsNil(o.Process) {
+		toSerialize["process"] = o.Process
+	}
+	if !IsNil(o.Noop) {
+		toSerialize["noop"] = o.Noop
+	}
 	return toSerialize, nil
 }
 
@@ -24,6 +24,12 @@ const (
 	SUPERPLANEEXECUTORSPECTYPE_TYPE_UNKNOWN SuperplaneExecutorSpecType = "TYPE_UNKNOWN"
 	SUPERPLANEEXECUTORSPECTYPE_TYPE_SEMAPHORE SuperplaneExecutorSpecType = "TYPE_SEMAPHORE"
 	SUPERPLANEEXECUTORSPECTYPE_TYPE_HTTP SuperplaneExecutorSpecType = "TYPE_HTTP"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_GITHUB SuperplaneExecutorSpecType = "TYPE_GITHUB"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_GITLAB SuperplaneExecutorSpecType = "TYPE_GITLAB"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_KUBERNETES SuperplaneExecutorSpecType = "TYPE_KUBERNETES"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_PLUGIN SuperplaneExecutorSpecType = "TYPE_PLUGIN"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_PROCESS SuperplaneExecutorSpecType = "TYPE_PROCESS"
+	SUPERPLANEEXECUTORSPECTYPE_TYPE_NOOP SuperplaneExecutorSpecType = "TYPE_NOOP"
 )
 
 // All allowed values of SuperplaneExecutorSpecType enum
@@ -31,6 +37,12 @@ var AllowedSuperplaneExecutorSpecTypeEnumValues = []SuperplaneExecutorSpecType{
 	"TYPE_UNKNOWN",
 	"TYPE_SEMAPHORE",
 	"TYPE_HTTP",
+	"TYPE_GITHUB",
+	"TYPE_GITLAB",
+	"TYPE_KUBERNETES",
+	"TYPE_PLUGIN",
+	"TYPE_PROCESS",
+	"TYPE_NOOP",
 }
 
 func (v *SuperplaneExecutorSpecType) UnmarshalJSON(src []byte) error {
@@ -27,6 +27,10 @@ type SuperplaneStageSpec struct {
 	InputMappings []SuperplaneInputMapping `json:"inputMappings,omitempty"`
 	Outputs []SuperplaneOutputDefinition `json:"outputs,omitempty"`
 	Secrets []SuperplaneValueDefinition `json:"secrets,omitempty"`
+	MaxConcurrentExecutions *int64 `json:"maxConcurrentExecutions,omitempty"`
+	QueuePolicy *StageQueuePolicy `json:"queuePolicy,omitempty"`
+	ExecutionTimeout *int64 `json:"executionTimeout,omitempty"`
+	RetryPolicy *SuperplaneRetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // NewSuperplaneStageSpec instantiates a new SuperplaneStageSpec object
@@ -35,6 +39,8 @@ type SuperplaneStageSpec struct {
 // will change when the set of required properties is changed
 func NewSuperplaneStageSpec() *SuperplaneStageSpec {
 	this := SuperplaneStageSpec{}
+	var queuePolicy StageQueuePolicy = STAGEQUEUEPOLICY_QUEUE_POLICY_FIFO
+	this.QueuePolicy = &queuePolicy
 	return &this
 }
 
@@ -43,6 +49,8 @@ func NewSuperplaneStageSpec() *SuperplaneStageSpec {
 // but it doesn't guarantee that properties required by API are set
 func NewSuperplaneStageSpecWithDefaults() *SuperplaneStageSpec {
 	this := SuperplaneStageSpec{}
+	var queuePolicy StageQueuePolicy = STAGEQUEUEPOLICY_QUEUE_POLICY_FIFO
+	this.QueuePolicy = &queuePolicy
 	return &this
 }
 
@@ -270,6 +278,134 @@ func (o *SuperplaneStageSpec) SetSecrets(v []SuperplaneValueDefinition) {
 	o.Secrets = v
 }
 
+// GetMaxConcurrentExecutions returns the MaxConcurrentExecutions field value if set, zero value otherwise.
+func (o *SuperplaneStageSpec) GetMaxConcurrentExecutions() int64 {
+	if o == nil || IsNil(o.MaxConcurrentExecutions) {
+		var ret int64
+		return ret
+	}
+	return *o.MaxConcurrentExecutions
+}
+
+// GetMaxConcurrentExecutionsOk returns a tuple with the MaxConcurrentExecutions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneStageSpec) GetMaxConcurrentExecutionsOk() (*int64, bool) {
+	if o == nil || IsNil(o.MaxConcurrentExecutions) {
+		return nil, false
+	}
+	return o.MaxConcurrentExecutions, true
+}
+
+// HasMaxConcurrentExecutions returns a boolean if a field has been set.
+func (o *SuperplaneStageSpec) HasMaxConcurrentExecutions() bool {
+	if o != nil && !IsNil(o.MaxConcurrentExecutions) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrentExecutions gets a reference to the given int64 and assigns it to the MaxConcurrentExecutions field.
+func (o *SuperplaneStageSpec) SetMaxConcurrentExecutions(v int64) {
+	o.MaxConcurrentExecutions = &v
+}
+
+// GetQueuePolicy returns the QueuePolicy field value if set, zero value otherwise.
+func (o *SuperplaneStageSpec) GetQueuePolicy() StageQueuePolicy {
+	if o == nil || IsNil(o.QueuePolicy) {
+		var ret StageQueuePolicy
+		return ret
+	}
+	return *o.QueuePolicy
+}
+
+// GetQueuePolicyOk returns a tuple with the QueuePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneStageSpec) GetQueuePolicyOk() (*StageQueuePolicy, bool) {
+	if o == nil || IsNil(o.QueuePolicy) {
+		return nil, false
+	}
+	return o.QueuePolicy, true
+}
+
+// HasQueuePolicy returns a boolean if a field has been set.
+func (o *SuperplaneStageSpec) HasQueuePolicy() bool {
+	if o != nil && !IsNil(o.QueuePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuePolicy gets a reference to the given StageQueuePolicy and assigns it to the QueuePolicy field.
+func (o *SuperplaneStageSpec) SetQueuePolicy(v StageQueuePolicy) {
+	o.QueuePolicy = &v
+}
+
+// GetExecutionTimeout returns the ExecutionTimeout field value if set, zero value otherwise.
+func (o *SuperplaneStageSpec) GetExecutionTimeout() int64 {
+	if o == nil || IsNil(o.ExecutionTimeout) {
+		var ret int64
+		return ret
+	}
+	return *o.ExecutionTimeout
+}
+
+// GetExecutionTimeoutOk returns a tuple with the ExecutionTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneStageSpec) GetExecutionTimeoutOk() (*int64, bool) {
+	if o == nil || IsNil(o.ExecutionTimeout) {
+		return nil, false
+	}
+	return o.ExecutionTimeout, true
+}
+
+// HasExecutionTimeout returns a boolean if a field has been set.
+func (o *SuperplaneStageSpec) HasExecutionTimeout() bool {
+	if o != nil && !IsNil(o.ExecutionTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetExecutionTimeout gets a reference to the given int64 and assigns it to the ExecutionTimeout field.
+func (o *SuperplaneStageSpec) SetExecutionTimeout(v int64) {
+	o.ExecutionTimeout = &v
+}
+
+// GetRetryPolicy returns the RetryPolicy field value if set, zero value otherwise.
+func (o *SuperplaneStageSpec) GetRetryPolicy() SuperplaneRetryPolicy {
+	if o == nil || IsNil(o.RetryPolicy) {
+		var ret SuperplaneRetryPolicy
+		return ret
+	}
+	return *o.RetryPolicy
+}
+
+// GetRetryPolicyOk returns a tuple with the RetryPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SuperplaneStageSpec) GetRetryPolicyOk() (*SuperplaneRetryPolicy, bool) {
+	if o == nil || IsNil(o.RetryPolicy) {
+		return nil, false
+	}
+	return o.RetryPolicy, true
+}
+
+// HasRetryPolicy returns a boolean if a field has been set.
+func (o *SuperplaneStageSpec) HasRetryPolicy() bool {
+	if o != nil && !IsNil(o.RetryPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryPolicy gets a reference to the given SuperplaneRetryPolicy and assigns it to the RetryPolicy field.
+func (o *SuperplaneStageSpec) SetRetryPolicy(v SuperplaneRetryPolicy) {
+	o.RetryPolicy = &v
+}
+
 func (o SuperplaneStageSpec) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -301,6 +437,18 @@ func (o SuperplaneStageSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Secrets) {
 		toSerialize["secrets"] = o.Secrets
 	}
+	if !IsNil(o.MaxConcurrentExecutions) {
+		toSerialize["maxConcurrentExecutions"] = o.MaxConcurrentExecutions
+	}
+	if !IsNil(o.QueuePolicy) {
+		toSerialize["queuePolicy"] = o.QueuePolicy
+	}
+	if !IsNil(o.ExecutionTimeout) {
+		toSerialize["executionTimeout"] = o.ExecutionTimeout
+	}
+	if !IsNil(o.RetryPolicy) {
+		toSerialize["retryPolicy"] = o.RetryPolicy
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: agents.proto
+
+package agents
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LogChunk_Stream int32
+
+const (
+	LogChunk_STREAM_UNKNOWN LogChunk_Stream = 0
+	LogChunk_STREAM_STDOUT  LogChunk_Stream = 1
+	LogChunk_STREAM_STDERR  LogChunk_Stream = 2
+)
+
+// Enum value maps for LogChunk_Stream.
+var (
+	LogChunk_Stream_name = map[int32]string{
+		0: "STREAM_UNKNOWN",
+		1: "STREAM_STDOUT",
+		2: "STREAM_STDERR",
+	}
+	LogChunk_Stream_value = map[string]int32{
+		"STREAM_UNKNOWN": 0,
+		"STREAM_STDOUT":  1,
+		"STREAM_STDERR":  2,
+	}
+)
+
+func (x LogChunk_Stream) Enum() *LogChunk_Stream {
+	p := new(LogChunk_Stream)
+	*p = x
+	return p
+}
+
+func (x LogChunk_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogChunk_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_agents_proto_enumTypes[0].Descriptor()
+}
+
+func (LogChunk_Stream) Type() protoreflect.EnumType {
+	return &file_agents_proto_enumTypes[0]
+}
+
+func (x LogChunk_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogChunk_Stream.Descriptor instead.
+func (LogChunk_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Job struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionId      string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Command          string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Args             []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env              map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorkingDirectory string                 `protobuf:"bytes,6,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_agents_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *Job) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Job) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Job) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Job) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+type LogChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        LogChunk_Stream        `protobuf:"varint,1,opt,name=stream,proto3,enum=Superplane.Agents.LogChunk_Stream" json:"stream,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogChunk) Reset() {
+	*x = LogChunk{}
+	mi := &file_agents_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogChunk) ProtoMessage() {}
+
+func (x *LogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
+func (*LogChunk) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogChunk) GetStream() LogChunk_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return LogChunk_STREAM_UNKNOWN
+}
+
+func (x *LogChunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type PullJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullJobRequest) Reset() {
+	*x = PullJobRequest{}
+	mi := &file_agents_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullJobRequest) ProtoMessage() {}
+
+func (x *PullJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullJobRequest.ProtoReflect.Descriptor instead.
+func (*PullJobRequest) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{2}
+}
+
+type PullJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullJobResponse) Reset() {
+	*x = PullJobResponse{}
+	mi := &file_agents_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullJobResponse) ProtoMessage() {}
+
+func (x *PullJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullJobResponse.ProtoReflect.Descriptor instead.
+func (*PullJobResponse) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PullJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type PushLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Chunks        []*LogChunk            `protobuf:"bytes,2,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushLogsRequest) Reset() {
+	*x = PushLogsRequest{}
+	mi := &file_agents_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushLogsRequest) ProtoMessage() {}
+
+func (x *PushLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushLogsRequest.ProtoReflect.Descriptor instead.
+func (*PushLogsRequest) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushLogsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *PushLogsRequest) GetChunks() []*LogChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+type PushLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushLogsResponse) Reset() {
+	*x = PushLogsResponse{}
+	mi := &file_agents_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushLogsResponse) ProtoMessage() {}
+
+func (x *PushLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushLogsResponse.ProtoReflect.Descriptor instead.
+func (*PushLogsResponse) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PushLogsResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type FinishJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishJobRequest) Reset() {
+	*x = FinishJobRequest{}
+	mi := &file_agents_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobRequest) ProtoMessage() {}
+
+func (x *FinishJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobRequest.ProtoReflect.Descriptor instead.
+func (*FinishJobRequest) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FinishJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *FinishJobRequest) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type FinishJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishJobResponse) Reset() {
+	*x = FinishJobResponse{}
+	mi := &file_agents_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobResponse) ProtoMessage() {}
+
+func (x *FinishJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agents_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobResponse.ProtoReflect.Descriptor instead.
+func (*FinishJobResponse) Descriptor() ([]byte, []int) {
+	return file_agents_proto_rawDescGZIP(), []int{7}
+}
+
+var File_agents_proto protoreflect.FileDescriptor
+
+const file_agents_proto_rawDesc = "" +
+	"\n" +
+	"\fagents.proto\x12\x11Superplane.Agents\"\xfe\x01\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x121\n" +
+	"\x03env\x18\x05 \x03(\v2\x1f.Superplane.Agents.Job.EnvEntryR\x03env\x12+\n" +
+	"\x11working_directory\x18\x06 \x01(\tR\x10workingDirectory\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
+	"\bLogChunk\x12:\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\".Superplane.Agents.LogChunk.StreamR\x06stream\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"B\n" +
+	"\x06Stream\x12\x12\n" +
+	"\x0eSTREAM_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTREAM_STDOUT\x10\x01\x12\x11\n" +
+	"\rSTREAM_STDERR\x10\x02\"\x10\n" +
+	"\x0ePullJobRequest\";\n" +
+	"\x0fPullJobResponse\x12(\n" +
+	"\x03job\x18\x01 \x01(\v2\x16.Superplane.Agents.JobR\x03job\"]\n" +
+	"\x0fPushLogsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x123\n" +
+	"\x06chunks\x18\x02 \x03(\v2\x1b.Superplane.Agents.LogChunkR\x06chunks\"0\n" +
+	"\x10PushLogsResponse\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\bR\tcancelled\"F\n" +
+	"\x10FinishJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\texit_code\x18\x02 \x01(\x05R\bexitCode\"\x13\n" +
+	"\x11FinishJobResponse2\x8d\x02\n" +
+	"\x06Agents\x12R\n" +
+	"\aPullJob\x12!.Superplane.Agents.PullJobRequest\x1a\".Superplane.Agents.PullJobResponse\"\x00\x12U\n" +
+	"\bPushLogs\x12\".Superplane.Agents.PushLogsRequest\x1a#.Superplane.Agents.PushLogsResponse\"\x00\x12X\n" +
+	"\tFinishJob\x12#.Superplane.Agents.FinishJobRequest\x1a$.Superplane.Agents.FinishJobResponse\"\x00B6Z4github.com/superplanehq/superplane/pkg/protos/agentsb\x06proto3"
+
+var (
+	file_agents_proto_rawDescOnce sync.Once
+	file_agents_proto_rawDescData []byte
+)
+
+func file_agents_proto_rawDescGZIP() []byte {
+	file_agents_proto_rawDescOnce.Do(func() {
+		file_agents_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agents_proto_rawDesc), len(file_agents_proto_rawDesc)))
+	})
+	return file_agents_proto_rawDescData
+}
+
+var file_agents_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agents_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_agents_proto_goTypes = []any{
+	(LogChunk_Stream)(0),      // 0: Superplane.Agents.LogChunk.Stream
+	(*Job)(nil),               // 1: Superplane.Agents.Job
+	(*LogChunk)(nil),          // 2: Superplane.Agents.LogChunk
+	(*PullJobRequest)(nil),    // 3: Superplane.Agents.PullJobRequest
+	(*PullJobResponse)(nil),   // 4: Superplane.Agents.PullJobResponse
+	(*PushLogsRequest)(nil),   // 5: Superplane.Agents.PushLogsRequest
+	(*PushLogsResponse)(nil),  // 6: Superplane.Agents.PushLogsResponse
+	(*FinishJobRequest)(nil),  // 7: Superplane.Agents.FinishJobRequest
+	(*FinishJobResponse)(nil), // 8: Superplane.Agents.FinishJobResponse
+	nil,                       // 9: Superplane.Agents.Job.EnvEntry
+}
+var file_agents_proto_depIdxs = []int32{
+	9, // 0: Superplane.Agents.Job.env:type_name -> Superplane.Agents.Job.EnvEntry
+	0, // 1: Superplane.Agents.LogChunk.stream:type_name -> Superplane.Agents.LogChunk.Stream
+	1, // 2: Superplane.Agents.PullJobResponse.job:type_name -> Superplane.Agents.Job
+	2, // 3: Superplane.Agents.PushLogsRequest.chunks:type_name -> Superplane.Agents.LogChunk
+	3, // 4: Superplane.Agents.Agents.PullJob:input_type -> Superplane.Agents.PullJobRequest
+	5, // 5: Superplane.Agents.Agents.PushLogs:input_type -> Superplane.Agents.PushLogsRequest
+	7, // 6: Superplane.Agents.Agents.FinishJob:input_type -> Superplane.Agents.FinishJobRequest
+	4, // 7: Superplane.Agents.Agents.PullJob:output_type -> Superplane.Agents.PullJobResponse
+	6, // 8: Superplane.Agents.Agents.PushLogs:output_type -> Superplane.Agents.PushLogsResponse
+	8, // 9: Superplane.Agents.Agents.FinishJob:output_type -> Superplane.Agents.FinishJobResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_agents_proto_init() }
+func file_agents_proto_init() {
+	if File_agents_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agents_proto_rawDesc), len(file_agents_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agents_proto_goTypes,
+		DependencyIndexes: file_agents_proto_depIdxs,
+		EnumInfos:         file_agents_proto_enumTypes,
+		MessageInfos:      file_agents_proto_msgTypes,
+	}.Build()
+	File_agents_proto = out.File
+	file_agents_proto_goTypes = nil
+	file_agents_proto_depIdxs = nil
+}
@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: plugins.proto
+
+package plugins
+
+import (
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Execution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Execution) Reset() {
+	*x = Execution{}
+	mi := &file_plugins_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Execution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execution) ProtoMessage() {}
+
+func (x *Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execution.ProtoReflect.Descriptor instead.
+func (*Execution) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Execution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Execution) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *Execution) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ExecutionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Finished      bool                   `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
+	Successful    bool                   `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	Outputs       *_struct.Struct        `protobuf:"bytes,4,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionStatus) Reset() {
+	*x = ExecutionStatus{}
+	mi := &file_plugins_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionStatus) ProtoMessage() {}
+
+func (x *ExecutionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionStatus.ProtoReflect.Descriptor instead.
+func (*ExecutionStatus) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExecutionStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecutionStatus) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *ExecutionStatus) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *ExecutionStatus) GetOutputs() *_struct.Struct {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *_struct.Struct        `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_plugins_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidateRequest) GetSpec() *_struct.Struct {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *_struct.Struct        `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_plugins_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidateResponse) GetSpec() *_struct.Struct {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ExecuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Spec          *_struct.Struct        `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteRequest) Reset() {
+	*x = ExecuteRequest{}
+	mi := &file_plugins_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteRequest) ProtoMessage() {}
+
+func (x *ExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExecuteRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *ExecuteRequest) GetSpec() *_struct.Struct {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ExecuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ExecutionStatus       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteResponse) Reset() {
+	*x = ExecuteResponse{}
+	mi := &file_plugins_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteResponse) ProtoMessage() {}
+
+func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExecuteResponse) GetStatus() *ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Spec          *_struct.Struct        `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_plugins_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *CheckRequest) GetSpec() *_struct.Struct {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CheckRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ExecutionStatus       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	mi := &file_plugins_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckResponse) GetStatus() *ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Spec          *_struct.Struct        `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_plugins_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelRequest) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *CancelRequest) GetSpec() *_struct.Struct {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CancelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_plugins_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{9}
+}
+
+var File_plugins_proto protoreflect.FileDescriptor
+
+const file_plugins_proto_rawDesc = "" +
+	"\n" +
+	"\rplugins.proto\x12\x12Superplane.Plugins\x1a\x1cgoogle/protobuf/struct.proto\"L\n" +
+	"\tExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bstage_id\x18\x02 \x01(\tR\astageId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\x90\x01\n" +
+	"\x0fExecutionStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfinished\x18\x02 \x01(\bR\bfinished\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x03 \x01(\bR\n" +
+	"successful\x121\n" +
+	"\aoutputs\x18\x04 \x01(\v2\x17.google.protobuf.StructR\aoutputs\">\n" +
+	"\x0fValidateRequest\x12+\n" +
+	"\x04spec\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04spec\"?\n" +
+	"\x10ValidateResponse\x12+\n" +
+	"\x04spec\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04spec\"z\n" +
+	"\x0eExecuteRequest\x12;\n" +
+	"\texecution\x18\x01 \x01(\v2\x1d.Superplane.Plugins.ExecutionR\texecution\x12+\n" +
+	"\x04spec\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04spec\"N\n" +
+	"\x0fExecuteResponse\x12;\n" +
+	"\x06status\x18\x01 \x01(\v2#.Superplane.Plugins.ExecutionStatusR\x06status\"\x88\x01\n" +
+	"\fCheckRequest\x12;\n" +
+	"\texecution\x18\x01 \x01(\v2\x1d.Superplane.Plugins.ExecutionR\texecution\x12+\n" +
+	"\x04spec\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04spec\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"L\n" +
+	"\rCheckResponse\x12;\n" +
+	"\x06status\x18\x01 \x01(\v2#.Superplane.Plugins.ExecutionStatusR\x06status\"\x89\x01\n" +
+	"\rCancelRequest\x12;\n" +
+	"\texecution\x18\x01 \x01(\v2\x1d.Superplane.Plugins.ExecutionR\texecution\x12+\n" +
+	"\x04spec\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04spec\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"\x10\n" +
+	"\x0eCancelResponse2\xe2\x02\n" +
+	"\x0eExecutorPlugin\x12W\n" +
+	"\bValidate\x12#.Superplane.Plugins.ValidateRequest\x1a$.Superplane.Plugins.ValidateResponse\"\x00\x12T\n" +
+	"\aExecute\x12\".Superplane.Plugins.ExecuteRequest\x1a#.Superplane.Plugins.ExecuteResponse\"\x00\x12N\n" +
+	"\x05Check\x12 .Superplane.Plugins.CheckRequest\x1a!.Superplane.Plugins.CheckResponse\"\x00\x12Q\n" +
+	"\x06Cancel\x12!.Superplane.Plugins.CancelRequest\x1a\".Superplane.Plugins.CancelResponse\"\x00B7Z5github.com/superplanehq/superplane/pkg/protos/pluginsb\x06proto3"
+
+var (
+	file_plugins_proto_rawDescOnce sync.Once
+	file_plugins_proto_rawDescData []byte
+)
+
+func file_plugins_proto_rawDescGZIP() []byte {
+	file_plugins_proto_rawDescOnce.Do(func() {
+		file_plugins_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)))
+	})
+	return file_plugins_proto_rawDescData
+}
+
+var file_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_plugins_proto_goTypes = []any{
+	(*Execution)(nil),        // 0: Superplane.Plugins.Execution
+	(*ExecutionStatus)(nil),  // 1: Superplane.Plugins.ExecutionStatus
+	(*ValidateRequest)(nil),  // 2: Superplane.Plugins.ValidateRequest
+	(*ValidateResponse)(nil), // 3: Superplane.Plugins.ValidateResponse
+	(*ExecuteRequest)(nil),   // 4: Superplane.Plugins.ExecuteRequest
+	(*ExecuteResponse)(nil),  // 5: Superplane.Plugins.ExecuteResponse
+	(*CheckRequest)(nil),     // 6: Superplane.Plugins.CheckRequest
+	(*CheckResponse)(nil),    // 7: Superplane.Plugins.CheckResponse
+	(*CancelRequest)(nil),    // 8: Superplane.Plugins.CancelRequest
+	(*CancelResponse)(nil),   // 9: Superplane.Plugins.CancelResponse
+	(*_struct.Struct)(nil),   // 10: google.protobuf.Struct
+}
+var file_plugins_proto_depIdxs = []int32{
+	10, // 0: Superplane.Plugins.ExecutionStatus.outputs:type_name -> google.protobuf.Struct
+	10, // 1: Superplane.Plugins.ValidateRequest.spec:type_name -> google.protobuf.Struct
+	10, // 2: Superplane.Plugins.ValidateResponse.spec:type_name -> google.protobuf.Struct
+	0,  // 3: Superplane.Plugins.ExecuteRequest.execution:type_name -> Superplane.Plugins.Execution
+	10, // 4: Superplane.Plugins.ExecuteRequest.spec:type_name -> google.protobuf.Struct
+	1,  // 5: Superplane.Plugins.ExecuteResponse.status:type_name -> Superplane.Plugins.ExecutionStatus
+	0,  // 6: Superplane.Plugins.CheckRequest.execution:type_name -> Superplane.Plugins.Execution
+	10, // 7: Superplane.Plugins.CheckRequest.spec:type_name -> google.protobuf.Struct
+	1,  // 8: Superplane.Plugins.CheckResponse.status:type_name -> Superplane.Plugins.ExecutionStatus
+	0,  // 9: Superplane.Plugins.CancelRequest.execution:type_name -> Superplane.Plugins.Execution
+	10, // 10: Superplane.Plugins.CancelRequest.spec:type_name -> google.protobuf.Struct
+	2,  // 11: Superplane.Plugins.ExecutorPlugin.Validate:input_type -> Superplane.Plugins.ValidateRequest
+	4,  // 12: Superplane.Plugins.ExecutorPlugin.Execute:input_type -> Superplane.Plugins.ExecuteRequest
+	6,  // 13: Superplane.Plugins.ExecutorPlugin.Check:input_type -> Superplane.Plugins.CheckRequest
+	8,  // 14: Superplane.Plugins.ExecutorPlugin.Cancel:input_type -> Superplane.Plugins.CancelRequest
+	3,  // 15: Superplane.Plugins.ExecutorPlugin.Validate:output_type -> Superplane.Plugins.ValidateResponse
+	5,  // 16: Superplane.Plugins.ExecutorPlugin.Execute:output_type -> Superplane.Plugins.ExecuteResponse
+	7,  // 17: Superplane.Plugins.ExecutorPlugin.Check:output_type -> Superplane.Plugins.CheckResponse
+	9,  // 18: Superplane.Plugins.ExecutorPlugin.Cancel:output_type -> Superplane.Plugins.CancelResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_plugins_proto_init() }
+func file_plugins_proto_init() {
+	if File_plugins_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugins_proto_goTypes,
+		DependencyIndexes: file_plugins_proto_depIdxs,
+		MessageInfos:      file_plugins_proto_msgTypes,
+	}.Build()
+	File_plugins_proto = out.File
+	file_plugins_proto_goTypes = nil
+	file_plugins_proto_depIdxs = nil
+}
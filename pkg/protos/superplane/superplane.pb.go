@@ -7,6 +7,7 @@
 package superplane
 
 import (
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -24,6 +25,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventSource_Webhook_Algorithm int32
+
+const (
+	EventSource_Webhook_ALGORITHM_HMAC_SHA256 EventSource_Webhook_Algorithm = 0
+	EventSource_Webhook_ALGORITHM_HMAC_SHA1   EventSource_Webhook_Algorithm = 1
+	EventSource_Webhook_ALGORITHM_HMAC_SHA512 EventSource_Webhook_Algorithm = 2
+	EventSource_Webhook_ALGORITHM_BEARER      EventSource_Webhook_Algorithm = 3
+	EventSource_Webhook_ALGORITHM_BASIC       EventSource_Webhook_Algorithm = 4
+)
+
+// Enum value maps for EventSource_Webhook_Algorithm.
+var (
+	EventSource_Webhook_Algorithm_name = map[int32]string{
+		0: "ALGORITHM_HMAC_SHA256",
+		1: "ALGORITHM_HMAC_SHA1",
+		2: "ALGORITHM_HMAC_SHA512",
+		3: "ALGORITHM_BEARER",
+		4: "ALGORITHM_BASIC",
+	}
+	EventSource_Webhook_Algorithm_value = map[string]int32{
+		"ALGORITHM_HMAC_SHA256": 0,
+		"ALGORITHM_HMAC_SHA1":   1,
+		"ALGORITHM_HMAC_SHA512": 2,
+		"ALGORITHM_BEARER":      3,
+		"ALGORITHM_BASIC":       4,
+	}
+)
+
+func (x EventSource_Webhook_Algorithm) Enum() *EventSource_Webhook_Algorithm {
+	p := new(EventSource_Webhook_Algorithm)
+	*p = x
+	return p
+}
+
+func (x EventSource_Webhook_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSource_Webhook_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[0].Descriptor()
+}
+
+func (EventSource_Webhook_Algorithm) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[0]
+}
+
+func (x EventSource_Webhook_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSource_Webhook_Algorithm.Descriptor instead.
+func (EventSource_Webhook_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 3, 0}
+}
+
+type EventSource_Webhook_Encoding int32
+
+const (
+	EventSource_Webhook_ENCODING_HEX    EventSource_Webhook_Encoding = 0
+	EventSource_Webhook_ENCODING_BASE64 EventSource_Webhook_Encoding = 1
+)
+
+// Enum value maps for EventSource_Webhook_Encoding.
+var (
+	EventSource_Webhook_Encoding_name = map[int32]string{
+		0: "ENCODING_HEX",
+		1: "ENCODING_BASE64",
+	}
+	EventSource_Webhook_Encoding_value = map[string]int32{
+		"ENCODING_HEX":    0,
+		"ENCODING_BASE64": 1,
+	}
+)
+
+func (x EventSource_Webhook_Encoding) Enum() *EventSource_Webhook_Encoding {
+	p := new(EventSource_Webhook_Encoding)
+	*p = x
+	return p
+}
+
+func (x EventSource_Webhook_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSource_Webhook_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[1].Descriptor()
+}
+
+func (EventSource_Webhook_Encoding) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[1]
+}
+
+func (x EventSource_Webhook_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSource_Webhook_Encoding.Descriptor instead.
+func (EventSource_Webhook_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 3, 1}
+}
+
+type EventSource_Webhook_SignedContent int32
+
+const (
+	EventSource_Webhook_SIGNED_CONTENT_BODY               EventSource_Webhook_SignedContent = 0
+	EventSource_Webhook_SIGNED_CONTENT_TIMESTAMP_AND_BODY EventSource_Webhook_SignedContent = 1
+)
+
+// Enum value maps for EventSource_Webhook_SignedContent.
+var (
+	EventSource_Webhook_SignedContent_name = map[int32]string{
+		0: "SIGNED_CONTENT_BODY",
+		1: "SIGNED_CONTENT_TIMESTAMP_AND_BODY",
+	}
+	EventSource_Webhook_SignedContent_value = map[string]int32{
+		"SIGNED_CONTENT_BODY":               0,
+		"SIGNED_CONTENT_TIMESTAMP_AND_BODY": 1,
+	}
+)
+
+func (x EventSource_Webhook_SignedContent) Enum() *EventSource_Webhook_SignedContent {
+	p := new(EventSource_Webhook_SignedContent)
+	*p = x
+	return p
+}
+
+func (x EventSource_Webhook_SignedContent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSource_Webhook_SignedContent) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[2].Descriptor()
+}
+
+func (EventSource_Webhook_SignedContent) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[2]
+}
+
+func (x EventSource_Webhook_SignedContent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSource_Webhook_SignedContent.Descriptor instead.
+func (EventSource_Webhook_SignedContent) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 3, 2}
+}
+
 type Secret_Provider int32
 
 const (
@@ -54,11 +202,11 @@ func (x Secret_Provider) String() string {
 }
 
 func (Secret_Provider) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[0].Descriptor()
+	return file_superplane_proto_enumTypes[3].Descriptor()
 }
 
 func (Secret_Provider) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[0]
+	return &file_superplane_proto_enumTypes[3]
 }
 
 func (x Secret_Provider) Number() protoreflect.EnumNumber {
@@ -103,11 +251,11 @@ func (x Connection_Type) String() string {
 }
 
 func (Connection_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[1].Descriptor()
+	return file_superplane_proto_enumTypes[4].Descriptor()
 }
 
 func (Connection_Type) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[1]
+	return &file_superplane_proto_enumTypes[4]
 }
 
 func (x Connection_Type) Number() protoreflect.EnumNumber {
@@ -116,7 +264,7 @@ func (x Connection_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Connection_Type.Descriptor instead.
 func (Connection_Type) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 0}
+	return file_superplane_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type Connection_FilterType int32
@@ -152,11 +300,11 @@ func (x Connection_FilterType) String() string {
 }
 
 func (Connection_FilterType) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[2].Descriptor()
+	return file_superplane_proto_enumTypes[5].Descriptor()
 }
 
 func (Connection_FilterType) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[2]
+	return &file_superplane_proto_enumTypes[5]
 }
 
 func (x Connection_FilterType) Number() protoreflect.EnumNumber {
@@ -165,7 +313,7 @@ func (x Connection_FilterType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Connection_FilterType.Descriptor instead.
 func (Connection_FilterType) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 1}
+	return file_superplane_proto_rawDescGZIP(), []int{28, 1}
 }
 
 // Filters can be combined in two ways:
@@ -201,11 +349,11 @@ func (x Connection_FilterOperator) String() string {
 }
 
 func (Connection_FilterOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[3].Descriptor()
+	return file_superplane_proto_enumTypes[6].Descriptor()
 }
 
 func (Connection_FilterOperator) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[3]
+	return &file_superplane_proto_enumTypes[6]
 }
 
 func (x Connection_FilterOperator) Number() protoreflect.EnumNumber {
@@ -214,7 +362,56 @@ func (x Connection_FilterOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Connection_FilterOperator.Descriptor instead.
 func (Connection_FilterOperator) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 2}
+	return file_superplane_proto_rawDescGZIP(), []int{28, 2}
+}
+
+type Stage_QueuePolicy int32
+
+const (
+	Stage_QUEUE_POLICY_FIFO           Stage_QueuePolicy = 0
+	Stage_QUEUE_POLICY_LATEST         Stage_QueuePolicy = 1
+	Stage_QUEUE_POLICY_CANCEL_RUNNING Stage_QueuePolicy = 2
+)
+
+// Enum value maps for Stage_QueuePolicy.
+var (
+	Stage_QueuePolicy_name = map[int32]string{
+		0: "QUEUE_POLICY_FIFO",
+		1: "QUEUE_POLICY_LATEST",
+		2: "QUEUE_POLICY_CANCEL_RUNNING",
+	}
+	Stage_QueuePolicy_value = map[string]int32{
+		"QUEUE_POLICY_FIFO":           0,
+		"QUEUE_POLICY_LATEST":         1,
+		"QUEUE_POLICY_CANCEL_RUNNING": 2,
+	}
+)
+
+func (x Stage_QueuePolicy) Enum() *Stage_QueuePolicy {
+	p := new(Stage_QueuePolicy)
+	*p = x
+	return p
+}
+
+func (x Stage_QueuePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Stage_QueuePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[7].Descriptor()
+}
+
+func (Stage_QueuePolicy) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[7]
+}
+
+func (x Stage_QueuePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Stage_QueuePolicy.Descriptor instead.
+func (Stage_QueuePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type Condition_Type int32
@@ -250,11 +447,11 @@ func (x Condition_Type) String() string {
 }
 
 func (Condition_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[4].Descriptor()
+	return file_superplane_proto_enumTypes[8].Descriptor()
 }
 
 func (Condition_Type) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[4]
+	return &file_superplane_proto_enumTypes[8]
 }
 
 func (x Condition_Type) Number() protoreflect.EnumNumber {
@@ -263,15 +460,119 @@ func (x Condition_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Condition_Type.Descriptor instead.
 func (Condition_Type) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{35, 0}
+	return file_superplane_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type RetryPolicy_BackoffStrategy int32
+
+const (
+	RetryPolicy_BACKOFF_STRATEGY_FIXED       RetryPolicy_BackoffStrategy = 0
+	RetryPolicy_BACKOFF_STRATEGY_EXPONENTIAL RetryPolicy_BackoffStrategy = 1
+)
+
+// Enum value maps for RetryPolicy_BackoffStrategy.
+var (
+	RetryPolicy_BackoffStrategy_name = map[int32]string{
+		0: "BACKOFF_STRATEGY_FIXED",
+		1: "BACKOFF_STRATEGY_EXPONENTIAL",
+	}
+	RetryPolicy_BackoffStrategy_value = map[string]int32{
+		"BACKOFF_STRATEGY_FIXED":       0,
+		"BACKOFF_STRATEGY_EXPONENTIAL": 1,
+	}
+)
+
+func (x RetryPolicy_BackoffStrategy) Enum() *RetryPolicy_BackoffStrategy {
+	p := new(RetryPolicy_BackoffStrategy)
+	*p = x
+	return p
+}
+
+func (x RetryPolicy_BackoffStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryPolicy_BackoffStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[9].Descriptor()
+}
+
+func (RetryPolicy_BackoffStrategy) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[9]
+}
+
+func (x RetryPolicy_BackoffStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryPolicy_BackoffStrategy.Descriptor instead.
+func (RetryPolicy_BackoffStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{39, 0}
+}
+
+type RetryPolicy_FailureKind int32
+
+const (
+	RetryPolicy_FAILURE_KIND_UNKNOWN RetryPolicy_FailureKind = 0
+	RetryPolicy_FAILURE_KIND_FAILED  RetryPolicy_FailureKind = 1
+	RetryPolicy_FAILURE_KIND_TIMEOUT RetryPolicy_FailureKind = 2
+	RetryPolicy_FAILURE_KIND_ERROR   RetryPolicy_FailureKind = 3
+)
+
+// Enum value maps for RetryPolicy_FailureKind.
+var (
+	RetryPolicy_FailureKind_name = map[int32]string{
+		0: "FAILURE_KIND_UNKNOWN",
+		1: "FAILURE_KIND_FAILED",
+		2: "FAILURE_KIND_TIMEOUT",
+		3: "FAILURE_KIND_ERROR",
+	}
+	RetryPolicy_FailureKind_value = map[string]int32{
+		"FAILURE_KIND_UNKNOWN": 0,
+		"FAILURE_KIND_FAILED":  1,
+		"FAILURE_KIND_TIMEOUT": 2,
+		"FAILURE_KIND_ERROR":   3,
+	}
+)
+
+func (x RetryPolicy_FailureKind) Enum() *RetryPolicy_FailureKind {
+	p := new(RetryPolicy_FailureKind)
+	*p = x
+	return p
+}
+
+func (x RetryPolicy_FailureKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryPolicy_FailureKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[10].Descriptor()
+}
+
+func (RetryPolicy_FailureKind) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[10]
+}
+
+func (x RetryPolicy_FailureKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryPolicy_FailureKind.Descriptor instead.
+func (RetryPolicy_FailureKind) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{39, 1}
 }
 
 type ExecutorSpec_Type int32
 
 const (
-	ExecutorSpec_TYPE_UNKNOWN   ExecutorSpec_Type = 0
-	ExecutorSpec_TYPE_SEMAPHORE ExecutorSpec_Type = 1
-	ExecutorSpec_TYPE_HTTP      ExecutorSpec_Type = 2
+	ExecutorSpec_TYPE_UNKNOWN    ExecutorSpec_Type = 0
+	ExecutorSpec_TYPE_SEMAPHORE  ExecutorSpec_Type = 1
+	ExecutorSpec_TYPE_HTTP       ExecutorSpec_Type = 2
+	ExecutorSpec_TYPE_GITHUB     ExecutorSpec_Type = 3
+	ExecutorSpec_TYPE_GITLAB     ExecutorSpec_Type = 4
+	ExecutorSpec_TYPE_KUBERNETES ExecutorSpec_Type = 5
+	ExecutorSpec_TYPE_PLUGIN     ExecutorSpec_Type = 6
+	ExecutorSpec_TYPE_PROCESS    ExecutorSpec_Type = 7
+	ExecutorSpec_TYPE_NOOP       ExecutorSpec_Type = 8
 )
 
 // Enum value maps for ExecutorSpec_Type.
@@ -280,11 +581,23 @@ var (
 		0: "TYPE_UNKNOWN",
 		1: "TYPE_SEMAPHORE",
 		2: "TYPE_HTTP",
+		3: "TYPE_GITHUB",
+		4: "TYPE_GITLAB",
+		5: "TYPE_KUBERNETES",
+		6: "TYPE_PLUGIN",
+		7: "TYPE_PROCESS",
+		8: "TYPE_NOOP",
 	}
 	ExecutorSpec_Type_value = map[string]int32{
-		"TYPE_UNKNOWN":   0,
-		"TYPE_SEMAPHORE": 1,
-		"TYPE_HTTP":      2,
+		"TYPE_UNKNOWN":    0,
+		"TYPE_SEMAPHORE":  1,
+		"TYPE_HTTP":       2,
+		"TYPE_GITHUB":     3,
+		"TYPE_GITLAB":     4,
+		"TYPE_KUBERNETES": 5,
+		"TYPE_PLUGIN":     6,
+		"TYPE_PROCESS":    7,
+		"TYPE_NOOP":       8,
 	}
 )
 
@@ -299,11 +612,11 @@ func (x ExecutorSpec_Type) String() string {
 }
 
 func (ExecutorSpec_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[5].Descriptor()
+	return file_superplane_proto_enumTypes[11].Descriptor()
 }
 
 func (ExecutorSpec_Type) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[5]
+	return &file_superplane_proto_enumTypes[11]
 }
 
 func (x ExecutorSpec_Type) Number() protoreflect.EnumNumber {
@@ -312,7 +625,56 @@ func (x ExecutorSpec_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecutorSpec_Type.Descriptor instead.
 func (ExecutorSpec_Type) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{39, 0}
+	return file_superplane_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type ExecutorSpec_HTTPMode int32
+
+const (
+	ExecutorSpec_HTTP_MODE_SYNC     ExecutorSpec_HTTPMode = 0
+	ExecutorSpec_HTTP_MODE_POLLING  ExecutorSpec_HTTPMode = 1
+	ExecutorSpec_HTTP_MODE_CALLBACK ExecutorSpec_HTTPMode = 2
+)
+
+// Enum value maps for ExecutorSpec_HTTPMode.
+var (
+	ExecutorSpec_HTTPMode_name = map[int32]string{
+		0: "HTTP_MODE_SYNC",
+		1: "HTTP_MODE_POLLING",
+		2: "HTTP_MODE_CALLBACK",
+	}
+	ExecutorSpec_HTTPMode_value = map[string]int32{
+		"HTTP_MODE_SYNC":     0,
+		"HTTP_MODE_POLLING":  1,
+		"HTTP_MODE_CALLBACK": 2,
+	}
+)
+
+func (x ExecutorSpec_HTTPMode) Enum() *ExecutorSpec_HTTPMode {
+	p := new(ExecutorSpec_HTTPMode)
+	*p = x
+	return p
+}
+
+func (x ExecutorSpec_HTTPMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutorSpec_HTTPMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[12].Descriptor()
+}
+
+func (ExecutorSpec_HTTPMode) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[12]
+}
+
+func (x ExecutorSpec_HTTPMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutorSpec_HTTPMode.Descriptor instead.
+func (ExecutorSpec_HTTPMode) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 1}
 }
 
 type StageEvent_State int32
@@ -351,11 +713,11 @@ func (x StageEvent_State) String() string {
 }
 
 func (StageEvent_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[6].Descriptor()
+	return file_superplane_proto_enumTypes[13].Descriptor()
 }
 
 func (StageEvent_State) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[6]
+	return &file_superplane_proto_enumTypes[13]
 }
 
 func (x StageEvent_State) Number() protoreflect.EnumNumber {
@@ -364,7 +726,7 @@ func (x StageEvent_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StageEvent_State.Descriptor instead.
 func (StageEvent_State) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{49, 0}
+	return file_superplane_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type StageEvent_StateReason int32
@@ -412,11 +774,11 @@ func (x StageEvent_StateReason) String() string {
 }
 
 func (StageEvent_StateReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[7].Descriptor()
+	return file_superplane_proto_enumTypes[14].Descriptor()
 }
 
 func (StageEvent_StateReason) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[7]
+	return &file_superplane_proto_enumTypes[14]
 }
 
 func (x StageEvent_StateReason) Number() protoreflect.EnumNumber {
@@ -425,7 +787,7 @@ func (x StageEvent_StateReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StageEvent_StateReason.Descriptor instead.
 func (StageEvent_StateReason) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{49, 1}
+	return file_superplane_proto_rawDescGZIP(), []int{53, 1}
 }
 
 type Execution_State int32
@@ -464,11 +826,11 @@ func (x Execution_State) String() string {
 }
 
 func (Execution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[8].Descriptor()
+	return file_superplane_proto_enumTypes[15].Descriptor()
 }
 
 func (Execution_State) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[8]
+	return &file_superplane_proto_enumTypes[15]
 }
 
 func (x Execution_State) Number() protoreflect.EnumNumber {
@@ -477,15 +839,16 @@ func (x Execution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Execution_State.Descriptor instead.
 func (Execution_State) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{52, 0}
+	return file_superplane_proto_rawDescGZIP(), []int{56, 0}
 }
 
 type Execution_Result int32
 
 const (
-	Execution_RESULT_UNKNOWN Execution_Result = 0
-	Execution_RESULT_PASSED  Execution_Result = 1
-	Execution_RESULT_FAILED  Execution_Result = 2
+	Execution_RESULT_UNKNOWN   Execution_Result = 0
+	Execution_RESULT_PASSED    Execution_Result = 1
+	Execution_RESULT_FAILED    Execution_Result = 2
+	Execution_RESULT_CANCELLED Execution_Result = 3
 )
 
 // Enum value maps for Execution_Result.
@@ -494,11 +857,13 @@ var (
 		0: "RESULT_UNKNOWN",
 		1: "RESULT_PASSED",
 		2: "RESULT_FAILED",
+		3: "RESULT_CANCELLED",
 	}
 	Execution_Result_value = map[string]int32{
-		"RESULT_UNKNOWN": 0,
-		"RESULT_PASSED":  1,
-		"RESULT_FAILED":  2,
+		"RESULT_UNKNOWN":   0,
+		"RESULT_PASSED":    1,
+		"RESULT_FAILED":    2,
+		"RESULT_CANCELLED": 3,
 	}
 )
 
@@ -513,11 +878,11 @@ func (x Execution_Result) String() string {
 }
 
 func (Execution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_superplane_proto_enumTypes[9].Descriptor()
+	return file_superplane_proto_enumTypes[16].Descriptor()
 }
 
 func (Execution_Result) Type() protoreflect.EnumType {
-	return &file_superplane_proto_enumTypes[9]
+	return &file_superplane_proto_enumTypes[16]
 }
 
 func (x Execution_Result) Number() protoreflect.EnumNumber {
@@ -526,44 +891,197 @@ func (x Execution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Execution_Result.Descriptor instead.
 func (Execution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{52, 1}
+	return file_superplane_proto_rawDescGZIP(), []int{56, 1}
 }
 
-type ListCanvasesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
+type Execution_ResultReason int32
 
-func (x *ListCanvasesRequest) Reset() {
-	*x = ListCanvasesRequest{}
-	mi := &file_superplane_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+const (
+	Execution_RESULT_REASON_NONE    Execution_ResultReason = 0
+	Execution_RESULT_REASON_TIMEOUT Execution_ResultReason = 1
+	Execution_RESULT_REASON_ERROR   Execution_ResultReason = 2
+)
 
-func (x *ListCanvasesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Enum value maps for Execution_ResultReason.
+var (
+	Execution_ResultReason_name = map[int32]string{
+		0: "RESULT_REASON_NONE",
+		1: "RESULT_REASON_TIMEOUT",
+		2: "RESULT_REASON_ERROR",
+	}
+	Execution_ResultReason_value = map[string]int32{
+		"RESULT_REASON_NONE":    0,
+		"RESULT_REASON_TIMEOUT": 1,
+		"RESULT_REASON_ERROR":   2,
+	}
+)
+
+func (x Execution_ResultReason) Enum() *Execution_ResultReason {
+	p := new(Execution_ResultReason)
+	*p = x
+	return p
 }
 
-func (*ListCanvasesRequest) ProtoMessage() {}
+func (x Execution_ResultReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *ListCanvasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Execution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[17].Descriptor()
 }
 
-// Deprecated: Use ListCanvasesRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasesRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{0}
+func (Execution_ResultReason) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[17]
+}
+
+func (x Execution_ResultReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Execution_ResultReason.Descriptor instead.
+func (Execution_ResultReason) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{56, 2}
+}
+
+type Event_State int32
+
+const (
+	Event_STATE_UNKNOWN   Event_State = 0
+	Event_STATE_PENDING   Event_State = 1
+	Event_STATE_PROCESSED Event_State = 2
+	Event_STATE_DISCARDED Event_State = 3
+)
+
+// Enum value maps for Event_State.
+var (
+	Event_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_PENDING",
+		2: "STATE_PROCESSED",
+		3: "STATE_DISCARDED",
+	}
+	Event_State_value = map[string]int32{
+		"STATE_UNKNOWN":   0,
+		"STATE_PENDING":   1,
+		"STATE_PROCESSED": 2,
+		"STATE_DISCARDED": 3,
+	}
+)
+
+func (x Event_State) Enum() *Event_State {
+	p := new(Event_State)
+	*p = x
+	return p
+}
+
+func (x Event_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[18].Descriptor()
+}
+
+func (Event_State) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[18]
+}
+
+func (x Event_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_State.Descriptor instead.
+func (Event_State) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{64, 0}
+}
+
+type ExecutionLog_Stream int32
+
+const (
+	ExecutionLog_STREAM_UNKNOWN ExecutionLog_Stream = 0
+	ExecutionLog_STREAM_STDOUT  ExecutionLog_Stream = 1
+	ExecutionLog_STREAM_STDERR  ExecutionLog_Stream = 2
+	ExecutionLog_STREAM_SYSTEM  ExecutionLog_Stream = 3
+)
+
+// Enum value maps for ExecutionLog_Stream.
+var (
+	ExecutionLog_Stream_name = map[int32]string{
+		0: "STREAM_UNKNOWN",
+		1: "STREAM_STDOUT",
+		2: "STREAM_STDERR",
+		3: "STREAM_SYSTEM",
+	}
+	ExecutionLog_Stream_value = map[string]int32{
+		"STREAM_UNKNOWN": 0,
+		"STREAM_STDOUT":  1,
+		"STREAM_STDERR":  2,
+		"STREAM_SYSTEM":  3,
+	}
+)
+
+func (x ExecutionLog_Stream) Enum() *ExecutionLog_Stream {
+	p := new(ExecutionLog_Stream)
+	*p = x
+	return p
+}
+
+func (x ExecutionLog_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionLog_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_superplane_proto_enumTypes[19].Descriptor()
+}
+
+func (ExecutionLog_Stream) Type() protoreflect.EnumType {
+	return &file_superplane_proto_enumTypes[19]
+}
+
+func (x ExecutionLog_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionLog_Stream.Descriptor instead.
+func (ExecutionLog_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{71, 0}
+}
+
+type ListCanvasesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCanvasesRequest) Reset() {
+	*x = ListCanvasesRequest{}
+	mi := &file_superplane_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasesRequest) ProtoMessage() {}
+
+func (x *ListCanvasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasesRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *ListCanvasesRequest) GetOrganizationId() string {
@@ -1677,6 +2195,162 @@ func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
 	return file_superplane_proto_rawDescGZIP(), []int{22}
 }
 
+type Agent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Agent_Metadata        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	mi := &file_superplane_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Agent) GetMetadata() *Agent_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CreateAgentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Agent          *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	RequesterId    string                 `protobuf:"bytes,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	CanvasIdOrName string                 `protobuf:"bytes,3,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAgentRequest) Reset() {
+	*x = CreateAgentRequest{}
+	mi := &file_superplane_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentRequest) ProtoMessage() {}
+
+func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateAgentRequest) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+func (x *CreateAgentRequest) GetCanvasIdOrName() string {
+	if x != nil {
+		return x.CanvasIdOrName
+	}
+	return ""
+}
+
+type CreateAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAgentResponse) Reset() {
+	*x = CreateAgentResponse{}
+	mi := &file_superplane_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentResponse) ProtoMessage() {}
+
+func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateAgentResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *CreateAgentResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 type DescribeEventSourceRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1688,7 +2362,7 @@ type DescribeEventSourceRequest struct {
 
 func (x *DescribeEventSourceRequest) Reset() {
 	*x = DescribeEventSourceRequest{}
-	mi := &file_superplane_proto_msgTypes[23]
+	mi := &file_superplane_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1700,7 +2374,7 @@ func (x *DescribeEventSourceRequest) String() string {
 func (*DescribeEventSourceRequest) ProtoMessage() {}
 
 func (x *DescribeEventSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[23]
+	mi := &file_superplane_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +2387,7 @@ func (x *DescribeEventSourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeEventSourceRequest.ProtoReflect.Descriptor instead.
 func (*DescribeEventSourceRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{23}
+	return file_superplane_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DescribeEventSourceRequest) GetId() string {
@@ -1746,7 +2420,7 @@ type DescribeEventSourceResponse struct {
 
 func (x *DescribeEventSourceResponse) Reset() {
 	*x = DescribeEventSourceResponse{}
-	mi := &file_superplane_proto_msgTypes[24]
+	mi := &file_superplane_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +2432,7 @@ func (x *DescribeEventSourceResponse) String() string {
 func (*DescribeEventSourceResponse) ProtoMessage() {}
 
 func (x *DescribeEventSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[24]
+	mi := &file_superplane_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +2445,7 @@ func (x *DescribeEventSourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeEventSourceResponse.ProtoReflect.Descriptor instead.
 func (*DescribeEventSourceResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{24}
+	return file_superplane_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DescribeEventSourceResponse) GetEventSource() *EventSource {
@@ -1793,7 +2467,7 @@ type Connection struct {
 
 func (x *Connection) Reset() {
 	*x = Connection{}
-	mi := &file_superplane_proto_msgTypes[25]
+	mi := &file_superplane_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1805,7 +2479,7 @@ func (x *Connection) String() string {
 func (*Connection) ProtoMessage() {}
 
 func (x *Connection) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[25]
+	mi := &file_superplane_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +2492,7 @@ func (x *Connection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Connection.ProtoReflect.Descriptor instead.
 func (*Connection) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25}
+	return file_superplane_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Connection) GetType() Connection_Type {
@@ -1859,7 +2533,7 @@ type Stage struct {
 
 func (x *Stage) Reset() {
 	*x = Stage{}
-	mi := &file_superplane_proto_msgTypes[26]
+	mi := &file_superplane_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1871,7 +2545,7 @@ func (x *Stage) String() string {
 func (*Stage) ProtoMessage() {}
 
 func (x *Stage) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[26]
+	mi := &file_superplane_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2558,7 @@ func (x *Stage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stage.ProtoReflect.Descriptor instead.
 func (*Stage) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{26}
+	return file_superplane_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Stage) GetMetadata() *Stage_Metadata {
@@ -1912,7 +2586,7 @@ type OutputDefinition struct {
 
 func (x *OutputDefinition) Reset() {
 	*x = OutputDefinition{}
-	mi := &file_superplane_proto_msgTypes[27]
+	mi := &file_superplane_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1924,7 +2598,7 @@ func (x *OutputDefinition) String() string {
 func (*OutputDefinition) ProtoMessage() {}
 
 func (x *OutputDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[27]
+	mi := &file_superplane_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +2611,7 @@ func (x *OutputDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputDefinition.ProtoReflect.Descriptor instead.
 func (*OutputDefinition) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{27}
+	return file_superplane_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OutputDefinition) GetName() string {
@@ -1971,7 +2645,7 @@ type InputDefinition struct {
 
 func (x *InputDefinition) Reset() {
 	*x = InputDefinition{}
-	mi := &file_superplane_proto_msgTypes[28]
+	mi := &file_superplane_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1983,7 +2657,7 @@ func (x *InputDefinition) String() string {
 func (*InputDefinition) ProtoMessage() {}
 
 func (x *InputDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[28]
+	mi := &file_superplane_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,7 +2670,7 @@ func (x *InputDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputDefinition.ProtoReflect.Descriptor instead.
 func (*InputDefinition) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{28}
+	return file_superplane_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *InputDefinition) GetName() string {
@@ -2023,7 +2697,7 @@ type InputMapping struct {
 
 func (x *InputMapping) Reset() {
 	*x = InputMapping{}
-	mi := &file_superplane_proto_msgTypes[29]
+	mi := &file_superplane_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2035,7 +2709,7 @@ func (x *InputMapping) String() string {
 func (*InputMapping) ProtoMessage() {}
 
 func (x *InputMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[29]
+	mi := &file_superplane_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2048,7 +2722,7 @@ func (x *InputMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputMapping.ProtoReflect.Descriptor instead.
 func (*InputMapping) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{29}
+	return file_superplane_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InputMapping) GetValues() []*ValueDefinition {
@@ -2076,7 +2750,7 @@ type ValueDefinition struct {
 
 func (x *ValueDefinition) Reset() {
 	*x = ValueDefinition{}
-	mi := &file_superplane_proto_msgTypes[30]
+	mi := &file_superplane_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2088,7 +2762,7 @@ func (x *ValueDefinition) String() string {
 func (*ValueDefinition) ProtoMessage() {}
 
 func (x *ValueDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[30]
+	mi := &file_superplane_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2775,7 @@ func (x *ValueDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueDefinition.ProtoReflect.Descriptor instead.
 func (*ValueDefinition) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{30}
+	return file_superplane_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ValueDefinition) GetName() string {
@@ -2136,7 +2810,7 @@ type ValueFrom struct {
 
 func (x *ValueFrom) Reset() {
 	*x = ValueFrom{}
-	mi := &file_superplane_proto_msgTypes[31]
+	mi := &file_superplane_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2148,7 +2822,7 @@ func (x *ValueFrom) String() string {
 func (*ValueFrom) ProtoMessage() {}
 
 func (x *ValueFrom) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[31]
+	mi := &file_superplane_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,7 +2835,7 @@ func (x *ValueFrom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFrom.ProtoReflect.Descriptor instead.
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{31}
+	return file_superplane_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ValueFrom) GetEventData() *ValueFromEventData {
@@ -2195,7 +2869,7 @@ type ValueFromEventData struct {
 
 func (x *ValueFromEventData) Reset() {
 	*x = ValueFromEventData{}
-	mi := &file_superplane_proto_msgTypes[32]
+	mi := &file_superplane_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2207,7 +2881,7 @@ func (x *ValueFromEventData) String() string {
 func (*ValueFromEventData) ProtoMessage() {}
 
 func (x *ValueFromEventData) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[32]
+	mi := &file_superplane_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2220,7 +2894,7 @@ func (x *ValueFromEventData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFromEventData.ProtoReflect.Descriptor instead.
 func (*ValueFromEventData) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{32}
+	return file_superplane_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ValueFromEventData) GetConnection() string {
@@ -2246,7 +2920,7 @@ type ValueFromLastExecution struct {
 
 func (x *ValueFromLastExecution) Reset() {
 	*x = ValueFromLastExecution{}
-	mi := &file_superplane_proto_msgTypes[33]
+	mi := &file_superplane_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2258,7 +2932,7 @@ func (x *ValueFromLastExecution) String() string {
 func (*ValueFromLastExecution) ProtoMessage() {}
 
 func (x *ValueFromLastExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[33]
+	mi := &file_superplane_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,7 +2945,7 @@ func (x *ValueFromLastExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFromLastExecution.ProtoReflect.Descriptor instead.
 func (*ValueFromLastExecution) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{33}
+	return file_superplane_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ValueFromLastExecution) GetResults() []Execution_Result {
@@ -2291,7 +2965,7 @@ type ValueFromSecret struct {
 
 func (x *ValueFromSecret) Reset() {
 	*x = ValueFromSecret{}
-	mi := &file_superplane_proto_msgTypes[34]
+	mi := &file_superplane_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2303,7 +2977,7 @@ func (x *ValueFromSecret) String() string {
 func (*ValueFromSecret) ProtoMessage() {}
 
 func (x *ValueFromSecret) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[34]
+	mi := &file_superplane_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,7 +2990,7 @@ func (x *ValueFromSecret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFromSecret.ProtoReflect.Descriptor instead.
 func (*ValueFromSecret) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{34}
+	return file_superplane_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ValueFromSecret) GetName() string {
@@ -2344,7 +3018,7 @@ type Condition struct {
 
 func (x *Condition) Reset() {
 	*x = Condition{}
-	mi := &file_superplane_proto_msgTypes[35]
+	mi := &file_superplane_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +3030,7 @@ func (x *Condition) String() string {
 func (*Condition) ProtoMessage() {}
 
 func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[35]
+	mi := &file_superplane_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +3043,7 @@ func (x *Condition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Condition.ProtoReflect.Descriptor instead.
 func (*Condition) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{35}
+	return file_superplane_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Condition) GetType() Condition_Type {
@@ -2393,6 +3067,74 @@ func (x *Condition) GetTimeWindow() *ConditionTimeWindow {
 	return nil
 }
 
+type RetryPolicy struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	MaxAttempts     uint32                      `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff         uint32                      `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	BackoffStrategy RetryPolicy_BackoffStrategy `protobuf:"varint,3,opt,name=backoff_strategy,json=backoffStrategy,proto3,enum=Superplane.RetryPolicy_BackoffStrategy" json:"backoff_strategy,omitempty"`
+	RetryOn         []RetryPolicy_FailureKind   `protobuf:"varint,4,rep,packed,name=retry_on,json=retryOn,proto3,enum=Superplane.RetryPolicy_FailureKind" json:"retry_on,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_superplane_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() uint32 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffStrategy() RetryPolicy_BackoffStrategy {
+	if x != nil {
+		return x.BackoffStrategy
+	}
+	return RetryPolicy_BACKOFF_STRATEGY_FIXED
+}
+
+func (x *RetryPolicy) GetRetryOn() []RetryPolicy_FailureKind {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
 type ConditionApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Count         uint32                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -2402,7 +3144,7 @@ type ConditionApproval struct {
 
 func (x *ConditionApproval) Reset() {
 	*x = ConditionApproval{}
-	mi := &file_superplane_proto_msgTypes[36]
+	mi := &file_superplane_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2414,7 +3156,7 @@ func (x *ConditionApproval) String() string {
 func (*ConditionApproval) ProtoMessage() {}
 
 func (x *ConditionApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[36]
+	mi := &file_superplane_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +3169,7 @@ func (x *ConditionApproval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionApproval.ProtoReflect.Descriptor instead.
 func (*ConditionApproval) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{36}
+	return file_superplane_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ConditionApproval) GetCount() uint32 {
@@ -2448,7 +3190,7 @@ type ConditionTimeWindow struct {
 
 func (x *ConditionTimeWindow) Reset() {
 	*x = ConditionTimeWindow{}
-	mi := &file_superplane_proto_msgTypes[37]
+	mi := &file_superplane_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2460,7 +3202,7 @@ func (x *ConditionTimeWindow) String() string {
 func (*ConditionTimeWindow) ProtoMessage() {}
 
 func (x *ConditionTimeWindow) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[37]
+	mi := &file_superplane_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2473,7 +3215,7 @@ func (x *ConditionTimeWindow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionTimeWindow.ProtoReflect.Descriptor instead.
 func (*ConditionTimeWindow) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{37}
+	return file_superplane_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ConditionTimeWindow) GetStart() string {
@@ -2508,7 +3250,7 @@ type CreateStageRequest struct {
 
 func (x *CreateStageRequest) Reset() {
 	*x = CreateStageRequest{}
-	mi := &file_superplane_proto_msgTypes[38]
+	mi := &file_superplane_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2520,7 +3262,7 @@ func (x *CreateStageRequest) String() string {
 func (*CreateStageRequest) ProtoMessage() {}
 
 func (x *CreateStageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[38]
+	mi := &file_superplane_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2533,7 +3275,7 @@ func (x *CreateStageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStageRequest.ProtoReflect.Descriptor instead.
 func (*CreateStageRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{38}
+	return file_superplane_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CreateStageRequest) GetStage() *Stage {
@@ -2558,17 +3300,23 @@ func (x *CreateStageRequest) GetCanvasIdOrName() string {
 }
 
 type ExecutorSpec struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Type          ExecutorSpec_Type       `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.ExecutorSpec_Type" json:"type,omitempty"`
-	Semaphore     *ExecutorSpec_Semaphore `protobuf:"bytes,2,opt,name=semaphore,proto3" json:"semaphore,omitempty"`
-	Http          *ExecutorSpec_HTTP      `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Type          ExecutorSpec_Type        `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.ExecutorSpec_Type" json:"type,omitempty"`
+	Semaphore     *ExecutorSpec_Semaphore  `protobuf:"bytes,2,opt,name=semaphore,proto3" json:"semaphore,omitempty"`
+	Http          *ExecutorSpec_HTTP       `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	Github        *ExecutorSpec_GitHub     `protobuf:"bytes,4,opt,name=github,proto3" json:"github,omitempty"`
+	Gitlab        *ExecutorSpec_GitLab     `protobuf:"bytes,5,opt,name=gitlab,proto3" json:"gitlab,omitempty"`
+	Kubernetes    *ExecutorSpec_Kubernetes `protobuf:"bytes,6,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
+	Plugin        *ExecutorSpec_Plugin     `protobuf:"bytes,7,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Process       *ExecutorSpec_Process    `protobuf:"bytes,8,opt,name=process,proto3" json:"process,omitempty"`
+	Noop          *ExecutorSpec_Noop       `protobuf:"bytes,9,opt,name=noop,proto3" json:"noop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExecutorSpec) Reset() {
 	*x = ExecutorSpec{}
-	mi := &file_superplane_proto_msgTypes[39]
+	mi := &file_superplane_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2580,7 +3328,7 @@ func (x *ExecutorSpec) String() string {
 func (*ExecutorSpec) ProtoMessage() {}
 
 func (x *ExecutorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[39]
+	mi := &file_superplane_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,7 +3341,7 @@ func (x *ExecutorSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutorSpec.ProtoReflect.Descriptor instead.
 func (*ExecutorSpec) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{39}
+	return file_superplane_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ExecutorSpec) GetType() ExecutorSpec_Type {
@@ -2617,6 +3365,48 @@ func (x *ExecutorSpec) GetHttp() *ExecutorSpec_HTTP {
 	return nil
 }
 
+func (x *ExecutorSpec) GetGithub() *ExecutorSpec_GitHub {
+	if x != nil {
+		return x.Github
+	}
+	return nil
+}
+
+func (x *ExecutorSpec) GetGitlab() *ExecutorSpec_GitLab {
+	if x != nil {
+		return x.Gitlab
+	}
+	return nil
+}
+
+func (x *ExecutorSpec) GetKubernetes() *ExecutorSpec_Kubernetes {
+	if x != nil {
+		return x.Kubernetes
+	}
+	return nil
+}
+
+func (x *ExecutorSpec) GetPlugin() *ExecutorSpec_Plugin {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+func (x *ExecutorSpec) GetProcess() *ExecutorSpec_Process {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *ExecutorSpec) GetNoop() *ExecutorSpec_Noop {
+	if x != nil {
+		return x.Noop
+	}
+	return nil
+}
+
 type CreateStageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Stage         *Stage                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
@@ -2626,7 +3416,7 @@ type CreateStageResponse struct {
 
 func (x *CreateStageResponse) Reset() {
 	*x = CreateStageResponse{}
-	mi := &file_superplane_proto_msgTypes[40]
+	mi := &file_superplane_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2638,7 +3428,7 @@ func (x *CreateStageResponse) String() string {
 func (*CreateStageResponse) ProtoMessage() {}
 
 func (x *CreateStageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[40]
+	mi := &file_superplane_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,7 +3441,7 @@ func (x *CreateStageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStageResponse.ProtoReflect.Descriptor instead.
 func (*CreateStageResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{40}
+	return file_superplane_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CreateStageResponse) GetStage() *Stage {
@@ -2673,7 +3463,7 @@ type UpdateStageRequest struct {
 
 func (x *UpdateStageRequest) Reset() {
 	*x = UpdateStageRequest{}
-	mi := &file_superplane_proto_msgTypes[41]
+	mi := &file_superplane_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2685,7 +3475,7 @@ func (x *UpdateStageRequest) String() string {
 func (*UpdateStageRequest) ProtoMessage() {}
 
 func (x *UpdateStageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[41]
+	mi := &file_superplane_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,7 +3488,7 @@ func (x *UpdateStageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStageRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStageRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{41}
+	return file_superplane_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *UpdateStageRequest) GetStage() *Stage {
@@ -2738,7 +3528,7 @@ type UpdateStageResponse struct {
 
 func (x *UpdateStageResponse) Reset() {
 	*x = UpdateStageResponse{}
-	mi := &file_superplane_proto_msgTypes[42]
+	mi := &file_superplane_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2750,7 +3540,7 @@ func (x *UpdateStageResponse) String() string {
 func (*UpdateStageResponse) ProtoMessage() {}
 
 func (x *UpdateStageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[42]
+	mi := &file_superplane_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,7 +3553,7 @@ func (x *UpdateStageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStageResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStageResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{42}
+	return file_superplane_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdateStageResponse) GetStage() *Stage {
@@ -2782,7 +3572,7 @@ type ListStagesRequest struct {
 
 func (x *ListStagesRequest) Reset() {
 	*x = ListStagesRequest{}
-	mi := &file_superplane_proto_msgTypes[43]
+	mi := &file_superplane_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2794,7 +3584,7 @@ func (x *ListStagesRequest) String() string {
 func (*ListStagesRequest) ProtoMessage() {}
 
 func (x *ListStagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[43]
+	mi := &file_superplane_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2807,7 +3597,7 @@ func (x *ListStagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStagesRequest.ProtoReflect.Descriptor instead.
 func (*ListStagesRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{43}
+	return file_superplane_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListStagesRequest) GetCanvasIdOrName() string {
@@ -2826,7 +3616,7 @@ type ListStagesResponse struct {
 
 func (x *ListStagesResponse) Reset() {
 	*x = ListStagesResponse{}
-	mi := &file_superplane_proto_msgTypes[44]
+	mi := &file_superplane_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +3628,7 @@ func (x *ListStagesResponse) String() string {
 func (*ListStagesResponse) ProtoMessage() {}
 
 func (x *ListStagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[44]
+	mi := &file_superplane_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +3641,7 @@ func (x *ListStagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStagesResponse.ProtoReflect.Descriptor instead.
 func (*ListStagesResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{44}
+	return file_superplane_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListStagesResponse) GetStages() []*Stage {
@@ -2870,7 +3660,7 @@ type ListEventSourcesRequest struct {
 
 func (x *ListEventSourcesRequest) Reset() {
 	*x = ListEventSourcesRequest{}
-	mi := &file_superplane_proto_msgTypes[45]
+	mi := &file_superplane_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2882,7 +3672,7 @@ func (x *ListEventSourcesRequest) String() string {
 func (*ListEventSourcesRequest) ProtoMessage() {}
 
 func (x *ListEventSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[45]
+	mi := &file_superplane_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2895,7 +3685,7 @@ func (x *ListEventSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListEventSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{45}
+	return file_superplane_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListEventSourcesRequest) GetCanvasIdOrName() string {
@@ -2914,7 +3704,7 @@ type ListEventSourcesResponse struct {
 
 func (x *ListEventSourcesResponse) Reset() {
 	*x = ListEventSourcesResponse{}
-	mi := &file_superplane_proto_msgTypes[46]
+	mi := &file_superplane_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2926,7 +3716,7 @@ func (x *ListEventSourcesResponse) String() string {
 func (*ListEventSourcesResponse) ProtoMessage() {}
 
 func (x *ListEventSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[46]
+	mi := &file_superplane_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,7 +3729,7 @@ func (x *ListEventSourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListEventSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{46}
+	return file_superplane_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListEventSourcesResponse) GetEventSources() []*EventSource {
@@ -2961,7 +3751,7 @@ type ListStageEventsRequest struct {
 
 func (x *ListStageEventsRequest) Reset() {
 	*x = ListStageEventsRequest{}
-	mi := &file_superplane_proto_msgTypes[47]
+	mi := &file_superplane_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2973,7 +3763,7 @@ func (x *ListStageEventsRequest) String() string {
 func (*ListStageEventsRequest) ProtoMessage() {}
 
 func (x *ListStageEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[47]
+	mi := &file_superplane_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2986,7 +3776,7 @@ func (x *ListStageEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStageEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListStageEventsRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{47}
+	return file_superplane_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListStageEventsRequest) GetStageIdOrName() string {
@@ -3026,7 +3816,7 @@ type ListStageEventsResponse struct {
 
 func (x *ListStageEventsResponse) Reset() {
 	*x = ListStageEventsResponse{}
-	mi := &file_superplane_proto_msgTypes[48]
+	mi := &file_superplane_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3038,7 +3828,7 @@ func (x *ListStageEventsResponse) String() string {
 func (*ListStageEventsResponse) ProtoMessage() {}
 
 func (x *ListStageEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[48]
+	mi := &file_superplane_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,7 +3841,7 @@ func (x *ListStageEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStageEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListStageEventsResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{48}
+	return file_superplane_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListStageEventsResponse) GetEvents() []*StageEvent {
@@ -3078,7 +3868,7 @@ type StageEvent struct {
 
 func (x *StageEvent) Reset() {
 	*x = StageEvent{}
-	mi := &file_superplane_proto_msgTypes[49]
+	mi := &file_superplane_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3090,7 +3880,7 @@ func (x *StageEvent) String() string {
 func (*StageEvent) ProtoMessage() {}
 
 func (x *StageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[49]
+	mi := &file_superplane_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,7 +3893,7 @@ func (x *StageEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageEvent.ProtoReflect.Descriptor instead.
 func (*StageEvent) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{49}
+	return file_superplane_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *StageEvent) GetId() string {
@@ -3179,7 +3969,7 @@ type InputValue struct {
 
 func (x *InputValue) Reset() {
 	*x = InputValue{}
-	mi := &file_superplane_proto_msgTypes[50]
+	mi := &file_superplane_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3191,7 +3981,7 @@ func (x *InputValue) String() string {
 func (*InputValue) ProtoMessage() {}
 
 func (x *InputValue) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[50]
+	mi := &file_superplane_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +3994,7 @@ func (x *InputValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputValue.ProtoReflect.Descriptor instead.
 func (*InputValue) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{50}
+	return file_superplane_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *InputValue) GetName() string {
@@ -3231,7 +4021,7 @@ type OutputValue struct {
 
 func (x *OutputValue) Reset() {
 	*x = OutputValue{}
-	mi := &file_superplane_proto_msgTypes[51]
+	mi := &file_superplane_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3243,7 +4033,7 @@ func (x *OutputValue) String() string {
 func (*OutputValue) ProtoMessage() {}
 
 func (x *OutputValue) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[51]
+	mi := &file_superplane_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3256,7 +4046,7 @@ func (x *OutputValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputValue.ProtoReflect.Descriptor instead.
 func (*OutputValue) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{51}
+	return file_superplane_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *OutputValue) GetName() string {
@@ -3274,22 +4064,26 @@ func (x *OutputValue) GetValue() string {
 }
 
 type Execution struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	State         Execution_State        `protobuf:"varint,3,opt,name=state,proto3,enum=Superplane.Execution_State" json:"state,omitempty"`
-	Result        Execution_Result       `protobuf:"varint,4,opt,name=result,proto3,enum=Superplane.Execution_Result" json:"result,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	StartedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	FinishedAt    *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
-	Outputs       []*OutputValue         `protobuf:"bytes,8,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReferenceId         string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	State               Execution_State        `protobuf:"varint,3,opt,name=state,proto3,enum=Superplane.Execution_State" json:"state,omitempty"`
+	Result              Execution_Result       `protobuf:"varint,4,opt,name=result,proto3,enum=Superplane.Execution_Result" json:"result,omitempty"`
+	CreatedAt           *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt           *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt          *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Outputs             []*OutputValue         `protobuf:"bytes,8,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	ResultReason        Execution_ResultReason `protobuf:"varint,9,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Execution_ResultReason" json:"result_reason,omitempty"`
+	Attempt             uint32                 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	PreviousExecutionId string                 `protobuf:"bytes,11,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
+	ScheduledAt         *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Execution) Reset() {
 	*x = Execution{}
-	mi := &file_superplane_proto_msgTypes[52]
+	mi := &file_superplane_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3301,7 +4095,7 @@ func (x *Execution) String() string {
 func (*Execution) ProtoMessage() {}
 
 func (x *Execution) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[52]
+	mi := &file_superplane_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,7 +4108,7 @@ func (x *Execution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execution.ProtoReflect.Descriptor instead.
 func (*Execution) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{52}
+	return file_superplane_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *Execution) GetId() string {
@@ -3373,6 +4167,34 @@ func (x *Execution) GetOutputs() []*OutputValue {
 	return nil
 }
 
+func (x *Execution) GetResultReason() Execution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return Execution_RESULT_REASON_NONE
+}
+
+func (x *Execution) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *Execution) GetPreviousExecutionId() string {
+	if x != nil {
+		return x.PreviousExecutionId
+	}
+	return ""
+}
+
+func (x *Execution) GetScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
 type StageEventApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApprovedBy    string                 `protobuf:"bytes,1,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
@@ -3383,7 +4205,7 @@ type StageEventApproval struct {
 
 func (x *StageEventApproval) Reset() {
 	*x = StageEventApproval{}
-	mi := &file_superplane_proto_msgTypes[53]
+	mi := &file_superplane_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3395,7 +4217,7 @@ func (x *StageEventApproval) String() string {
 func (*StageEventApproval) ProtoMessage() {}
 
 func (x *StageEventApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[53]
+	mi := &file_superplane_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3408,7 +4230,7 @@ func (x *StageEventApproval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StageEventApproval.ProtoReflect.Descriptor instead.
 func (*StageEventApproval) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{53}
+	return file_superplane_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *StageEventApproval) GetApprovedBy() string {
@@ -3437,7 +4259,7 @@ type ApproveStageEventRequest struct {
 
 func (x *ApproveStageEventRequest) Reset() {
 	*x = ApproveStageEventRequest{}
-	mi := &file_superplane_proto_msgTypes[54]
+	mi := &file_superplane_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3449,7 +4271,7 @@ func (x *ApproveStageEventRequest) String() string {
 func (*ApproveStageEventRequest) ProtoMessage() {}
 
 func (x *ApproveStageEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[54]
+	mi := &file_superplane_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3462,7 +4284,7 @@ func (x *ApproveStageEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveStageEventRequest.ProtoReflect.Descriptor instead.
 func (*ApproveStageEventRequest) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{54}
+	return file_superplane_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ApproveStageEventRequest) GetStageIdOrName() string {
@@ -3502,7 +4324,7 @@ type ApproveStageEventResponse struct {
 
 func (x *ApproveStageEventResponse) Reset() {
 	*x = ApproveStageEventResponse{}
-	mi := &file_superplane_proto_msgTypes[55]
+	mi := &file_superplane_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3514,7 +4336,7 @@ func (x *ApproveStageEventResponse) String() string {
 func (*ApproveStageEventResponse) ProtoMessage() {}
 
 func (x *ApproveStageEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[55]
+	mi := &file_superplane_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,7 +4349,7 @@ func (x *ApproveStageEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveStageEventResponse.ProtoReflect.Descriptor instead.
 func (*ApproveStageEventResponse) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{55}
+	return file_superplane_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ApproveStageEventResponse) GetEvent() *StageEvent {
@@ -3537,30 +4359,31 @@ func (x *ApproveStageEventResponse) GetEvent() *StageEvent {
 	return nil
 }
 
-type StageCreated struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CancelExecutionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StageIdOrName  string                 `protobuf:"bytes,1,opt,name=stage_id_or_name,json=stageIdOrName,proto3" json:"stage_id_or_name,omitempty"`
+	CanvasIdOrName string                 `protobuf:"bytes,2,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	ExecutionId    string                 `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	RequesterId    string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StageCreated) Reset() {
-	*x = StageCreated{}
-	mi := &file_superplane_proto_msgTypes[56]
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_superplane_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageCreated) String() string {
+func (x *CancelExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageCreated) ProtoMessage() {}
+func (*CancelExecutionRequest) ProtoMessage() {}
 
-func (x *StageCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[56]
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3571,56 +4394,61 @@ func (x *StageCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageCreated.ProtoReflect.Descriptor instead.
-func (*StageCreated) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *StageCreated) GetCanvasId() string {
+func (x *CancelExecutionRequest) GetStageIdOrName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.StageIdOrName
 	}
 	return ""
 }
 
-func (x *StageCreated) GetStageId() string {
+func (x *CancelExecutionRequest) GetCanvasIdOrName() string {
 	if x != nil {
-		return x.StageId
+		return x.CanvasIdOrName
 	}
 	return ""
 }
 
-func (x *StageCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *CancelExecutionRequest) GetExecutionId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ExecutionId
 	}
-	return nil
+	return ""
 }
 
-type StageUpdated struct {
+func (x *CancelExecutionRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type CancelExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StageUpdated) Reset() {
-	*x = StageUpdated{}
-	mi := &file_superplane_proto_msgTypes[57]
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_superplane_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageUpdated) String() string {
+func (x *CancelExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageUpdated) ProtoMessage() {}
+func (*CancelExecutionResponse) ProtoMessage() {}
 
-func (x *StageUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[57]
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3631,56 +4459,43 @@ func (x *StageUpdated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageUpdated.ProtoReflect.Descriptor instead.
-func (*StageUpdated) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *StageUpdated) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *StageUpdated) GetStageId() string {
-	if x != nil {
-		return x.StageId
-	}
-	return ""
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *StageUpdated) GetTimestamp() *timestamp.Timestamp {
+func (x *CancelExecutionResponse) GetExecution() *Execution {
 	if x != nil {
-		return x.Timestamp
+		return x.Execution
 	}
 	return nil
 }
 
-type EventSourceCreated struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RetryExecutionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StageIdOrName  string                 `protobuf:"bytes,1,opt,name=stage_id_or_name,json=stageIdOrName,proto3" json:"stage_id_or_name,omitempty"`
+	CanvasIdOrName string                 `protobuf:"bytes,2,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	ExecutionId    string                 `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	RequesterId    string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *EventSourceCreated) Reset() {
-	*x = EventSourceCreated{}
-	mi := &file_superplane_proto_msgTypes[58]
+func (x *RetryExecutionRequest) Reset() {
+	*x = RetryExecutionRequest{}
+	mi := &file_superplane_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventSourceCreated) String() string {
+func (x *RetryExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSourceCreated) ProtoMessage() {}
+func (*RetryExecutionRequest) ProtoMessage() {}
 
-func (x *EventSourceCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[58]
+func (x *RetryExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,58 +4506,61 @@ func (x *EventSourceCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventSourceCreated.ProtoReflect.Descriptor instead.
-func (*EventSourceCreated) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RetryExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RetryExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *EventSourceCreated) GetCanvasId() string {
+func (x *RetryExecutionRequest) GetStageIdOrName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.StageIdOrName
 	}
 	return ""
 }
 
-func (x *EventSourceCreated) GetSourceId() string {
+func (x *RetryExecutionRequest) GetCanvasIdOrName() string {
 	if x != nil {
-		return x.SourceId
+		return x.CanvasIdOrName
 	}
 	return ""
 }
 
-func (x *EventSourceCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *RetryExecutionRequest) GetExecutionId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ExecutionId
 	}
-	return nil
+	return ""
 }
 
-type StageEventCreated struct {
+func (x *RetryExecutionRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type RetryExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Execution     *Execution             `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StageEventCreated) Reset() {
-	*x = StageEventCreated{}
-	mi := &file_superplane_proto_msgTypes[59]
+func (x *RetryExecutionResponse) Reset() {
+	*x = RetryExecutionResponse{}
+	mi := &file_superplane_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageEventCreated) String() string {
+func (x *RetryExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageEventCreated) ProtoMessage() {}
+func (*RetryExecutionResponse) ProtoMessage() {}
 
-func (x *StageEventCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[59]
+func (x *RetryExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3753,72 +4571,48 @@ func (x *StageEventCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageEventCreated.ProtoReflect.Descriptor instead.
-func (*StageEventCreated) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *StageEventCreated) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *StageEventCreated) GetStageId() string {
-	if x != nil {
-		return x.StageId
-	}
-	return ""
-}
-
-func (x *StageEventCreated) GetEventId() string {
-	if x != nil {
-		return x.EventId
-	}
-	return ""
-}
-
-func (x *StageEventCreated) GetSourceId() string {
-	if x != nil {
-		return x.SourceId
-	}
-	return ""
+// Deprecated: Use RetryExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RetryExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StageEventCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *RetryExecutionResponse) GetExecution() *Execution {
 	if x != nil {
-		return x.Timestamp
+		return x.Execution
 	}
 	return nil
 }
 
-type StageEventApproved struct {
+type Event struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceName    string                 `protobuf:"bytes,3,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	State         Event_State            `protobuf:"varint,4,opt,name=state,proto3,enum=Superplane.Event_State" json:"state,omitempty"`
+	ReceivedAt    *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Payload       *_struct.Struct        `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TriggeredBy   string                 `protobuf:"bytes,8,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	ReplayOf      string                 `protobuf:"bytes,9,opt,name=replay_of,json=replayOf,proto3" json:"replay_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StageEventApproved) Reset() {
-	*x = StageEventApproved{}
-	mi := &file_superplane_proto_msgTypes[60]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_superplane_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageEventApproved) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageEventApproved) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *StageEventApproved) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[60]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3829,72 +4623,99 @@ func (x *StageEventApproved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageEventApproved.ProtoReflect.Descriptor instead.
-func (*StageEventApproved) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *StageEventApproved) GetCanvasId() string {
+func (x *Event) GetId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StageEventApproved) GetStageId() string {
+func (x *Event) GetSourceId() string {
 	if x != nil {
-		return x.StageId
+		return x.SourceId
 	}
 	return ""
 }
 
-func (x *StageEventApproved) GetEventId() string {
+func (x *Event) GetSourceName() string {
 	if x != nil {
-		return x.EventId
+		return x.SourceName
 	}
 	return ""
 }
 
-func (x *StageEventApproved) GetSourceId() string {
+func (x *Event) GetState() Event_State {
 	if x != nil {
-		return x.SourceId
+		return x.State
 	}
-	return ""
+	return Event_STATE_UNKNOWN
 }
 
-func (x *StageEventApproved) GetTimestamp() *timestamp.Timestamp {
+func (x *Event) GetReceivedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.ReceivedAt
 	}
 	return nil
 }
 
-type StageExecutionCreated struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Event) GetPayload() *_struct.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
 }
 
-func (x *StageExecutionCreated) Reset() {
-	*x = StageExecutionCreated{}
-	mi := &file_superplane_proto_msgTypes[61]
+func (x *Event) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Event) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
+func (x *Event) GetReplayOf() string {
+	if x != nil {
+		return x.ReplayOf
+	}
+	return ""
+}
+
+type CreateEventRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CanvasIdOrName      string                 `protobuf:"bytes,1,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	EventSourceIdOrName string                 `protobuf:"bytes,2,opt,name=event_source_id_or_name,json=eventSourceIdOrName,proto3" json:"event_source_id_or_name,omitempty"`
+	Payload             *_struct.Struct        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers             map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_superplane_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageExecutionCreated) String() string {
+func (x *CreateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageExecutionCreated) ProtoMessage() {}
+func (*CreateEventRequest) ProtoMessage() {}
 
-func (x *StageExecutionCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[61]
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3905,72 +4726,61 @@ func (x *StageExecutionCreated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageExecutionCreated.ProtoReflect.Descriptor instead.
-func (*StageExecutionCreated) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *StageExecutionCreated) GetCanvasId() string {
+func (x *CreateEventRequest) GetCanvasIdOrName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.CanvasIdOrName
 	}
 	return ""
 }
 
-func (x *StageExecutionCreated) GetExecutionId() string {
+func (x *CreateEventRequest) GetEventSourceIdOrName() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.EventSourceIdOrName
 	}
 	return ""
 }
 
-func (x *StageExecutionCreated) GetStageId() string {
+func (x *CreateEventRequest) GetPayload() *_struct.Struct {
 	if x != nil {
-		return x.StageId
-	}
-	return ""
-}
-
-func (x *StageExecutionCreated) GetEventId() string {
-	if x != nil {
-		return x.EventId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *StageExecutionCreated) GetTimestamp() *timestamp.Timestamp {
+func (x *CreateEventRequest) GetHeaders() map[string]string {
 	if x != nil {
-		return x.Timestamp
+		return x.Headers
 	}
 	return nil
 }
 
-type StageExecutionStarted struct {
+type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StageExecutionStarted) Reset() {
-	*x = StageExecutionStarted{}
-	mi := &file_superplane_proto_msgTypes[62]
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	mi := &file_superplane_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageExecutionStarted) String() string {
+func (x *CreateEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageExecutionStarted) ProtoMessage() {}
+func (*CreateEventResponse) ProtoMessage() {}
 
-func (x *StageExecutionStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[62]
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3981,72 +4791,108 @@ func (x *StageExecutionStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageExecutionStarted.ProtoReflect.Descriptor instead.
-func (*StageExecutionStarted) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *StageExecutionStarted) GetCanvasId() string {
+func (x *CreateEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.CanvasId
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *StageExecutionStarted) GetExecutionId() string {
+type ReplayEventRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CanvasIdOrName string                 `protobuf:"bytes,1,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	StageIdOrName  string                 `protobuf:"bytes,3,opt,name=stage_id_or_name,json=stageIdOrName,proto3" json:"stage_id_or_name,omitempty"`
+	UseCurrentSpec bool                   `protobuf:"varint,4,opt,name=use_current_spec,json=useCurrentSpec,proto3" json:"use_current_spec,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReplayEventRequest) Reset() {
+	*x = ReplayEventRequest{}
+	mi := &file_superplane_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEventRequest) ProtoMessage() {}
+
+func (x *ReplayEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[67]
 	if x != nil {
-		return x.ExecutionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StageExecutionStarted) GetStageId() string {
+// Deprecated: Use ReplayEventRequest.ProtoReflect.Descriptor instead.
+func (*ReplayEventRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReplayEventRequest) GetCanvasIdOrName() string {
 	if x != nil {
-		return x.StageId
+		return x.CanvasIdOrName
 	}
 	return ""
 }
 
-func (x *StageExecutionStarted) GetEventId() string {
+func (x *ReplayEventRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *StageExecutionStarted) GetTimestamp() *timestamp.Timestamp {
+func (x *ReplayEventRequest) GetStageIdOrName() string {
 	if x != nil {
-		return x.Timestamp
+		return x.StageIdOrName
 	}
-	return nil
+	return ""
 }
 
-type StageExecutionFinished struct {
+func (x *ReplayEventRequest) GetUseCurrentSpec() bool {
+	if x != nil {
+		return x.UseCurrentSpec
+	}
+	return false
+}
+
+type ReplayEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StageExecutionFinished) Reset() {
-	*x = StageExecutionFinished{}
-	mi := &file_superplane_proto_msgTypes[63]
+func (x *ReplayEventResponse) Reset() {
+	*x = ReplayEventResponse{}
+	mi := &file_superplane_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageExecutionFinished) String() string {
+func (x *ReplayEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageExecutionFinished) ProtoMessage() {}
+func (*ReplayEventResponse) ProtoMessage() {}
 
-func (x *StageExecutionFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[63]
+func (x *ReplayEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4057,71 +4903,118 @@ func (x *StageExecutionFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageExecutionFinished.ProtoReflect.Descriptor instead.
-func (*StageExecutionFinished) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ReplayEventResponse.ProtoReflect.Descriptor instead.
+func (*ReplayEventResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *StageExecutionFinished) GetCanvasId() string {
+func (x *ReplayEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.CanvasId
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *StageExecutionFinished) GetExecutionId() string {
+type GetExecutionLogsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StageIdOrName  string                 `protobuf:"bytes,1,opt,name=stage_id_or_name,json=stageIdOrName,proto3" json:"stage_id_or_name,omitempty"`
+	CanvasIdOrName string                 `protobuf:"bytes,2,opt,name=canvas_id_or_name,json=canvasIdOrName,proto3" json:"canvas_id_or_name,omitempty"`
+	ExecutionId    string                 `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	AfterId        int64                  `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Follow         bool                   `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsRequest) Reset() {
+	*x = GetExecutionLogsRequest{}
+	mi := &file_superplane_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsRequest) ProtoMessage() {}
+
+func (x *GetExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[69]
 	if x != nil {
-		return x.ExecutionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetExecutionLogsRequest) GetStageIdOrName() string {
+	if x != nil {
+		return x.StageIdOrName
 	}
 	return ""
 }
 
-func (x *StageExecutionFinished) GetStageId() string {
+func (x *GetExecutionLogsRequest) GetCanvasIdOrName() string {
 	if x != nil {
-		return x.StageId
+		return x.CanvasIdOrName
 	}
 	return ""
 }
 
-func (x *StageExecutionFinished) GetEventId() string {
+func (x *GetExecutionLogsRequest) GetExecutionId() string {
 	if x != nil {
-		return x.EventId
+		return x.ExecutionId
 	}
 	return ""
 }
 
-func (x *StageExecutionFinished) GetTimestamp() *timestamp.Timestamp {
+func (x *GetExecutionLogsRequest) GetAfterId() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.AfterId
 	}
-	return nil
+	return 0
 }
 
-type Canvas_Metadata struct {
+func (x *GetExecutionLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type GetExecutionLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Logs          []*ExecutionLog        `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	LastId        int64                  `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	Finished      bool                   `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Metadata) Reset() {
-	*x = Canvas_Metadata{}
-	mi := &file_superplane_proto_msgTypes[64]
+func (x *GetExecutionLogsResponse) Reset() {
+	*x = GetExecutionLogsResponse{}
+	mi := &file_superplane_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Metadata) String() string {
+func (x *GetExecutionLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Metadata) ProtoMessage() {}
+func (*GetExecutionLogsResponse) ProtoMessage() {}
 
-func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[64]
+func (x *GetExecutionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4132,64 +5025,57 @@ func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
-func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *Canvas_Metadata) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Canvas_Metadata) GetName() string {
+func (x *GetExecutionLogsResponse) GetLogs() []*ExecutionLog {
 	if x != nil {
-		return x.Name
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *Canvas_Metadata) GetCreatedBy() string {
+func (x *GetExecutionLogsResponse) GetLastId() int64 {
 	if x != nil {
-		return x.CreatedBy
+		return x.LastId
 	}
-	return ""
+	return 0
 }
 
-func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *GetExecutionLogsResponse) GetFinished() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Finished
 	}
-	return nil
+	return false
 }
 
-type EventSource_Metadata struct {
+type ExecutionLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stream        ExecutionLog_Stream    `protobuf:"varint,2,opt,name=stream,proto3,enum=Superplane.ExecutionLog_Stream" json:"stream,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventSource_Metadata) Reset() {
-	*x = EventSource_Metadata{}
-	mi := &file_superplane_proto_msgTypes[65]
+func (x *ExecutionLog) Reset() {
+	*x = ExecutionLog{}
+	mi := &file_superplane_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventSource_Metadata) String() string {
+func (x *ExecutionLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSource_Metadata) ProtoMessage() {}
+func (*ExecutionLog) ProtoMessage() {}
 
-func (x *EventSource_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[65]
+func (x *ExecutionLog) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4200,60 +5086,63 @@ func (x *EventSource_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventSource_Metadata.ProtoReflect.Descriptor instead.
-func (*EventSource_Metadata) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use ExecutionLog.ProtoReflect.Descriptor instead.
+func (*ExecutionLog) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *EventSource_Metadata) GetId() string {
+func (x *ExecutionLog) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *EventSource_Metadata) GetName() string {
+func (x *ExecutionLog) GetStream() ExecutionLog_Stream {
 	if x != nil {
-		return x.Name
+		return x.Stream
 	}
-	return ""
+	return ExecutionLog_STREAM_UNKNOWN
 }
 
-func (x *EventSource_Metadata) GetCanvasId() string {
+func (x *ExecutionLog) GetContent() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Content
 	}
 	return ""
 }
 
-func (x *EventSource_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *ExecutionLog) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type EventSource_Spec struct {
+type StageCreated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventSource_Spec) Reset() {
-	*x = EventSource_Spec{}
-	mi := &file_superplane_proto_msgTypes[66]
+func (x *StageCreated) Reset() {
+	*x = StageCreated{}
+	mi := &file_superplane_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventSource_Spec) String() string {
+func (x *StageCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSource_Spec) ProtoMessage() {}
+func (*StageCreated) ProtoMessage() {}
 
-func (x *EventSource_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[66]
+func (x *StageCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4264,34 +5153,1620 @@ func (x *EventSource_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventSource_Spec.ProtoReflect.Descriptor instead.
-func (*EventSource_Spec) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{7, 1}
-}
-
-// Local secrets are stored and managed by SuperPlane itself.
-type Secret_Local struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          map[string]string      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use StageCreated.ProtoReflect.Descriptor instead.
+func (*StageCreated) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *Secret_Local) Reset() {
-	*x = Secret_Local{}
-	mi := &file_superplane_proto_msgTypes[67]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StageCreated) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
 }
 
-func (x *Secret_Local) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StageCreated) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
 }
 
-func (*Secret_Local) ProtoMessage() {}
+func (x *StageCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageUpdated) Reset() {
+	*x = StageUpdated{}
+	mi := &file_superplane_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageUpdated) ProtoMessage() {}
+
+func (x *StageUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageUpdated.ProtoReflect.Descriptor instead.
+func (*StageUpdated) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StageUpdated) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageUpdated) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageUpdated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type EventSourceCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSourceCreated) Reset() {
+	*x = EventSourceCreated{}
+	mi := &file_superplane_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSourceCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSourceCreated) ProtoMessage() {}
+
+func (x *EventSourceCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSourceCreated.ProtoReflect.Descriptor instead.
+func (*EventSourceCreated) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EventSourceCreated) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *EventSourceCreated) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *EventSourceCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageEventCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageEventCreated) Reset() {
+	*x = StageEventCreated{}
+	mi := &file_superplane_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageEventCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageEventCreated) ProtoMessage() {}
+
+func (x *StageEventCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageEventCreated.ProtoReflect.Descriptor instead.
+func (*StageEventCreated) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *StageEventCreated) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageEventCreated) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageEventCreated) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StageEventCreated) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *StageEventCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageEventApproved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageEventApproved) Reset() {
+	*x = StageEventApproved{}
+	mi := &file_superplane_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageEventApproved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageEventApproved) ProtoMessage() {}
+
+func (x *StageEventApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageEventApproved.ProtoReflect.Descriptor instead.
+func (*StageEventApproved) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StageEventApproved) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageEventApproved) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageEventApproved) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StageEventApproved) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *StageEventApproved) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageExecutionCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageExecutionCreated) Reset() {
+	*x = StageExecutionCreated{}
+	mi := &file_superplane_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageExecutionCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageExecutionCreated) ProtoMessage() {}
+
+func (x *StageExecutionCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageExecutionCreated.ProtoReflect.Descriptor instead.
+func (*StageExecutionCreated) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *StageExecutionCreated) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageExecutionCreated) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *StageExecutionCreated) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageExecutionCreated) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StageExecutionCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageExecutionStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageExecutionStarted) Reset() {
+	*x = StageExecutionStarted{}
+	mi := &file_superplane_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageExecutionStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageExecutionStarted) ProtoMessage() {}
+
+func (x *StageExecutionStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageExecutionStarted.ProtoReflect.Descriptor instead.
+func (*StageExecutionStarted) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StageExecutionStarted) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageExecutionStarted) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *StageExecutionStarted) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageExecutionStarted) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StageExecutionStarted) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type StageExecutionFinished struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	StageId       string                 `protobuf:"bytes,3,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Result        Execution_Result       `protobuf:"varint,6,opt,name=result,proto3,enum=Superplane.Execution_Result" json:"result,omitempty"`
+	ResultReason  Execution_ResultReason `protobuf:"varint,7,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Execution_ResultReason" json:"result_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageExecutionFinished) Reset() {
+	*x = StageExecutionFinished{}
+	mi := &file_superplane_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageExecutionFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageExecutionFinished) ProtoMessage() {}
+
+func (x *StageExecutionFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageExecutionFinished.ProtoReflect.Descriptor instead.
+func (*StageExecutionFinished) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StageExecutionFinished) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *StageExecutionFinished) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *StageExecutionFinished) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *StageExecutionFinished) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StageExecutionFinished) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *StageExecutionFinished) GetResult() Execution_Result {
+	if x != nil {
+		return x.Result
+	}
+	return Execution_RESULT_UNKNOWN
+}
+
+func (x *StageExecutionFinished) GetResultReason() Execution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return Execution_RESULT_REASON_NONE
+}
+
+type Canvas_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Canvas_Metadata) Reset() {
+	*x = Canvas_Metadata{}
+	mi := &file_superplane_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canvas_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas_Metadata) ProtoMessage() {}
+
+func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
+func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Canvas_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type EventSource_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSource_Metadata) Reset() {
+	*x = EventSource_Metadata{}
+	mi := &file_superplane_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSource_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSource_Metadata) ProtoMessage() {}
+
+func (x *EventSource_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSource_Metadata.ProtoReflect.Descriptor instead.
+func (*EventSource_Metadata) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *EventSource_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventSource_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventSource_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *EventSource_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type EventSource_Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *EventSource_Webhook   `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Schedule      *EventSource_Schedule  `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSource_Spec) Reset() {
+	*x = EventSource_Spec{}
+	mi := &file_superplane_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSource_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSource_Spec) ProtoMessage() {}
+
+func (x *EventSource_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSource_Spec.ProtoReflect.Descriptor instead.
+func (*EventSource_Spec) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *EventSource_Spec) GetWebhook() *EventSource_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *EventSource_Spec) GetSchedule() *EventSource_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type EventSource_Schedule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Cron     string                 `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	Timezone string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Payload  *_struct.Struct        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Set by Superplane, ignored when creating event sources.
+	LastFiredAt   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_fired_at,json=lastFiredAt,proto3" json:"last_fired_at,omitempty"`
+	NextFireAt    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=next_fire_at,json=nextFireAt,proto3" json:"next_fire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSource_Schedule) Reset() {
+	*x = EventSource_Schedule{}
+	mi := &file_superplane_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSource_Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSource_Schedule) ProtoMessage() {}
+
+func (x *EventSource_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSource_Schedule.ProtoReflect.Descriptor instead.
+func (*EventSource_Schedule) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *EventSource_Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *EventSource_Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *EventSource_Schedule) GetPayload() *_struct.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EventSource_Schedule) GetLastFiredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFiredAt
+	}
+	return nil
+}
+
+func (x *EventSource_Schedule) GetNextFireAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+type EventSource_Webhook struct {
+	state               protoimpl.MessageState            `protogen:"open.v1"`
+	Algorithm           EventSource_Webhook_Algorithm     `protobuf:"varint,1,opt,name=algorithm,proto3,enum=Superplane.EventSource_Webhook_Algorithm" json:"algorithm,omitempty"`
+	SignatureHeader     string                            `protobuf:"bytes,2,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	SignaturePrefix     string                            `protobuf:"bytes,3,opt,name=signature_prefix,json=signaturePrefix,proto3" json:"signature_prefix,omitempty"`
+	Encoding            EventSource_Webhook_Encoding      `protobuf:"varint,4,opt,name=encoding,proto3,enum=Superplane.EventSource_Webhook_Encoding" json:"encoding,omitempty"`
+	SignedContent       EventSource_Webhook_SignedContent `protobuf:"varint,5,opt,name=signed_content,json=signedContent,proto3,enum=Superplane.EventSource_Webhook_SignedContent" json:"signed_content,omitempty"`
+	SignedContentPrefix string                            `protobuf:"bytes,6,opt,name=signed_content_prefix,json=signedContentPrefix,proto3" json:"signed_content_prefix,omitempty"`
+	TimestampHeader     string                            `protobuf:"bytes,7,opt,name=timestamp_header,json=timestampHeader,proto3" json:"timestamp_header,omitempty"`
+	TimestampSeparator  string                            `protobuf:"bytes,8,opt,name=timestamp_separator,json=timestampSeparator,proto3" json:"timestamp_separator,omitempty"`
+	ReplayWindow        uint32                            `protobuf:"varint,9,opt,name=replay_window,json=replayWindow,proto3" json:"replay_window,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventSource_Webhook) Reset() {
+	*x = EventSource_Webhook{}
+	mi := &file_superplane_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSource_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSource_Webhook) ProtoMessage() {}
+
+func (x *EventSource_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSource_Webhook.ProtoReflect.Descriptor instead.
+func (*EventSource_Webhook) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{7, 3}
+}
+
+func (x *EventSource_Webhook) GetAlgorithm() EventSource_Webhook_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return EventSource_Webhook_ALGORITHM_HMAC_SHA256
+}
+
+func (x *EventSource_Webhook) GetSignatureHeader() string {
+	if x != nil {
+		return x.SignatureHeader
+	}
+	return ""
+}
+
+func (x *EventSource_Webhook) GetSignaturePrefix() string {
+	if x != nil {
+		return x.SignaturePrefix
+	}
+	return ""
+}
+
+func (x *EventSource_Webhook) GetEncoding() EventSource_Webhook_Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return EventSource_Webhook_ENCODING_HEX
+}
+
+func (x *EventSource_Webhook) GetSignedContent() EventSource_Webhook_SignedContent {
+	if x != nil {
+		return x.SignedContent
+	}
+	return EventSource_Webhook_SIGNED_CONTENT_BODY
+}
+
+func (x *EventSource_Webhook) GetSignedContentPrefix() string {
+	if x != nil {
+		return x.SignedContentPrefix
+	}
+	return ""
+}
+
+func (x *EventSource_Webhook) GetTimestampHeader() string {
+	if x != nil {
+		return x.TimestampHeader
+	}
+	return ""
+}
+
+func (x *EventSource_Webhook) GetTimestampSeparator() string {
+	if x != nil {
+		return x.TimestampSeparator
+	}
+	return ""
+}
+
+func (x *EventSource_Webhook) GetReplayWindow() uint32 {
+	if x != nil {
+		return x.ReplayWindow
+	}
+	return 0
+}
+
+// Local secrets are stored and managed by SuperPlane itself.
+type Secret_Local struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          map[string]string      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Local) Reset() {
+	*x = Secret_Local{}
+	mi := &file_superplane_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Local) ProtoMessage() {}
+
+func (x *Secret_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Local.ProtoReflect.Descriptor instead.
+func (*Secret_Local) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *Secret_Local) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Secret_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Metadata) Reset() {
+	*x = Secret_Metadata{}
+	mi := &file_superplane_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Metadata) ProtoMessage() {}
+
+func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Metadata.ProtoReflect.Descriptor instead.
+func (*Secret_Metadata) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *Secret_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Secret_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *Secret_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Secret_Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secret_Provider" json:"provider,omitempty"`
+	Local         *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Spec) Reset() {
+	*x = Secret_Spec{}
+	mi := &file_superplane_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Spec) ProtoMessage() {}
+
+func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Spec.ProtoReflect.Descriptor instead.
+func (*Secret_Spec) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *Secret_Spec) GetProvider() Secret_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Secret_PROVIDER_UNKNOWN
+}
+
+func (x *Secret_Spec) GetLocal() *Secret_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+type Agent_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Agent_Metadata) Reset() {
+	*x = Agent_Metadata{}
+	mi := &file_superplane_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent_Metadata) ProtoMessage() {}
+
+func (x *Agent_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent_Metadata.ProtoReflect.Descriptor instead.
+func (*Agent_Metadata) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *Agent_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Agent_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Agent_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *Agent_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Agent_Metadata) GetLastSeenAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+type Connection_Filter struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Type          Connection_FilterType    `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Connection_FilterType" json:"type,omitempty"`
+	Data          *Connection_DataFilter   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Header        *Connection_HeaderFilter `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection_Filter) Reset() {
+	*x = Connection_Filter{}
+	mi := &file_superplane_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection_Filter) ProtoMessage() {}
+
+func (x *Connection_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection_Filter.ProtoReflect.Descriptor instead.
+func (*Connection_Filter) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *Connection_Filter) GetType() Connection_FilterType {
+	if x != nil {
+		return x.Type
+	}
+	return Connection_FILTER_TYPE_UNKNOWN
+}
+
+func (x *Connection_Filter) GetData() *Connection_DataFilter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Connection_Filter) GetHeader() *Connection_HeaderFilter {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type Connection_DataFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection_DataFilter) Reset() {
+	*x = Connection_DataFilter{}
+	mi := &file_superplane_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection_DataFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection_DataFilter) ProtoMessage() {}
+
+func (x *Connection_DataFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection_DataFilter.ProtoReflect.Descriptor instead.
+func (*Connection_DataFilter) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *Connection_DataFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type Connection_HeaderFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connection_HeaderFilter) Reset() {
+	*x = Connection_HeaderFilter{}
+	mi := &file_superplane_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connection_HeaderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection_HeaderFilter) ProtoMessage() {}
+
+func (x *Connection_HeaderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection_HeaderFilter.ProtoReflect.Descriptor instead.
+func (*Connection_HeaderFilter) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{28, 2}
+}
+
+func (x *Connection_HeaderFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type Stage_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stage_Metadata) Reset() {
+	*x = Stage_Metadata{}
+	mi := &file_superplane_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stage_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stage_Metadata) ProtoMessage() {}
+
+func (x *Stage_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stage_Metadata.ProtoReflect.Descriptor instead.
+func (*Stage_Metadata) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *Stage_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Stage_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stage_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *Stage_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Stage_Spec struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Connections             []*Connection          `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	Conditions              []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Executor                *ExecutorSpec          `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	Inputs                  []*InputDefinition     `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	InputMappings           []*InputMapping        `protobuf:"bytes,5,rep,name=input_mappings,json=inputMappings,proto3" json:"input_mappings,omitempty"`
+	Outputs                 []*OutputDefinition    `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Secrets                 []*ValueDefinition     `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	MaxConcurrentExecutions uint32                 `protobuf:"varint,8,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"`
+	QueuePolicy             Stage_QueuePolicy      `protobuf:"varint,9,opt,name=queue_policy,json=queuePolicy,proto3,enum=Superplane.Stage_QueuePolicy" json:"queue_policy,omitempty"`
+	ExecutionTimeout        uint32                 `protobuf:"varint,10,opt,name=execution_timeout,json=executionTimeout,proto3" json:"execution_timeout,omitempty"`
+	RetryPolicy             *RetryPolicy           `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Stage_Spec) Reset() {
+	*x = Stage_Spec{}
+	mi := &file_superplane_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stage_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stage_Spec) ProtoMessage() {}
+
+func (x *Stage_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stage_Spec.ProtoReflect.Descriptor instead.
+func (*Stage_Spec) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *Stage_Spec) GetConnections() []*Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetExecutor() *ExecutorSpec {
+	if x != nil {
+		return x.Executor
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetInputs() []*InputDefinition {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetInputMappings() []*InputMapping {
+	if x != nil {
+		return x.InputMappings
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetOutputs() []*OutputDefinition {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetSecrets() []*ValueDefinition {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *Stage_Spec) GetMaxConcurrentExecutions() uint32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+func (x *Stage_Spec) GetQueuePolicy() Stage_QueuePolicy {
+	if x != nil {
+		return x.QueuePolicy
+	}
+	return Stage_QUEUE_POLICY_FIFO
+}
+
+func (x *Stage_Spec) GetExecutionTimeout() uint32 {
+	if x != nil {
+		return x.ExecutionTimeout
+	}
+	return 0
+}
+
+func (x *Stage_Spec) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+type InputMapping_When struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	TriggeredBy   *InputMapping_WhenTriggeredBy `protobuf:"bytes,1,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputMapping_When) Reset() {
+	*x = InputMapping_When{}
+	mi := &file_superplane_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputMapping_When) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputMapping_When) ProtoMessage() {}
+
+func (x *InputMapping_When) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputMapping_When.ProtoReflect.Descriptor instead.
+func (*InputMapping_When) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *InputMapping_When) GetTriggeredBy() *InputMapping_WhenTriggeredBy {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return nil
+}
+
+type InputMapping_WhenTriggeredBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputMapping_WhenTriggeredBy) Reset() {
+	*x = InputMapping_WhenTriggeredBy{}
+	mi := &file_superplane_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputMapping_WhenTriggeredBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputMapping_WhenTriggeredBy) ProtoMessage() {}
+
+func (x *InputMapping_WhenTriggeredBy) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputMapping_WhenTriggeredBy.ProtoReflect.Descriptor instead.
+func (*InputMapping_WhenTriggeredBy) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *InputMapping_WhenTriggeredBy) GetConnection() string {
+	if x != nil {
+		return x.Connection
+	}
+	return ""
+}
+
+type ExecutorSpec_Semaphore struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Branch          string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	PipelineFile    string                 `protobuf:"bytes,3,opt,name=pipeline_file,json=pipelineFile,proto3" json:"pipeline_file,omitempty"`
+	TaskId          string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Parameters      map[string]string      `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiToken        string                 `protobuf:"bytes,6,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	OrganizationUrl string                 `protobuf:"bytes,7,opt,name=organization_url,json=organizationUrl,proto3" json:"organization_url,omitempty"`
+	WorkflowId      string                 `protobuf:"bytes,8,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Promotion       string                 `protobuf:"bytes,9,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecutorSpec_Semaphore) Reset() {
+	*x = ExecutorSpec_Semaphore{}
+	mi := &file_superplane_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (x *Secret_Local) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[67]
+func (x *ExecutorSpec_Semaphore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutorSpec_Semaphore) ProtoMessage() {}
+
+func (x *ExecutorSpec_Semaphore) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,109 +6777,105 @@ func (x *Secret_Local) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret_Local.ProtoReflect.Descriptor instead.
-func (*Secret_Local) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use ExecutorSpec_Semaphore.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_Semaphore) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *Secret_Local) GetData() map[string]string {
+func (x *ExecutorSpec_Semaphore) GetProjectId() string {
 	if x != nil {
-		return x.Data
+		return x.ProjectId
 	}
-	return nil
-}
-
-type Secret_Metadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *Secret_Metadata) Reset() {
-	*x = Secret_Metadata{}
-	mi := &file_superplane_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExecutorSpec_Semaphore) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
 }
 
-func (x *Secret_Metadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutorSpec_Semaphore) GetPipelineFile() string {
+	if x != nil {
+		return x.PipelineFile
+	}
+	return ""
 }
 
-func (*Secret_Metadata) ProtoMessage() {}
-
-func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[68]
+func (x *ExecutorSpec_Semaphore) GetTaskId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TaskId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Secret_Metadata.ProtoReflect.Descriptor instead.
-func (*Secret_Metadata) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{12, 1}
+func (x *ExecutorSpec_Semaphore) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
 }
 
-func (x *Secret_Metadata) GetId() string {
+func (x *ExecutorSpec_Semaphore) GetApiToken() string {
 	if x != nil {
-		return x.Id
+		return x.ApiToken
 	}
 	return ""
 }
 
-func (x *Secret_Metadata) GetName() string {
+func (x *ExecutorSpec_Semaphore) GetOrganizationUrl() string {
 	if x != nil {
-		return x.Name
+		return x.OrganizationUrl
 	}
 	return ""
 }
 
-func (x *Secret_Metadata) GetCanvasId() string {
+func (x *ExecutorSpec_Semaphore) GetWorkflowId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *Secret_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *ExecutorSpec_Semaphore) GetPromotion() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Promotion
 	}
-	return nil
+	return ""
 }
 
-type Secret_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secret_Provider" json:"provider,omitempty"`
-	Local         *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExecutorSpec_HTTP struct {
+	state          protoimpl.MessageState           `protogen:"open.v1"`
+	Url            string                           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers        map[string]string                `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Payload        map[string]string                `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResponsePolicy *ExecutorSpec_HTTPResponsePolicy `protobuf:"bytes,4,opt,name=response_policy,json=responsePolicy,proto3" json:"response_policy,omitempty"`
+	Mode           ExecutorSpec_HTTPMode            `protobuf:"varint,5,opt,name=mode,proto3,enum=Superplane.ExecutorSpec_HTTPMode" json:"mode,omitempty"`
+	StatusPolicy   *ExecutorSpec_HTTPStatusPolicy   `protobuf:"bytes,6,opt,name=status_policy,json=statusPolicy,proto3" json:"status_policy,omitempty"`
+	Method         string                           `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	QueryParams    map[string]string                `protobuf:"bytes,8,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body           string                           `protobuf:"bytes,9,opt,name=body,proto3" json:"body,omitempty"`
+	Outputs        []*ExecutorSpec_HTTPOutput       `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Secret_Spec) Reset() {
-	*x = Secret_Spec{}
-	mi := &file_superplane_proto_msgTypes[69]
+func (x *ExecutorSpec_HTTP) Reset() {
+	*x = ExecutorSpec_HTTP{}
+	mi := &file_superplane_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Secret_Spec) String() string {
+func (x *ExecutorSpec_HTTP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secret_Spec) ProtoMessage() {}
+func (*ExecutorSpec_HTTP) ProtoMessage() {}
 
-func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[69]
+func (x *ExecutorSpec_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,107 +6886,104 @@ func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret_Spec.ProtoReflect.Descriptor instead.
-func (*Secret_Spec) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{12, 2}
+// Deprecated: Use ExecutorSpec_HTTP.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_HTTP) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 1}
 }
 
-func (x *Secret_Spec) GetProvider() Secret_Provider {
+func (x *ExecutorSpec_HTTP) GetUrl() string {
 	if x != nil {
-		return x.Provider
+		return x.Url
 	}
-	return Secret_PROVIDER_UNKNOWN
+	return ""
 }
 
-func (x *Secret_Spec) GetLocal() *Secret_Local {
+func (x *ExecutorSpec_HTTP) GetHeaders() map[string]string {
 	if x != nil {
-		return x.Local
+		return x.Headers
 	}
 	return nil
 }
 
-type Connection_Filter struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Type          Connection_FilterType    `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Connection_FilterType" json:"type,omitempty"`
-	Data          *Connection_DataFilter   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Header        *Connection_HeaderFilter `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExecutorSpec_HTTP) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
 }
 
-func (x *Connection_Filter) Reset() {
-	*x = Connection_Filter{}
-	mi := &file_superplane_proto_msgTypes[71]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExecutorSpec_HTTP) GetResponsePolicy() *ExecutorSpec_HTTPResponsePolicy {
+	if x != nil {
+		return x.ResponsePolicy
+	}
+	return nil
 }
 
-func (x *Connection_Filter) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutorSpec_HTTP) GetMode() ExecutorSpec_HTTPMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExecutorSpec_HTTP_MODE_SYNC
 }
 
-func (*Connection_Filter) ProtoMessage() {}
-
-func (x *Connection_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[71]
+func (x *ExecutorSpec_HTTP) GetStatusPolicy() *ExecutorSpec_HTTPStatusPolicy {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StatusPolicy
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Connection_Filter.ProtoReflect.Descriptor instead.
-func (*Connection_Filter) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 0}
+func (x *ExecutorSpec_HTTP) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
 }
 
-func (x *Connection_Filter) GetType() Connection_FilterType {
+func (x *ExecutorSpec_HTTP) GetQueryParams() map[string]string {
 	if x != nil {
-		return x.Type
+		return x.QueryParams
 	}
-	return Connection_FILTER_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *Connection_Filter) GetData() *Connection_DataFilter {
+func (x *ExecutorSpec_HTTP) GetBody() string {
 	if x != nil {
-		return x.Data
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-func (x *Connection_Filter) GetHeader() *Connection_HeaderFilter {
+func (x *ExecutorSpec_HTTP) GetOutputs() []*ExecutorSpec_HTTPOutput {
 	if x != nil {
-		return x.Header
+		return x.Outputs
 	}
 	return nil
 }
 
-type Connection_DataFilter struct {
+type ExecutorSpec_HTTPOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Connection_DataFilter) Reset() {
-	*x = Connection_DataFilter{}
-	mi := &file_superplane_proto_msgTypes[72]
+func (x *ExecutorSpec_HTTPOutput) Reset() {
+	*x = ExecutorSpec_HTTPOutput{}
+	mi := &file_superplane_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Connection_DataFilter) String() string {
+func (x *ExecutorSpec_HTTPOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connection_DataFilter) ProtoMessage() {}
+func (*ExecutorSpec_HTTPOutput) ProtoMessage() {}
 
-func (x *Connection_DataFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[72]
+func (x *ExecutorSpec_HTTPOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4526,40 +6994,47 @@ func (x *Connection_DataFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connection_DataFilter.ProtoReflect.Descriptor instead.
-func (*Connection_DataFilter) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 1}
+// Deprecated: Use ExecutorSpec_HTTPOutput.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_HTTPOutput) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 2}
 }
 
-func (x *Connection_DataFilter) GetExpression() string {
+func (x *ExecutorSpec_HTTPOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecutorSpec_HTTPOutput) GetExpression() string {
 	if x != nil {
 		return x.Expression
 	}
 	return ""
 }
 
-type Connection_HeaderFilter struct {
+type ExecutorSpec_HTTPResponsePolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	StatusCodes   []uint32               `protobuf:"varint,1,rep,packed,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Connection_HeaderFilter) Reset() {
-	*x = Connection_HeaderFilter{}
-	mi := &file_superplane_proto_msgTypes[73]
+func (x *ExecutorSpec_HTTPResponsePolicy) Reset() {
+	*x = ExecutorSpec_HTTPResponsePolicy{}
+	mi := &file_superplane_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Connection_HeaderFilter) String() string {
+func (x *ExecutorSpec_HTTPResponsePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connection_HeaderFilter) ProtoMessage() {}
+func (*ExecutorSpec_HTTPResponsePolicy) ProtoMessage() {}
 
-func (x *Connection_HeaderFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[73]
+func (x *ExecutorSpec_HTTPResponsePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4570,43 +7045,43 @@ func (x *Connection_HeaderFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connection_HeaderFilter.ProtoReflect.Descriptor instead.
-func (*Connection_HeaderFilter) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{25, 2}
+// Deprecated: Use ExecutorSpec_HTTPResponsePolicy.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_HTTPResponsePolicy) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 3}
 }
 
-func (x *Connection_HeaderFilter) GetExpression() string {
+func (x *ExecutorSpec_HTTPResponsePolicy) GetStatusCodes() []uint32 {
 	if x != nil {
-		return x.Expression
+		return x.StatusCodes
 	}
-	return ""
+	return nil
 }
 
-type Stage_Metadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExecutorSpec_HTTPStatusPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IdExpression      string                 `protobuf:"bytes,1,opt,name=id_expression,json=idExpression,proto3" json:"id_expression,omitempty"`
+	Url               string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	SuccessExpression string                 `protobuf:"bytes,3,opt,name=success_expression,json=successExpression,proto3" json:"success_expression,omitempty"`
+	FailureExpression string                 `protobuf:"bytes,4,opt,name=failure_expression,json=failureExpression,proto3" json:"failure_expression,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Stage_Metadata) Reset() {
-	*x = Stage_Metadata{}
-	mi := &file_superplane_proto_msgTypes[74]
+func (x *ExecutorSpec_HTTPStatusPolicy) Reset() {
+	*x = ExecutorSpec_HTTPStatusPolicy{}
+	mi := &file_superplane_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Stage_Metadata) String() string {
+func (x *ExecutorSpec_HTTPStatusPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stage_Metadata) ProtoMessage() {}
+func (*ExecutorSpec_HTTPStatusPolicy) ProtoMessage() {}
 
-func (x *Stage_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[74]
+func (x *ExecutorSpec_HTTPStatusPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4617,67 +7092,68 @@ func (x *Stage_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stage_Metadata.ProtoReflect.Descriptor instead.
-func (*Stage_Metadata) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{26, 0}
+// Deprecated: Use ExecutorSpec_HTTPStatusPolicy.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_HTTPStatusPolicy) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 4}
 }
 
-func (x *Stage_Metadata) GetId() string {
+func (x *ExecutorSpec_HTTPStatusPolicy) GetIdExpression() string {
 	if x != nil {
-		return x.Id
+		return x.IdExpression
 	}
 	return ""
 }
 
-func (x *Stage_Metadata) GetName() string {
+func (x *ExecutorSpec_HTTPStatusPolicy) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Stage_Metadata) GetCanvasId() string {
+func (x *ExecutorSpec_HTTPStatusPolicy) GetSuccessExpression() string {
 	if x != nil {
-		return x.CanvasId
+		return x.SuccessExpression
 	}
 	return ""
 }
 
-func (x *Stage_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *ExecutorSpec_HTTPStatusPolicy) GetFailureExpression() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.FailureExpression
 	}
-	return nil
-}
-
-type Stage_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connections   []*Connection          `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
-	Conditions    []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
-	Executor      *ExecutorSpec          `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
-	Inputs        []*InputDefinition     `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	InputMappings []*InputMapping        `protobuf:"bytes,5,rep,name=input_mappings,json=inputMappings,proto3" json:"input_mappings,omitempty"`
-	Outputs       []*OutputDefinition    `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	Secrets       []*ValueDefinition     `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *Stage_Spec) Reset() {
-	*x = Stage_Spec{}
-	mi := &file_superplane_proto_msgTypes[75]
+type ExecutorSpec_GitHub struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token           string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Owner           string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repository      string                 `protobuf:"bytes,4,opt,name=repository,proto3" json:"repository,omitempty"`
+	Workflow        string                 `protobuf:"bytes,5,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Ref             string                 `protobuf:"bytes,6,opt,name=ref,proto3" json:"ref,omitempty"`
+	Inputs          map[string]string      `protobuf:"bytes,7,rep,name=inputs,proto3" json:"inputs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExecutionInputs bool                   `protobuf:"varint,8,opt,name=execution_inputs,json=executionInputs,proto3" json:"execution_inputs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecutorSpec_GitHub) Reset() {
+	*x = ExecutorSpec_GitHub{}
+	mi := &file_superplane_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Stage_Spec) String() string {
+func (x *ExecutorSpec_GitHub) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stage_Spec) ProtoMessage() {}
+func (*ExecutorSpec_GitHub) ProtoMessage() {}
 
-func (x *Stage_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[75]
+func (x *ExecutorSpec_GitHub) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4688,82 +7164,93 @@ func (x *Stage_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stage_Spec.ProtoReflect.Descriptor instead.
-func (*Stage_Spec) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{26, 1}
+// Deprecated: Use ExecutorSpec_GitHub.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_GitHub) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 5}
 }
 
-func (x *Stage_Spec) GetConnections() []*Connection {
+func (x *ExecutorSpec_GitHub) GetUrl() string {
 	if x != nil {
-		return x.Connections
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetConditions() []*Condition {
+func (x *ExecutorSpec_GitHub) GetToken() string {
 	if x != nil {
-		return x.Conditions
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetExecutor() *ExecutorSpec {
+func (x *ExecutorSpec_GitHub) GetOwner() string {
 	if x != nil {
-		return x.Executor
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetInputs() []*InputDefinition {
+func (x *ExecutorSpec_GitHub) GetRepository() string {
 	if x != nil {
-		return x.Inputs
+		return x.Repository
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetInputMappings() []*InputMapping {
+func (x *ExecutorSpec_GitHub) GetWorkflow() string {
 	if x != nil {
-		return x.InputMappings
+		return x.Workflow
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetOutputs() []*OutputDefinition {
+func (x *ExecutorSpec_GitHub) GetRef() string {
 	if x != nil {
-		return x.Outputs
+		return x.Ref
 	}
-	return nil
+	return ""
 }
 
-func (x *Stage_Spec) GetSecrets() []*ValueDefinition {
+func (x *ExecutorSpec_GitHub) GetInputs() map[string]string {
 	if x != nil {
-		return x.Secrets
+		return x.Inputs
 	}
 	return nil
 }
 
-type InputMapping_When struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	TriggeredBy   *InputMapping_WhenTriggeredBy `protobuf:"bytes,1,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+func (x *ExecutorSpec_GitHub) GetExecutionInputs() bool {
+	if x != nil {
+		return x.ExecutionInputs
+	}
+	return false
+}
+
+type ExecutorSpec_GitLab struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Ref           string                 `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InputMapping_When) Reset() {
-	*x = InputMapping_When{}
-	mi := &file_superplane_proto_msgTypes[76]
+func (x *ExecutorSpec_GitLab) Reset() {
+	*x = ExecutorSpec_GitLab{}
+	mi := &file_superplane_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InputMapping_When) String() string {
+func (x *ExecutorSpec_GitLab) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InputMapping_When) ProtoMessage() {}
+func (*ExecutorSpec_GitLab) ProtoMessage() {}
 
-func (x *InputMapping_When) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[76]
+func (x *ExecutorSpec_GitLab) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4774,90 +7261,73 @@ func (x *InputMapping_When) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InputMapping_When.ProtoReflect.Descriptor instead.
-func (*InputMapping_When) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use ExecutorSpec_GitLab.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_GitLab) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 6}
 }
 
-func (x *InputMapping_When) GetTriggeredBy() *InputMapping_WhenTriggeredBy {
+func (x *ExecutorSpec_GitLab) GetUrl() string {
 	if x != nil {
-		return x.TriggeredBy
+		return x.Url
 	}
-	return nil
-}
-
-type InputMapping_WhenTriggeredBy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connection    string                 `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *InputMapping_WhenTriggeredBy) Reset() {
-	*x = InputMapping_WhenTriggeredBy{}
-	mi := &file_superplane_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *InputMapping_WhenTriggeredBy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutorSpec_GitLab) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (*InputMapping_WhenTriggeredBy) ProtoMessage() {}
-
-func (x *InputMapping_WhenTriggeredBy) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[77]
+func (x *ExecutorSpec_GitLab) GetProjectId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProjectId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use InputMapping_WhenTriggeredBy.ProtoReflect.Descriptor instead.
-func (*InputMapping_WhenTriggeredBy) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{29, 1}
+func (x *ExecutorSpec_GitLab) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
 }
 
-func (x *InputMapping_WhenTriggeredBy) GetConnection() string {
+func (x *ExecutorSpec_GitLab) GetVariables() map[string]string {
 	if x != nil {
-		return x.Connection
+		return x.Variables
 	}
-	return ""
+	return nil
 }
 
-type ExecutorSpec_Semaphore struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Branch          string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	PipelineFile    string                 `protobuf:"bytes,3,opt,name=pipeline_file,json=pipelineFile,proto3" json:"pipeline_file,omitempty"`
-	TaskId          string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Parameters      map[string]string      `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ApiToken        string                 `protobuf:"bytes,6,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
-	OrganizationUrl string                 `protobuf:"bytes,7,opt,name=organization_url,json=organizationUrl,proto3" json:"organization_url,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ExecutorSpec_Kubernetes struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Url                     string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token                   string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CaCertificate           string                 `protobuf:"bytes,3,opt,name=ca_certificate,json=caCertificate,proto3" json:"ca_certificate,omitempty"`
+	Namespace               string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Manifest                string                 `protobuf:"bytes,5,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	TtlSecondsAfterFinished int32                  `protobuf:"varint,6,opt,name=ttl_seconds_after_finished,json=ttlSecondsAfterFinished,proto3" json:"ttl_seconds_after_finished,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ExecutorSpec_Semaphore) Reset() {
-	*x = ExecutorSpec_Semaphore{}
-	mi := &file_superplane_proto_msgTypes[78]
+func (x *ExecutorSpec_Kubernetes) Reset() {
+	*x = ExecutorSpec_Kubernetes{}
+	mi := &file_superplane_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutorSpec_Semaphore) String() string {
+func (x *ExecutorSpec_Kubernetes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutorSpec_Semaphore) ProtoMessage() {}
+func (*ExecutorSpec_Kubernetes) ProtoMessage() {}
 
-func (x *ExecutorSpec_Semaphore) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[78]
+func (x *ExecutorSpec_Kubernetes) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4868,85 +7338,131 @@ func (x *ExecutorSpec_Semaphore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutorSpec_Semaphore.ProtoReflect.Descriptor instead.
-func (*ExecutorSpec_Semaphore) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use ExecutorSpec_Kubernetes.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_Kubernetes) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 7}
 }
 
-func (x *ExecutorSpec_Semaphore) GetProjectId() string {
+func (x *ExecutorSpec_Kubernetes) GetUrl() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *ExecutorSpec_Semaphore) GetBranch() string {
+func (x *ExecutorSpec_Kubernetes) GetToken() string {
 	if x != nil {
-		return x.Branch
+		return x.Token
 	}
 	return ""
 }
 
-func (x *ExecutorSpec_Semaphore) GetPipelineFile() string {
+func (x *ExecutorSpec_Kubernetes) GetCaCertificate() string {
 	if x != nil {
-		return x.PipelineFile
+		return x.CaCertificate
 	}
 	return ""
 }
 
-func (x *ExecutorSpec_Semaphore) GetTaskId() string {
+func (x *ExecutorSpec_Kubernetes) GetNamespace() string {
 	if x != nil {
-		return x.TaskId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ExecutorSpec_Semaphore) GetParameters() map[string]string {
+func (x *ExecutorSpec_Kubernetes) GetManifest() string {
 	if x != nil {
-		return x.Parameters
+		return x.Manifest
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecutorSpec_Semaphore) GetApiToken() string {
+func (x *ExecutorSpec_Kubernetes) GetTtlSecondsAfterFinished() int32 {
 	if x != nil {
-		return x.ApiToken
+		return x.TtlSecondsAfterFinished
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecutorSpec_Semaphore) GetOrganizationUrl() string {
+type ExecutorSpec_Plugin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config        *_struct.Struct        `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutorSpec_Plugin) Reset() {
+	*x = ExecutorSpec_Plugin{}
+	mi := &file_superplane_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutorSpec_Plugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutorSpec_Plugin) ProtoMessage() {}
+
+func (x *ExecutorSpec_Plugin) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[105]
 	if x != nil {
-		return x.OrganizationUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutorSpec_Plugin.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_Plugin) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 8}
+}
+
+func (x *ExecutorSpec_Plugin) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type ExecutorSpec_HTTP struct {
-	state          protoimpl.MessageState           `protogen:"open.v1"`
-	Url            string                           `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Headers        map[string]string                `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Payload        map[string]string                `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ResponsePolicy *ExecutorSpec_HTTPResponsePolicy `protobuf:"bytes,4,opt,name=response_policy,json=responsePolicy,proto3" json:"response_policy,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ExecutorSpec_Plugin) GetConfig() *_struct.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
-func (x *ExecutorSpec_HTTP) Reset() {
-	*x = ExecutorSpec_HTTP{}
-	mi := &file_superplane_proto_msgTypes[79]
+type ExecutorSpec_Process struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Agent            string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Command          string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Args             []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Env              map[string]string      `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorkingDirectory string                 `protobuf:"bytes,5,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExecutorSpec_Process) Reset() {
+	*x = ExecutorSpec_Process{}
+	mi := &file_superplane_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutorSpec_HTTP) String() string {
+func (x *ExecutorSpec_Process) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutorSpec_HTTP) ProtoMessage() {}
+func (*ExecutorSpec_Process) ProtoMessage() {}
 
-func (x *ExecutorSpec_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[79]
+func (x *ExecutorSpec_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4957,61 +7473,68 @@ func (x *ExecutorSpec_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutorSpec_HTTP.ProtoReflect.Descriptor instead.
-func (*ExecutorSpec_HTTP) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{39, 1}
+// Deprecated: Use ExecutorSpec_Process.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_Process) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 9}
 }
 
-func (x *ExecutorSpec_HTTP) GetUrl() string {
+func (x *ExecutorSpec_Process) GetAgent() string {
 	if x != nil {
-		return x.Url
+		return x.Agent
 	}
 	return ""
 }
 
-func (x *ExecutorSpec_HTTP) GetHeaders() map[string]string {
+func (x *ExecutorSpec_Process) GetCommand() string {
 	if x != nil {
-		return x.Headers
+		return x.Command
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecutorSpec_HTTP) GetPayload() map[string]string {
+func (x *ExecutorSpec_Process) GetArgs() []string {
 	if x != nil {
-		return x.Payload
+		return x.Args
 	}
 	return nil
 }
 
-func (x *ExecutorSpec_HTTP) GetResponsePolicy() *ExecutorSpec_HTTPResponsePolicy {
+func (x *ExecutorSpec_Process) GetEnv() map[string]string {
 	if x != nil {
-		return x.ResponsePolicy
+		return x.Env
 	}
 	return nil
 }
 
-type ExecutorSpec_HTTPResponsePolicy struct {
+func (x *ExecutorSpec_Process) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+type ExecutorSpec_Noop struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusCodes   []uint32               `protobuf:"varint,1,rep,packed,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
+	Outputs       map[string]string      `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecutorSpec_HTTPResponsePolicy) Reset() {
-	*x = ExecutorSpec_HTTPResponsePolicy{}
-	mi := &file_superplane_proto_msgTypes[80]
+func (x *ExecutorSpec_Noop) Reset() {
+	*x = ExecutorSpec_Noop{}
+	mi := &file_superplane_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutorSpec_HTTPResponsePolicy) String() string {
+func (x *ExecutorSpec_Noop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutorSpec_HTTPResponsePolicy) ProtoMessage() {}
+func (*ExecutorSpec_Noop) ProtoMessage() {}
 
-func (x *ExecutorSpec_HTTPResponsePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_superplane_proto_msgTypes[80]
+func (x *ExecutorSpec_Noop) ProtoReflect() protoreflect.Message {
+	mi := &file_superplane_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,14 +7545,14 @@ func (x *ExecutorSpec_HTTPResponsePolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutorSpec_HTTPResponsePolicy.ProtoReflect.Descriptor instead.
-func (*ExecutorSpec_HTTPResponsePolicy) Descriptor() ([]byte, []int) {
-	return file_superplane_proto_rawDescGZIP(), []int{39, 2}
+// Deprecated: Use ExecutorSpec_Noop.ProtoReflect.Descriptor instead.
+func (*ExecutorSpec_Noop) Descriptor() ([]byte, []int) {
+	return file_superplane_proto_rawDescGZIP(), []int{43, 10}
 }
 
-func (x *ExecutorSpec_HTTPResponsePolicy) GetStatusCodes() []uint32 {
+func (x *ExecutorSpec_Noop) GetOutputs() map[string]string {
 	if x != nil {
-		return x.StatusCodes
+		return x.Outputs
 	}
 	return nil
 }
@@ -5039,7 +7562,7 @@ var File_superplane_proto protoreflect.FileDescriptor
 const file_superplane_proto_rawDesc = "" +
 	"\n" +
 	"\x10superplane.proto\x12\n" +
-	"Superplane\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\">\n" +
+	"Superplane\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\">\n" +
 	"\x13ListCanvasesRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"F\n" +
 	"\x14ListCanvasesResponse\x12.\n" +
@@ -5063,7 +7586,8 @@ const file_superplane_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"D\n" +
 	"\x16DescribeCanvasResponse\x12*\n" +
-	"\x06canvas\x18\x01 \x01(\v2\x12.Superplane.CanvasR\x06canvas\"\x8e\x02\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x12.Superplane.CanvasR\x06canvas\"\xfd\n" +
+	"\n" +
 	"\vEventSource\x12<\n" +
 	"\bmetadata\x18\x01 \x01(\v2 .Superplane.EventSource.MetadataR\bmetadata\x120\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1c.Superplane.EventSource.SpecR\x04spec\x1a\x86\x01\n" +
@@ -5072,8 +7596,39 @@ const file_superplane_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
 	"\tcanvas_id\x18\x03 \x01(\tR\bcanvasId\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x06\n" +
-	"\x04Spec\"e\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x7f\n" +
+	"\x04Spec\x129\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1f.Superplane.EventSource.WebhookR\awebhook\x12<\n" +
+	"\bschedule\x18\x02 \x01(\v2 .Superplane.EventSource.ScheduleR\bschedule\x1a\xeb\x01\n" +
+	"\bSchedule\x12\x12\n" +
+	"\x04cron\x18\x01 \x01(\tR\x04cron\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x121\n" +
+	"\apayload\x18\x03 \x01(\v2\x17.google.protobuf.StructR\apayload\x12>\n" +
+	"\rlast_fired_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastFiredAt\x12<\n" +
+	"\fnext_fire_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextFireAt\x1a\x85\x06\n" +
+	"\aWebhook\x12G\n" +
+	"\talgorithm\x18\x01 \x01(\x0e2).Superplane.EventSource.Webhook.AlgorithmR\talgorithm\x12)\n" +
+	"\x10signature_header\x18\x02 \x01(\tR\x0fsignatureHeader\x12)\n" +
+	"\x10signature_prefix\x18\x03 \x01(\tR\x0fsignaturePrefix\x12D\n" +
+	"\bencoding\x18\x04 \x01(\x0e2(.Superplane.EventSource.Webhook.EncodingR\bencoding\x12T\n" +
+	"\x0esigned_content\x18\x05 \x01(\x0e2-.Superplane.EventSource.Webhook.SignedContentR\rsignedContent\x122\n" +
+	"\x15signed_content_prefix\x18\x06 \x01(\tR\x13signedContentPrefix\x12)\n" +
+	"\x10timestamp_header\x18\a \x01(\tR\x0ftimestampHeader\x12/\n" +
+	"\x13timestamp_separator\x18\b \x01(\tR\x12timestampSeparator\x12#\n" +
+	"\rreplay_window\x18\t \x01(\rR\freplayWindow\"\x85\x01\n" +
+	"\tAlgorithm\x12\x19\n" +
+	"\x15ALGORITHM_HMAC_SHA256\x10\x00\x12\x17\n" +
+	"\x13ALGORITHM_HMAC_SHA1\x10\x01\x12\x19\n" +
+	"\x15ALGORITHM_HMAC_SHA512\x10\x02\x12\x14\n" +
+	"\x10ALGORITHM_BEARER\x10\x03\x12\x13\n" +
+	"\x0fALGORITHM_BASIC\x10\x04\"1\n" +
+	"\bEncoding\x12\x10\n" +
+	"\fENCODING_HEX\x10\x00\x12\x13\n" +
+	"\x0fENCODING_BASE64\x10\x01\"O\n" +
+	"\rSignedContent\x12\x17\n" +
+	"\x13SIGNED_CONTENT_BODY\x10\x00\x12%\n" +
+	"!SIGNED_CONTENT_TIMESTAMP_AND_BODY\x10\x01\"e\n" +
 	"\x14DescribeStageRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
@@ -5136,7 +7691,24 @@ const file_superplane_proto_rawDesc = "" +
 	"\n" +
 	"id_or_name\x18\x02 \x01(\tR\bidOrName\x12!\n" +
 	"\frequester_id\x18\x03 \x01(\tR\vrequesterId\"\x16\n" +
-	"\x14DeleteSecretResponse\"k\n" +
+	"\x14DeleteSecretResponse\"\x86\x02\n" +
+	"\x05Agent\x126\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.Superplane.Agent.MetadataR\bmetadata\x1a\xc4\x01\n" +
+	"\bMetadata\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcanvas_id\x18\x03 \x01(\tR\bcanvasId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\"\x8b\x01\n" +
+	"\x12CreateAgentRequest\x12'\n" +
+	"\x05agent\x18\x01 \x01(\v2\x11.Superplane.AgentR\x05agent\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\tR\vrequesterId\x12)\n" +
+	"\x11canvas_id_or_name\x18\x03 \x01(\tR\x0ecanvasIdOrName\"T\n" +
+	"\x13CreateAgentResponse\x12'\n" +
+	"\x05agent\x18\x01 \x01(\v2\x11.Superplane.AgentR\x05agent\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"k\n" +
 	"\x1aDescribeEventSourceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
@@ -5174,7 +7746,7 @@ const file_superplane_proto_rawDesc = "" +
 	"\x12FILTER_TYPE_HEADER\x10\x02\"A\n" +
 	"\x0eFilterOperator\x12\x17\n" +
 	"\x13FILTER_OPERATOR_AND\x10\x00\x12\x16\n" +
-	"\x12FILTER_OPERATOR_OR\x10\x01\"\x89\x05\n" +
+	"\x12FILTER_OPERATOR_OR\x10\x01\"\xd0\a\n" +
 	"\x05Stage\x126\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1a.Superplane.Stage.MetadataR\bmetadata\x12*\n" +
 	"\x04spec\x18\x02 \x01(\v2\x16.Superplane.Stage.SpecR\x04spec\x1a\x86\x01\n" +
@@ -5183,7 +7755,7 @@ const file_superplane_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
 	"\tcanvas_id\x18\x03 \x01(\tR\bcanvasId\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x92\x03\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\xf9\x04\n" +
 	"\x04Spec\x128\n" +
 	"\vconnections\x18\x01 \x03(\v2\x16.Superplane.ConnectionR\vconnections\x125\n" +
 	"\n" +
@@ -5193,7 +7765,16 @@ const file_superplane_proto_rawDesc = "" +
 	"\x06inputs\x18\x04 \x03(\v2\x1b.Superplane.InputDefinitionR\x06inputs\x12?\n" +
 	"\x0einput_mappings\x18\x05 \x03(\v2\x18.Superplane.InputMappingR\rinputMappings\x126\n" +
 	"\aoutputs\x18\x06 \x03(\v2\x1c.Superplane.OutputDefinitionR\aoutputs\x125\n" +
-	"\asecrets\x18\a \x03(\v2\x1b.Superplane.ValueDefinitionR\asecrets\"d\n" +
+	"\asecrets\x18\a \x03(\v2\x1b.Superplane.ValueDefinitionR\asecrets\x12:\n" +
+	"\x19max_concurrent_executions\x18\b \x01(\rR\x17maxConcurrentExecutions\x12@\n" +
+	"\fqueue_policy\x18\t \x01(\x0e2\x1d.Superplane.Stage.QueuePolicyR\vqueuePolicy\x12+\n" +
+	"\x11execution_timeout\x18\n" +
+	" \x01(\rR\x10executionTimeout\x12:\n" +
+	"\fretry_policy\x18\v \x01(\v2\x17.Superplane.RetryPolicyR\vretryPolicy\"^\n" +
+	"\vQueuePolicy\x12\x15\n" +
+	"\x11QUEUE_POLICY_FIFO\x10\x00\x12\x17\n" +
+	"\x13QUEUE_POLICY_LATEST\x10\x01\x12\x1f\n" +
+	"\x1bQUEUE_POLICY_CANCEL_RUNNING\x10\x02\"d\n" +
 	"\x10OutputDefinition\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
@@ -5240,7 +7821,20 @@ const file_superplane_proto_rawDesc = "" +
 	"\x04Type\x12\x1a\n" +
 	"\x16CONDITION_TYPE_UNKNOWN\x10\x00\x12\x1b\n" +
 	"\x17CONDITION_TYPE_APPROVAL\x10\x01\x12\x1e\n" +
-	"\x1aCONDITION_TYPE_TIME_WINDOW\x10\x02\")\n" +
+	"\x1aCONDITION_TYPE_TIME_WINDOW\x10\x02\"\xa3\x03\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\rR\vmaxAttempts\x12\x18\n" +
+	"\abackoff\x18\x02 \x01(\rR\abackoff\x12R\n" +
+	"\x10backoff_strategy\x18\x03 \x01(\x0e2'.Superplane.RetryPolicy.BackoffStrategyR\x0fbackoffStrategy\x12>\n" +
+	"\bretry_on\x18\x04 \x03(\x0e2#.Superplane.RetryPolicy.FailureKindR\aretryOn\"O\n" +
+	"\x0fBackoffStrategy\x12\x1a\n" +
+	"\x16BACKOFF_STRATEGY_FIXED\x10\x00\x12 \n" +
+	"\x1cBACKOFF_STRATEGY_EXPONENTIAL\x10\x01\"r\n" +
+	"\vFailureKind\x12\x18\n" +
+	"\x14FAILURE_KIND_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13FAILURE_KIND_FAILED\x10\x01\x12\x18\n" +
+	"\x14FAILURE_KIND_TIMEOUT\x10\x02\x12\x16\n" +
+	"\x12FAILURE_KIND_ERROR\x10\x03\")\n" +
 	"\x11ConditionApproval\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\rR\x05count\"Z\n" +
 	"\x13ConditionTimeWindow\x12\x14\n" +
@@ -5250,11 +7844,19 @@ const file_superplane_proto_rawDesc = "" +
 	"\x12CreateStageRequest\x12'\n" +
 	"\x05stage\x18\x01 \x01(\v2\x11.Superplane.StageR\x05stage\x12!\n" +
 	"\frequester_id\x18\x02 \x01(\tR\vrequesterId\x12)\n" +
-	"\x11canvas_id_or_name\x18\x03 \x01(\tR\x0ecanvasIdOrName\"\xff\a\n" +
+	"\x11canvas_id_or_name\x18\x03 \x01(\tR\x0ecanvasIdOrName\"\x9a\x1b\n" +
 	"\fExecutorSpec\x121\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1d.Superplane.ExecutorSpec.TypeR\x04type\x12@\n" +
 	"\tsemaphore\x18\x02 \x01(\v2\".Superplane.ExecutorSpec.SemaphoreR\tsemaphore\x121\n" +
-	"\x04http\x18\x03 \x01(\v2\x1d.Superplane.ExecutorSpec.HTTPR\x04http\x1a\xdb\x02\n" +
+	"\x04http\x18\x03 \x01(\v2\x1d.Superplane.ExecutorSpec.HTTPR\x04http\x127\n" +
+	"\x06github\x18\x04 \x01(\v2\x1f.Superplane.ExecutorSpec.GitHubR\x06github\x127\n" +
+	"\x06gitlab\x18\x05 \x01(\v2\x1f.Superplane.ExecutorSpec.GitLabR\x06gitlab\x12C\n" +
+	"\n" +
+	"kubernetes\x18\x06 \x01(\v2#.Superplane.ExecutorSpec.KubernetesR\n" +
+	"kubernetes\x127\n" +
+	"\x06plugin\x18\a \x01(\v2\x1f.Superplane.ExecutorSpec.PluginR\x06plugin\x12:\n" +
+	"\aprocess\x18\b \x01(\v2 .Superplane.ExecutorSpec.ProcessR\aprocess\x121\n" +
+	"\x04noop\x18\t \x01(\v2\x1d.Superplane.ExecutorSpec.NoopR\x04noop\x1a\x9a\x03\n" +
 	"\tSemaphore\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x16\n" +
@@ -5265,27 +7867,110 @@ const file_superplane_proto_rawDesc = "" +
 	"parameters\x18\x05 \x03(\v22.Superplane.ExecutorSpec.Semaphore.ParametersEntryR\n" +
 	"parameters\x12\x1b\n" +
 	"\tapi_token\x18\x06 \x01(\tR\bapiToken\x12)\n" +
-	"\x10organization_url\x18\a \x01(\tR\x0forganizationUrl\x1a=\n" +
+	"\x10organization_url\x18\a \x01(\tR\x0forganizationUrl\x12\x1f\n" +
+	"\vworkflow_id\x18\b \x01(\tR\n" +
+	"workflowId\x12\x1c\n" +
+	"\tpromotion\x18\t \x01(\tR\tpromotion\x1a=\n" +
 	"\x0fParametersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xf2\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xf7\x05\n" +
 	"\x04HTTP\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12D\n" +
 	"\aheaders\x18\x02 \x03(\v2*.Superplane.ExecutorSpec.HTTP.HeadersEntryR\aheaders\x12D\n" +
 	"\apayload\x18\x03 \x03(\v2*.Superplane.ExecutorSpec.HTTP.PayloadEntryR\apayload\x12T\n" +
-	"\x0fresponse_policy\x18\x04 \x01(\v2+.Superplane.ExecutorSpec.HTTPResponsePolicyR\x0eresponsePolicy\x1a:\n" +
+	"\x0fresponse_policy\x18\x04 \x01(\v2+.Superplane.ExecutorSpec.HTTPResponsePolicyR\x0eresponsePolicy\x125\n" +
+	"\x04mode\x18\x05 \x01(\x0e2!.Superplane.ExecutorSpec.HTTPModeR\x04mode\x12N\n" +
+	"\rstatus_policy\x18\x06 \x01(\v2).Superplane.ExecutorSpec.HTTPStatusPolicyR\fstatusPolicy\x12\x16\n" +
+	"\x06method\x18\a \x01(\tR\x06method\x12Q\n" +
+	"\fquery_params\x18\b \x03(\v2..Superplane.ExecutorSpec.HTTP.QueryParamsEntryR\vqueryParams\x12\x12\n" +
+	"\x04body\x18\t \x01(\tR\x04body\x12=\n" +
+	"\aoutputs\x18\n" +
+	" \x03(\v2#.Superplane.ExecutorSpec.HTTPOutputR\aoutputs\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
 	"\fPayloadEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10QueryParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\n" +
+	"HTTPOutput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x1a7\n" +
 	"\x12HTTPResponsePolicy\x12!\n" +
-	"\fstatus_codes\x18\x01 \x03(\rR\vstatusCodes\";\n" +
+	"\fstatus_codes\x18\x01 \x03(\rR\vstatusCodes\x1a\xa7\x01\n" +
+	"\x10HTTPStatusPolicy\x12#\n" +
+	"\rid_expression\x18\x01 \x01(\tR\fidExpression\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12-\n" +
+	"\x12success_expression\x18\x03 \x01(\tR\x11successExpression\x12-\n" +
+	"\x12failure_expression\x18\x04 \x01(\tR\x11failureExpression\x1a\xbf\x02\n" +
+	"\x06GitHub\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x04 \x01(\tR\n" +
+	"repository\x12\x1a\n" +
+	"\bworkflow\x18\x05 \x01(\tR\bworkflow\x12\x10\n" +
+	"\x03ref\x18\x06 \x01(\tR\x03ref\x12C\n" +
+	"\x06inputs\x18\a \x03(\v2+.Superplane.ExecutorSpec.GitHub.InputsEntryR\x06inputs\x12)\n" +
+	"\x10execution_inputs\x18\b \x01(\bR\x0fexecutionInputs\x1a9\n" +
+	"\vInputsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xed\x01\n" +
+	"\x06GitLab\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x10\n" +
+	"\x03ref\x18\x04 \x01(\tR\x03ref\x12L\n" +
+	"\tvariables\x18\x05 \x03(\v2..Superplane.ExecutorSpec.GitLab.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xd2\x01\n" +
+	"\n" +
+	"Kubernetes\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12%\n" +
+	"\x0eca_certificate\x18\x03 \x01(\tR\rcaCertificate\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\bmanifest\x18\x05 \x01(\tR\bmanifest\x12;\n" +
+	"\x1attl_seconds_after_finished\x18\x06 \x01(\x05R\x17ttlSecondsAfterFinished\x1aM\n" +
+	"\x06Plugin\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06config\x1a\xef\x01\n" +
+	"\aProcess\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x12;\n" +
+	"\x03env\x18\x04 \x03(\v2).Superplane.ExecutorSpec.Process.EnvEntryR\x03env\x12+\n" +
+	"\x11working_directory\x18\x05 \x01(\tR\x10workingDirectory\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x88\x01\n" +
+	"\x04Noop\x12D\n" +
+	"\aoutputs\x18\x01 \x03(\v2*.Superplane.ExecutorSpec.Noop.OutputsEntryR\aoutputs\x1a:\n" +
+	"\fOutputsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
 	"\x04Type\x12\x10\n" +
 	"\fTYPE_UNKNOWN\x10\x00\x12\x12\n" +
 	"\x0eTYPE_SEMAPHORE\x10\x01\x12\r\n" +
-	"\tTYPE_HTTP\x10\x02\">\n" +
+	"\tTYPE_HTTP\x10\x02\x12\x0f\n" +
+	"\vTYPE_GITHUB\x10\x03\x12\x0f\n" +
+	"\vTYPE_GITLAB\x10\x04\x12\x13\n" +
+	"\x0fTYPE_KUBERNETES\x10\x05\x12\x0f\n" +
+	"\vTYPE_PLUGIN\x10\x06\x12\x10\n" +
+	"\fTYPE_PROCESS\x10\a\x12\r\n" +
+	"\tTYPE_NOOP\x10\b\"M\n" +
+	"\bHTTPMode\x12\x12\n" +
+	"\x0eHTTP_MODE_SYNC\x10\x00\x12\x15\n" +
+	"\x11HTTP_MODE_POLLING\x10\x01\x12\x16\n" +
+	"\x12HTTP_MODE_CALLBACK\x10\x02\">\n" +
 	"\x13CreateStageResponse\x12'\n" +
 	"\x05stage\x18\x01 \x01(\v2\x11.Superplane.StageR\x05stage\"\xa9\x01\n" +
 	"\x12UpdateStageRequest\x12'\n" +
@@ -5343,7 +8028,7 @@ const file_superplane_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"7\n" +
 	"\vOutputValue\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\xa7\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xef\x06\n" +
 	"\tExecution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x121\n" +
@@ -5355,16 +8040,26 @@ const file_superplane_proto_rawDesc = "" +
 	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
 	"\vfinished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"finishedAt\x121\n" +
-	"\aoutputs\x18\b \x03(\v2\x17.Superplane.OutputValueR\aoutputs\"T\n" +
+	"\aoutputs\x18\b \x03(\v2\x17.Superplane.OutputValueR\aoutputs\x12G\n" +
+	"\rresult_reason\x18\t \x01(\x0e2\".Superplane.Execution.ResultReasonR\fresultReason\x12\x18\n" +
+	"\aattempt\x18\n" +
+	" \x01(\rR\aattempt\x122\n" +
+	"\x15previous_execution_id\x18\v \x01(\tR\x13previousExecutionId\x12=\n" +
+	"\fscheduled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"T\n" +
 	"\x05State\x12\x11\n" +
 	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rSTATE_PENDING\x10\x01\x12\x11\n" +
 	"\rSTATE_STARTED\x10\x02\x12\x12\n" +
-	"\x0eSTATE_FINISHED\x10\x04\"B\n" +
+	"\x0eSTATE_FINISHED\x10\x04\"X\n" +
 	"\x06Result\x12\x12\n" +
 	"\x0eRESULT_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rRESULT_PASSED\x10\x01\x12\x11\n" +
-	"\rRESULT_FAILED\x10\x02\"r\n" +
+	"\rRESULT_FAILED\x10\x02\x12\x14\n" +
+	"\x10RESULT_CANCELLED\x10\x03\"Z\n" +
+	"\fResultReason\x12\x16\n" +
+	"\x12RESULT_REASON_NONE\x10\x00\x12\x19\n" +
+	"\x15RESULT_REASON_TIMEOUT\x10\x01\x12\x17\n" +
+	"\x13RESULT_REASON_ERROR\x10\x02\"r\n" +
 	"\x12StageEventApproval\x12\x1f\n" +
 	"\vapproved_by\x18\x01 \x01(\tR\n" +
 	"approvedBy\x12;\n" +
@@ -5376,7 +8071,79 @@ const file_superplane_proto_rawDesc = "" +
 	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12!\n" +
 	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"I\n" +
 	"\x19ApproveStageEventResponse\x12,\n" +
-	"\x05event\x18\x01 \x01(\v2\x16.Superplane.StageEventR\x05event\"\x80\x01\n" +
+	"\x05event\x18\x01 \x01(\v2\x16.Superplane.StageEventR\x05event\"\xb2\x01\n" +
+	"\x16CancelExecutionRequest\x12'\n" +
+	"\x10stage_id_or_name\x18\x01 \x01(\tR\rstageIdOrName\x12)\n" +
+	"\x11canvas_id_or_name\x18\x02 \x01(\tR\x0ecanvasIdOrName\x12!\n" +
+	"\fexecution_id\x18\x03 \x01(\tR\vexecutionId\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"N\n" +
+	"\x17CancelExecutionResponse\x123\n" +
+	"\texecution\x18\x01 \x01(\v2\x15.Superplane.ExecutionR\texecution\"\xb1\x01\n" +
+	"\x15RetryExecutionRequest\x12'\n" +
+	"\x10stage_id_or_name\x18\x01 \x01(\tR\rstageIdOrName\x12)\n" +
+	"\x11canvas_id_or_name\x18\x02 \x01(\tR\x0ecanvasIdOrName\x12!\n" +
+	"\fexecution_id\x18\x03 \x01(\tR\vexecutionId\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"M\n" +
+	"\x16RetryExecutionResponse\x123\n" +
+	"\texecution\x18\x01 \x01(\v2\x15.Superplane.ExecutionR\texecution\"\x83\x04\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12\x1f\n" +
+	"\vsource_name\x18\x03 \x01(\tR\n" +
+	"sourceName\x12-\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x17.Superplane.Event.StateR\x05state\x12;\n" +
+	"\vreceived_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x121\n" +
+	"\apayload\x18\x06 \x01(\v2\x17.google.protobuf.StructR\apayload\x128\n" +
+	"\aheaders\x18\a \x03(\v2\x1e.Superplane.Event.HeadersEntryR\aheaders\x12!\n" +
+	"\ftriggered_by\x18\b \x01(\tR\vtriggeredBy\x12\x1b\n" +
+	"\treplay_of\x18\t \x01(\tR\breplayOf\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x05State\x12\x11\n" +
+	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x13\n" +
+	"\x0fSTATE_PROCESSED\x10\x02\x12\x13\n" +
+	"\x0fSTATE_DISCARDED\x10\x03\"\xab\x02\n" +
+	"\x12CreateEventRequest\x12)\n" +
+	"\x11canvas_id_or_name\x18\x01 \x01(\tR\x0ecanvasIdOrName\x124\n" +
+	"\x17event_source_id_or_name\x18\x02 \x01(\tR\x13eventSourceIdOrName\x121\n" +
+	"\apayload\x18\x03 \x01(\v2\x17.google.protobuf.StructR\apayload\x12E\n" +
+	"\aheaders\x18\x04 \x03(\v2+.Superplane.CreateEventRequest.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x13CreateEventResponse\x12'\n" +
+	"\x05event\x18\x01 \x01(\v2\x11.Superplane.EventR\x05event\"\xad\x01\n" +
+	"\x12ReplayEventRequest\x12)\n" +
+	"\x11canvas_id_or_name\x18\x01 \x01(\tR\x0ecanvasIdOrName\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12'\n" +
+	"\x10stage_id_or_name\x18\x03 \x01(\tR\rstageIdOrName\x12(\n" +
+	"\x10use_current_spec\x18\x04 \x01(\bR\x0euseCurrentSpec\">\n" +
+	"\x13ReplayEventResponse\x12'\n" +
+	"\x05event\x18\x01 \x01(\v2\x11.Superplane.EventR\x05event\"\xc3\x01\n" +
+	"\x17GetExecutionLogsRequest\x12'\n" +
+	"\x10stage_id_or_name\x18\x01 \x01(\tR\rstageIdOrName\x12)\n" +
+	"\x11canvas_id_or_name\x18\x02 \x01(\tR\x0ecanvasIdOrName\x12!\n" +
+	"\fexecution_id\x18\x03 \x01(\tR\vexecutionId\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\x03R\aafterId\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\bR\x06follow\"}\n" +
+	"\x18GetExecutionLogsResponse\x12,\n" +
+	"\x04logs\x18\x01 \x03(\v2\x18.Superplane.ExecutionLogR\x04logs\x12\x17\n" +
+	"\alast_id\x18\x02 \x01(\x03R\x06lastId\x12\x1a\n" +
+	"\bfinished\x18\x03 \x01(\bR\bfinished\"\x83\x02\n" +
+	"\fExecutionLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\x06stream\x18\x02 \x01(\x0e2\x1f.Superplane.ExecutionLog.StreamR\x06stream\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"U\n" +
+	"\x06Stream\x12\x12\n" +
+	"\x0eSTREAM_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTREAM_STDOUT\x10\x01\x12\x11\n" +
+	"\rSTREAM_STDERR\x10\x02\x12\x11\n" +
+	"\rSTREAM_SYSTEM\x10\x03\"\x80\x01\n" +
 	"\fStageCreated\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
 	"\bstage_id\x18\x02 \x01(\tR\astageId\x128\n" +
@@ -5412,13 +8179,15 @@ const file_superplane_proto_rawDesc = "" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x19\n" +
 	"\bstage_id\x18\x03 \x01(\tR\astageId\x12\x19\n" +
 	"\bevent_id\x18\x04 \x01(\tR\aeventId\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc8\x01\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc7\x02\n" +
 	"\x16StageExecutionFinished\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x19\n" +
 	"\bstage_id\x18\x03 \x01(\tR\astageId\x12\x19\n" +
 	"\bevent_id\x18\x04 \x01(\tR\aeventId\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb0!\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x124\n" +
+	"\x06result\x18\x06 \x01(\x0e2\x1c.Superplane.Execution.ResultR\x06result\x12G\n" +
+	"\rresult_reason\x18\a \x01(\x0e2\".Superplane.Execution.ResultReasonR\fresultReason2\x823\n" +
 	"\n" +
 	"Superplane\x12\xa5\x01\n" +
 	"\fListCanvases\x12\x1f.Superplane.ListCanvasesRequest\x1a .Superplane.ListCanvasesResponse\"R\x92A7\n" +
@@ -5453,9 +8222,21 @@ const file_superplane_proto_rawDesc = "" +
 	"\fUpdateSecret\x12\x1f.Superplane.UpdateSecretRequest\x1a .Superplane.UpdateSecretResponse\"\xa1\x01\x92AZ\n" +
 	"\x06Secret\x12\x10Updates a secret\x1a>Updates the specified secret (can be referenced by ID or name)\x82\xd3\xe4\x93\x02>:\x01*29/api/v1/canvases/{canvas_id_or_name}/secrets/{id_or_name}\x12\xb4\x02\n" +
 	"\x11ApproveStageEvent\x12$.Superplane.ApproveStageEventRequest\x1a%.Superplane.ApproveStageEventResponse\"\xd1\x01\x92Ak\n" +
-	"\x05Event\x12\x15Approve a stage event\x1aKApproves the specified stage event (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02]:\x01*\"X/api/v1/canvases/{canvas_id_or_name}/stages/{stage_id_or_name}/events/{event_id}/approve\x12\xde\x01\n" +
+	"\x05Event\x12\x15Approve a stage event\x1aKApproves the specified stage event (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02]:\x01*\"X/api/v1/canvases/{canvas_id_or_name}/stages/{stage_id_or_name}/events/{event_id}/approve\x12\xe5\x02\n" +
+	"\x0fCancelExecution\x12\".Superplane.CancelExecutionRequest\x1a#.Superplane.CancelExecutionResponse\"\x88\x02\x92A\x9a\x01\n" +
+	"\x05Stage\x12\x18Cancel a stage execution\x1awCancels the specified stage execution, stopping it in the executor if possible (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02d:\x01*\"_/api/v1/canvases/{canvas_id_or_name}/stages/{stage_id_or_name}/executions/{execution_id}/cancel\x12\xe7\x02\n" +
+	"\x0eRetryExecution\x12!.Superplane.RetryExecutionRequest\x1a\".Superplane.RetryExecutionResponse\"\x8d\x02\x92A\xa0\x01\n" +
+	"\x05Stage\x12\x17Retry a stage execution\x1a~Creates a new attempt for a finished stage execution, using the same event and inputs (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02c:\x01*\"^/api/v1/canvases/{canvas_id_or_name}/stages/{stage_id_or_name}/executions/{execution_id}/retry\x12\xaa\x03\n" +
+	"\x10GetExecutionLogs\x12#.Superplane.GetExecutionLogsRequest\x1a$.Superplane.GetExecutionLogsResponse\"\xca\x02\x92A\xe1\x01\n" +
+	"\x05Stage\x12!Get the logs of a stage execution\x1a\xb4\x01Returns the logs of the specified stage execution after the given log ID. In follow mode, waits for new logs while the execution is running (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02_\x12]/api/v1/canvases/{canvas_id_or_name}/stages/{stage_id_or_name}/executions/{execution_id}/logs\x12\xde\x01\n" +
 	"\fDeleteSecret\x12\x1f.Superplane.DeleteSecretRequest\x1a .Superplane.DeleteSecretResponse\"\x8a\x01\x92AF\n" +
-	"\x06Secret\x12\x17Deletes a canvas secret\x1a#Deletes the specified canvas secret\x82\xd3\xe4\x93\x02;*9/api/v1/canvases/{canvas_id_or_name}/secrets/{id_or_name}B\xc4\x01\x92A\x86\x01\x12\\\n" +
+	"\x06Secret\x12\x17Deletes a canvas secret\x1a#Deletes the specified canvas secret\x82\xd3\xe4\x93\x02;*9/api/v1/canvases/{canvas_id_or_name}/secrets/{id_or_name}\x12\xea\x02\n" +
+	"\vCreateEvent\x12\x1e.Superplane.CreateEventRequest\x1a\x1f.Superplane.CreateEventResponse\"\x99\x02\x92A\xb7\x01\n" +
+	"\x05Event\x12\rEmit an event\x1a\x9e\x01Emits an event from the specified event source, as if it was received through its webhook, and records who emitted it (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02X:\x01*\"S/api/v1/canvases/{canvas_id_or_name}/event-sources/{event_source_id_or_name}/events\x12\xe4\x03\n" +
+	"\vReplayEvent\x12\x1e.Superplane.ReplayEventRequest\x1a\x1f.Superplane.ReplayEventResponse\"\x93\x03\x92A\xc7\x02\n" +
+	"\x05Event\x12\x0fReplay an event\x1a\xac\x02Processes a stored event again, sending it to all connected stages or to a specific one. By default, the event is re-delivered to the stages that received it with the same inputs; use_current_spec uses the current connection filters and input mappings instead (canvas can be referenced by ID or name)\x82\xd3\xe4\x93\x02B:\x01*\"=/api/v1/canvases/{canvas_id_or_name}/events/{event_id}/replay\x12\xfc\x01\n" +
+	"\vCreateAgent\x12\x1e.Superplane.CreateAgentRequest\x1a\x1f.Superplane.CreateAgentResponse\"\xab\x01\x92Ar\n" +
+	"\x05Agent\x12\x14Register a new agent\x1aSRegisters a new agent for the canvas, returning the token the agent uses to connect\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/canvases/{canvas_id_or_name}/agentsB\xc4\x01\x92A\x86\x01\x12\\\n" +
 	"\x0eSuperplane API\x12\x1eAPI for the Superplane service\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ8github.com/superplanehq/superplane/pkg/protos/superplaneb\x06proto3"
 
@@ -5471,236 +8252,342 @@ func file_superplane_proto_rawDescGZIP() []byte {
 	return file_superplane_proto_rawDescData
 }
 
-var file_superplane_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_superplane_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_superplane_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_superplane_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
 var file_superplane_proto_goTypes = []any{
-	(Secret_Provider)(0),                    // 0: Superplane.Secret.Provider
-	(Connection_Type)(0),                    // 1: Superplane.Connection.Type
-	(Connection_FilterType)(0),              // 2: Superplane.Connection.FilterType
-	(Connection_FilterOperator)(0),          // 3: Superplane.Connection.FilterOperator
-	(Condition_Type)(0),                     // 4: Superplane.Condition.Type
-	(ExecutorSpec_Type)(0),                  // 5: Superplane.ExecutorSpec.Type
-	(StageEvent_State)(0),                   // 6: Superplane.StageEvent.State
-	(StageEvent_StateReason)(0),             // 7: Superplane.StageEvent.StateReason
-	(Execution_State)(0),                    // 8: Superplane.Execution.State
-	(Execution_Result)(0),                   // 9: Superplane.Execution.Result
-	(*ListCanvasesRequest)(nil),             // 10: Superplane.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),            // 11: Superplane.ListCanvasesResponse
-	(*Canvas)(nil),                          // 12: Superplane.Canvas
-	(*CreateCanvasRequest)(nil),             // 13: Superplane.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),            // 14: Superplane.CreateCanvasResponse
-	(*DescribeCanvasRequest)(nil),           // 15: Superplane.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),          // 16: Superplane.DescribeCanvasResponse
-	(*EventSource)(nil),                     // 17: Superplane.EventSource
-	(*DescribeStageRequest)(nil),            // 18: Superplane.DescribeStageRequest
-	(*DescribeStageResponse)(nil),           // 19: Superplane.DescribeStageResponse
-	(*CreateEventSourceRequest)(nil),        // 20: Superplane.CreateEventSourceRequest
-	(*CreateEventSourceResponse)(nil),       // 21: Superplane.CreateEventSourceResponse
-	(*Secret)(nil),                          // 22: Superplane.Secret
-	(*CreateSecretRequest)(nil),             // 23: Superplane.CreateSecretRequest
-	(*CreateSecretResponse)(nil),            // 24: Superplane.CreateSecretResponse
-	(*UpdateSecretRequest)(nil),             // 25: Superplane.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),            // 26: Superplane.UpdateSecretResponse
-	(*DescribeSecretRequest)(nil),           // 27: Superplane.DescribeSecretRequest
-	(*DescribeSecretResponse)(nil),          // 28: Superplane.DescribeSecretResponse
-	(*ListSecretsRequest)(nil),              // 29: Superplane.ListSecretsRequest
-	(*ListSecretsResponse)(nil),             // 30: Superplane.ListSecretsResponse
-	(*DeleteSecretRequest)(nil),             // 31: Superplane.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),            // 32: Superplane.DeleteSecretResponse
-	(*DescribeEventSourceRequest)(nil),      // 33: Superplane.DescribeEventSourceRequest
-	(*DescribeEventSourceResponse)(nil),     // 34: Superplane.DescribeEventSourceResponse
-	(*Connection)(nil),                      // 35: Superplane.Connection
-	(*Stage)(nil),                           // 36: Superplane.Stage
-	(*OutputDefinition)(nil),                // 37: Superplane.OutputDefinition
-	(*InputDefinition)(nil),                 // 38: Superplane.InputDefinition
-	(*InputMapping)(nil),                    // 39: Superplane.InputMapping
-	(*ValueDefinition)(nil),                 // 40: Superplane.ValueDefinition
-	(*ValueFrom)(nil),                       // 41: Superplane.ValueFrom
-	(*ValueFromEventData)(nil),              // 42: Superplane.ValueFromEventData
-	(*ValueFromLastExecution)(nil),          // 43: Superplane.ValueFromLastExecution
-	(*ValueFromSecret)(nil),                 // 44: Superplane.ValueFromSecret
-	(*Condition)(nil),                       // 45: Superplane.Condition
-	(*ConditionApproval)(nil),               // 46: Superplane.ConditionApproval
-	(*ConditionTimeWindow)(nil),             // 47: Superplane.ConditionTimeWindow
-	(*CreateStageRequest)(nil),              // 48: Superplane.CreateStageRequest
-	(*ExecutorSpec)(nil),                    // 49: Superplane.ExecutorSpec
-	(*CreateStageResponse)(nil),             // 50: Superplane.CreateStageResponse
-	(*UpdateStageRequest)(nil),              // 51: Superplane.UpdateStageRequest
-	(*UpdateStageResponse)(nil),             // 52: Superplane.UpdateStageResponse
-	(*ListStagesRequest)(nil),               // 53: Superplane.ListStagesRequest
-	(*ListStagesResponse)(nil),              // 54: Superplane.ListStagesResponse
-	(*ListEventSourcesRequest)(nil),         // 55: Superplane.ListEventSourcesRequest
-	(*ListEventSourcesResponse)(nil),        // 56: Superplane.ListEventSourcesResponse
-	(*ListStageEventsRequest)(nil),          // 57: Superplane.ListStageEventsRequest
-	(*ListStageEventsResponse)(nil),         // 58: Superplane.ListStageEventsResponse
-	(*StageEvent)(nil),                      // 59: Superplane.StageEvent
-	(*InputValue)(nil),                      // 60: Superplane.InputValue
-	(*OutputValue)(nil),                     // 61: Superplane.OutputValue
-	(*Execution)(nil),                       // 62: Superplane.Execution
-	(*StageEventApproval)(nil),              // 63: Superplane.StageEventApproval
-	(*ApproveStageEventRequest)(nil),        // 64: Superplane.ApproveStageEventRequest
-	(*ApproveStageEventResponse)(nil),       // 65: Superplane.ApproveStageEventResponse
-	(*StageCreated)(nil),                    // 66: Superplane.StageCreated
-	(*StageUpdated)(nil),                    // 67: Superplane.StageUpdated
-	(*EventSourceCreated)(nil),              // 68: Superplane.EventSourceCreated
-	(*StageEventCreated)(nil),               // 69: Superplane.StageEventCreated
-	(*StageEventApproved)(nil),              // 70: Superplane.StageEventApproved
-	(*StageExecutionCreated)(nil),           // 71: Superplane.StageExecutionCreated
-	(*StageExecutionStarted)(nil),           // 72: Superplane.StageExecutionStarted
-	(*StageExecutionFinished)(nil),          // 73: Superplane.StageExecutionFinished
-	(*Canvas_Metadata)(nil),                 // 74: Superplane.Canvas.Metadata
-	(*EventSource_Metadata)(nil),            // 75: Superplane.EventSource.Metadata
-	(*EventSource_Spec)(nil),                // 76: Superplane.EventSource.Spec
-	(*Secret_Local)(nil),                    // 77: Superplane.Secret.Local
-	(*Secret_Metadata)(nil),                 // 78: Superplane.Secret.Metadata
-	(*Secret_Spec)(nil),                     // 79: Superplane.Secret.Spec
-	nil,                                     // 80: Superplane.Secret.Local.DataEntry
-	(*Connection_Filter)(nil),               // 81: Superplane.Connection.Filter
-	(*Connection_DataFilter)(nil),           // 82: Superplane.Connection.DataFilter
-	(*Connection_HeaderFilter)(nil),         // 83: Superplane.Connection.HeaderFilter
-	(*Stage_Metadata)(nil),                  // 84: Superplane.Stage.Metadata
-	(*Stage_Spec)(nil),                      // 85: Superplane.Stage.Spec
-	(*InputMapping_When)(nil),               // 86: Superplane.InputMapping.When
-	(*InputMapping_WhenTriggeredBy)(nil),    // 87: Superplane.InputMapping.WhenTriggeredBy
-	(*ExecutorSpec_Semaphore)(nil),          // 88: Superplane.ExecutorSpec.Semaphore
-	(*ExecutorSpec_HTTP)(nil),               // 89: Superplane.ExecutorSpec.HTTP
-	(*ExecutorSpec_HTTPResponsePolicy)(nil), // 90: Superplane.ExecutorSpec.HTTPResponsePolicy
-	nil,                                     // 91: Superplane.ExecutorSpec.Semaphore.ParametersEntry
-	nil,                                     // 92: Superplane.ExecutorSpec.HTTP.HeadersEntry
-	nil,                                     // 93: Superplane.ExecutorSpec.HTTP.PayloadEntry
-	(*timestamp.Timestamp)(nil),             // 94: google.protobuf.Timestamp
+	(EventSource_Webhook_Algorithm)(0),      // 0: Superplane.EventSource.Webhook.Algorithm
+	(EventSource_Webhook_Encoding)(0),       // 1: Superplane.EventSource.Webhook.Encoding
+	(EventSource_Webhook_SignedContent)(0),  // 2: Superplane.EventSource.Webhook.SignedContent
+	(Secret_Provider)(0),                    // 3: Superplane.Secret.Provider
+	(Connection_Type)(0),                    // 4: Superplane.Connection.Type
+	(Connection_FilterType)(0),              // 5: Superplane.Connection.FilterType
+	(Connection_FilterOperator)(0),          // 6: Superplane.Connection.FilterOperator
+	(Stage_QueuePolicy)(0),                  // 7: Superplane.Stage.QueuePolicy
+	(Condition_Type)(0),                     // 8: Superplane.Condition.Type
+	(RetryPolicy_BackoffStrategy)(0),        // 9: Superplane.RetryPolicy.BackoffStrategy
+	(RetryPolicy_FailureKind)(0),            // 10: Superplane.RetryPolicy.FailureKind
+	(ExecutorSpec_Type)(0),                  // 11: Superplane.ExecutorSpec.Type
+	(ExecutorSpec_HTTPMode)(0),              // 12: Superplane.ExecutorSpec.HTTPMode
+	(StageEvent_State)(0),                   // 13: Superplane.StageEvent.State
+	(StageEvent_StateReason)(0),             // 14: Superplane.StageEvent.StateReason
+	(Execution_State)(0),                    // 15: Superplane.Execution.State
+	(Execution_Result)(0),                   // 16: Superplane.Execution.Result
+	(Execution_ResultReason)(0),             // 17: Superplane.Execution.ResultReason
+	(Event_State)(0),                        // 18: Superplane.Event.State
+	(ExecutionLog_Stream)(0),                // 19: Superplane.ExecutionLog.Stream
+	(*ListCanvasesRequest)(nil),             // 20: Superplane.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),            // 21: Superplane.ListCanvasesResponse
+	(*Canvas)(nil),                          // 22: Superplane.Canvas
+	(*CreateCanvasRequest)(nil),             // 23: Superplane.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),            // 24: Superplane.CreateCanvasResponse
+	(*DescribeCanvasRequest)(nil),           // 25: Superplane.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),          // 26: Superplane.DescribeCanvasResponse
+	(*EventSource)(nil),                     // 27: Superplane.EventSource
+	(*DescribeStageRequest)(nil),            // 28: Superplane.DescribeStageRequest
+	(*DescribeStageResponse)(nil),           // 29: Superplane.DescribeStageResponse
+	(*CreateEventSourceRequest)(nil),        // 30: Superplane.CreateEventSourceRequest
+	(*CreateEventSourceResponse)(nil),       // 31: Superplane.CreateEventSourceResponse
+	(*Secret)(nil),                          // 32: Superplane.Secret
+	(*CreateSecretRequest)(nil),             // 33: Superplane.CreateSecretRequest
+	(*CreateSecretResponse)(nil),            // 34: Superplane.CreateSecretResponse
+	(*UpdateSecretRequest)(nil),             // 35: Superplane.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),            // 36: Superplane.UpdateSecretResponse
+	(*DescribeSecretRequest)(nil),           // 37: Superplane.DescribeSecretRequest
+	(*DescribeSecretResponse)(nil),          // 38: Superplane.DescribeSecretResponse
+	(*ListSecretsRequest)(nil),              // 39: Superplane.ListSecretsRequest
+	(*ListSecretsResponse)(nil),             // 40: Superplane.ListSecretsResponse
+	(*DeleteSecretRequest)(nil),             // 41: Superplane.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),            // 42: Superplane.DeleteSecretResponse
+	(*Agent)(nil),                           // 43: Superplane.Agent
+	(*CreateAgentRequest)(nil),              // 44: Superplane.CreateAgentRequest
+	(*CreateAgentResponse)(nil),             // 45: Superplane.CreateAgentResponse
+	(*DescribeEventSourceRequest)(nil),      // 46: Superplane.DescribeEventSourceRequest
+	(*DescribeEventSourceResponse)(nil),     // 47: Superplane.DescribeEventSourceResponse
+	(*Connection)(nil),                      // 48: Superplane.Connection
+	(*Stage)(nil),                           // 49: Superplane.Stage
+	(*OutputDefinition)(nil),                // 50: Superplane.OutputDefinition
+	(*InputDefinition)(nil),                 // 51: Superplane.InputDefinition
+	(*InputMapping)(nil),                    // 52: Superplane.InputMapping
+	(*ValueDefinition)(nil),                 // 53: Superplane.ValueDefinition
+	(*ValueFrom)(nil),                       // 54: Superplane.ValueFrom
+	(*ValueFromEventData)(nil),              // 55: Superplane.ValueFromEventData
+	(*ValueFromLastExecution)(nil),          // 56: Superplane.ValueFromLastExecution
+	(*ValueFromSecret)(nil),                 // 57: Superplane.ValueFromSecret
+	(*Condition)(nil),                       // 58: Superplane.Condition
+	(*RetryPolicy)(nil),                     // 59: Superplane.RetryPolicy
+	(*ConditionApproval)(nil),               // 60: Superplane.ConditionApproval
+	(*ConditionTimeWindow)(nil),             // 61: Superplane.ConditionTimeWindow
+	(*CreateStageRequest)(nil),              // 62: Superplane.CreateStageRequest
+	(*ExecutorSpec)(nil),                    // 63: Superplane.ExecutorSpec
+	(*CreateStageResponse)(nil),             // 64: Superplane.CreateStageResponse
+	(*UpdateStageRequest)(nil),              // 65: Superplane.UpdateStageRequest
+	(*UpdateStageResponse)(nil),             // 66: Superplane.UpdateStageResponse
+	(*ListStagesRequest)(nil),               // 67: Superplane.ListStagesRequest
+	(*ListStagesResponse)(nil),              // 68: Superplane.ListStagesResponse
+	(*ListEventSourcesRequest)(nil),         // 69: Superplane.ListEventSourcesRequest
+	(*ListEventSourcesResponse)(nil),        // 70: Superplane.ListEventSourcesResponse
+	(*ListStageEventsRequest)(nil),          // 71: Superplane.ListStageEventsRequest
+	(*ListStageEventsResponse)(nil),         // 72: Superplane.ListStageEventsResponse
+	(*StageEvent)(nil),                      // 73: Superplane.StageEvent
+	(*InputValue)(nil),                      // 74: Superplane.InputValue
+	(*OutputValue)(nil),                     // 75: Superplane.OutputValue
+	(*Execution)(nil),                       // 76: Superplane.Execution
+	(*StageEventApproval)(nil),              // 77: Superplane.StageEventApproval
+	(*ApproveStageEventRequest)(nil),        // 78: Superplane.ApproveStageEventRequest
+	(*ApproveStageEventResponse)(nil),       // 79: Superplane.ApproveStageEventResponse
+	(*CancelExecutionRequest)(nil),          // 80: Superplane.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),         // 81: Superplane.CancelExecutionResponse
+	(*RetryExecutionRequest)(nil),           // 82: Superplane.RetryExecutionRequest
+	(*RetryExecutionResponse)(nil),          // 83: Superplane.RetryExecutionResponse
+	(*Event)(nil),                           // 84: Superplane.Event
+	(*CreateEventRequest)(nil),              // 85: Superplane.CreateEventRequest
+	(*CreateEventResponse)(nil),             // 86: Superplane.CreateEventResponse
+	(*ReplayEventRequest)(nil),              // 87: Superplane.ReplayEventRequest
+	(*ReplayEventResponse)(nil),             // 88: Superplane.ReplayEventResponse
+	(*GetExecutionLogsRequest)(nil),         // 89: Superplane.GetExecutionLogsRequest
+	(*GetExecutionLogsResponse)(nil),        // 90: Superplane.GetExecutionLogsResponse
+	(*ExecutionLog)(nil),                    // 91: Superplane.ExecutionLog
+	(*StageCreated)(nil),                    // 92: Superplane.StageCreated
+	(*StageUpdated)(nil),                    // 93: Superplane.StageUpdated
+	(*EventSourceCreated)(nil),              // 94: Superplane.EventSourceCreated
+	(*StageEventCreated)(nil),               // 95: Superplane.StageEventCreated
+	(*StageEventApproved)(nil),              // 96: Superplane.StageEventApproved
+	(*StageExecutionCreated)(nil),           // 97: Superplane.StageExecutionCreated
+	(*StageExecutionStarted)(nil),           // 98: Superplane.StageExecutionStarted
+	(*StageExecutionFinished)(nil),          // 99: Superplane.StageExecutionFinished
+	(*Canvas_Metadata)(nil),                 // 100: Superplane.Canvas.Metadata
+	(*EventSource_Metadata)(nil),            // 101: Superplane.EventSource.Metadata
+	(*EventSource_Spec)(nil),                // 102: Superplane.EventSource.Spec
+	(*EventSource_Schedule)(nil),            // 103: Superplane.EventSource.Schedule
+	(*EventSource_Webhook)(nil),             // 104: Superplane.EventSource.Webhook
+	(*Secret_Local)(nil),                    // 105: Superplane.Secret.Local
+	(*Secret_Metadata)(nil),                 // 106: Superplane.Secret.Metadata
+	(*Secret_Spec)(nil),                     // 107: Superplane.Secret.Spec
+	nil,                                     // 108: Superplane.Secret.Local.DataEntry
+	(*Agent_Metadata)(nil),                  // 109: Superplane.Agent.Metadata
+	(*Connection_Filter)(nil),               // 110: Superplane.Connection.Filter
+	(*Connection_DataFilter)(nil),           // 111: Superplane.Connection.DataFilter
+	(*Connection_HeaderFilter)(nil),         // 112: Superplane.Connection.HeaderFilter
+	(*Stage_Metadata)(nil),                  // 113: Superplane.Stage.Metadata
+	(*Stage_Spec)(nil),                      // 114: Superplane.Stage.Spec
+	(*InputMapping_When)(nil),               // 115: Superplane.InputMapping.When
+	(*InputMapping_WhenTriggeredBy)(nil),    // 116: Superplane.InputMapping.WhenTriggeredBy
+	(*ExecutorSpec_Semaphore)(nil),          // 117: Superplane.ExecutorSpec.Semaphore
+	(*ExecutorSpec_HTTP)(nil),               // 118: Superplane.ExecutorSpec.HTTP
+	(*ExecutorSpec_HTTPOutput)(nil),         // 119: Superplane.ExecutorSpec.HTTPOutput
+	(*ExecutorSpec_HTTPResponsePolicy)(nil), // 120: Superplane.ExecutorSpec.HTTPResponsePolicy
+	(*ExecutorSpec_HTTPStatusPolicy)(nil),   // 121: Superplane.ExecutorSpec.HTTPStatusPolicy
+	(*ExecutorSpec_GitHub)(nil),             // 122: Superplane.ExecutorSpec.GitHub
+	(*ExecutorSpec_GitLab)(nil),             // 123: Superplane.ExecutorSpec.GitLab
+	(*ExecutorSpec_Kubernetes)(nil),         // 124: Superplane.ExecutorSpec.Kubernetes
+	(*ExecutorSpec_Plugin)(nil),             // 125: Superplane.ExecutorSpec.Plugin
+	(*ExecutorSpec_Process)(nil),            // 126: Superplane.ExecutorSpec.Process
+	(*ExecutorSpec_Noop)(nil),               // 127: Superplane.ExecutorSpec.Noop
+	nil,                                     // 128: Superplane.ExecutorSpec.Semaphore.ParametersEntry
+	nil,                                     // 129: Superplane.ExecutorSpec.HTTP.HeadersEntry
+	nil,                                     // 130: Superplane.ExecutorSpec.HTTP.PayloadEntry
+	nil,                                     // 131: Superplane.ExecutorSpec.HTTP.QueryParamsEntry
+	nil,                                     // 132: Superplane.ExecutorSpec.GitHub.InputsEntry
+	nil,                                     // 133: Superplane.ExecutorSpec.GitLab.VariablesEntry
+	nil,                                     // 134: Superplane.ExecutorSpec.Process.EnvEntry
+	nil,                                     // 135: Superplane.ExecutorSpec.Noop.OutputsEntry
+	nil,                                     // 136: Superplane.Event.HeadersEntry
+	nil,                                     // 137: Superplane.CreateEventRequest.HeadersEntry
+	(*timestamp.Timestamp)(nil),             // 138: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                  // 139: google.protobuf.Struct
 }
 var file_superplane_proto_depIdxs = []int32{
-	12,  // 0: Superplane.ListCanvasesResponse.canvases:type_name -> Superplane.Canvas
-	74,  // 1: Superplane.Canvas.metadata:type_name -> Superplane.Canvas.Metadata
-	12,  // 2: Superplane.CreateCanvasRequest.canvas:type_name -> Superplane.Canvas
-	12,  // 3: Superplane.CreateCanvasResponse.canvas:type_name -> Superplane.Canvas
-	12,  // 4: Superplane.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvas
-	75,  // 5: Superplane.EventSource.metadata:type_name -> Superplane.EventSource.Metadata
-	76,  // 6: Superplane.EventSource.spec:type_name -> Superplane.EventSource.Spec
-	36,  // 7: Superplane.DescribeStageResponse.stage:type_name -> Superplane.Stage
-	17,  // 8: Superplane.CreateEventSourceRequest.event_source:type_name -> Superplane.EventSource
-	17,  // 9: Superplane.CreateEventSourceResponse.event_source:type_name -> Superplane.EventSource
-	78,  // 10: Superplane.Secret.metadata:type_name -> Superplane.Secret.Metadata
-	79,  // 11: Superplane.Secret.spec:type_name -> Superplane.Secret.Spec
-	22,  // 12: Superplane.CreateSecretRequest.secret:type_name -> Superplane.Secret
-	22,  // 13: Superplane.CreateSecretResponse.secret:type_name -> Superplane.Secret
-	22,  // 14: Superplane.UpdateSecretRequest.secret:type_name -> Superplane.Secret
-	22,  // 15: Superplane.UpdateSecretResponse.secret:type_name -> Superplane.Secret
-	22,  // 16: Superplane.DescribeSecretResponse.secret:type_name -> Superplane.Secret
-	22,  // 17: Superplane.ListSecretsResponse.secrets:type_name -> Superplane.Secret
-	17,  // 18: Superplane.DescribeEventSourceResponse.event_source:type_name -> Superplane.EventSource
-	1,   // 19: Superplane.Connection.type:type_name -> Superplane.Connection.Type
-	81,  // 20: Superplane.Connection.filters:type_name -> Superplane.Connection.Filter
-	3,   // 21: Superplane.Connection.filter_operator:type_name -> Superplane.Connection.FilterOperator
-	84,  // 22: Superplane.Stage.metadata:type_name -> Superplane.Stage.Metadata
-	85,  // 23: Superplane.Stage.spec:type_name -> Superplane.Stage.Spec
-	40,  // 24: Superplane.InputMapping.values:type_name -> Superplane.ValueDefinition
-	86,  // 25: Superplane.InputMapping.when:type_name -> Superplane.InputMapping.When
-	41,  // 26: Superplane.ValueDefinition.value_from:type_name -> Superplane.ValueFrom
-	42,  // 27: Superplane.ValueFrom.event_data:type_name -> Superplane.ValueFromEventData
-	43,  // 28: Superplane.ValueFrom.last_execution:type_name -> Superplane.ValueFromLastExecution
-	44,  // 29: Superplane.ValueFrom.secret:type_name -> Superplane.ValueFromSecret
-	9,   // 30: Superplane.ValueFromLastExecution.results:type_name -> Superplane.Execution.Result
-	4,   // 31: Superplane.Condition.type:type_name -> Superplane.Condition.Type
-	46,  // 32: Superplane.Condition.approval:type_name -> Superplane.ConditionApproval
-	47,  // 33: Superplane.Condition.time_window:type_name -> Superplane.ConditionTimeWindow
-	36,  // 34: Superplane.CreateStageRequest.stage:type_name -> Superplane.Stage
-	5,   // 35: Superplane.ExecutorSpec.type:type_name -> Superplane.ExecutorSpec.Type
-	88,  // 36: Superplane.ExecutorSpec.semaphore:type_name -> Superplane.ExecutorSpec.Semaphore
-	89,  // 37: Superplane.ExecutorSpec.http:type_name -> Superplane.ExecutorSpec.HTTP
-	36,  // 38: Superplane.CreateStageResponse.stage:type_name -> Superplane.Stage
-	36,  // 39: Superplane.UpdateStageRequest.stage:type_name -> Superplane.Stage
-	36,  // 40: Superplane.UpdateStageResponse.stage:type_name -> Superplane.Stage
-	36,  // 41: Superplane.ListStagesResponse.stages:type_name -> Superplane.Stage
-	17,  // 42: Superplane.ListEventSourcesResponse.event_sources:type_name -> Superplane.EventSource
-	6,   // 43: Superplane.ListStageEventsRequest.states:type_name -> Superplane.StageEvent.State
-	7,   // 44: Superplane.ListStageEventsRequest.state_reasons:type_name -> Superplane.StageEvent.StateReason
-	59,  // 45: Superplane.ListStageEventsResponse.events:type_name -> Superplane.StageEvent
-	1,   // 46: Superplane.StageEvent.source_type:type_name -> Superplane.Connection.Type
-	6,   // 47: Superplane.StageEvent.state:type_name -> Superplane.StageEvent.State
-	7,   // 48: Superplane.StageEvent.state_reason:type_name -> Superplane.StageEvent.StateReason
-	94,  // 49: Superplane.StageEvent.created_at:type_name -> google.protobuf.Timestamp
-	63,  // 50: Superplane.StageEvent.approvals:type_name -> Superplane.StageEventApproval
-	62,  // 51: Superplane.StageEvent.execution:type_name -> Superplane.Execution
-	60,  // 52: Superplane.StageEvent.inputs:type_name -> Superplane.InputValue
-	8,   // 53: Superplane.Execution.state:type_name -> Superplane.Execution.State
-	9,   // 54: Superplane.Execution.result:type_name -> Superplane.Execution.Result
-	94,  // 55: Superplane.Execution.created_at:type_name -> google.protobuf.Timestamp
-	94,  // 56: Superplane.Execution.started_at:type_name -> google.protobuf.Timestamp
-	94,  // 57: Superplane.Execution.finished_at:type_name -> google.protobuf.Timestamp
-	61,  // 58: Superplane.Execution.outputs:type_name -> Superplane.OutputValue
-	94,  // 59: Superplane.StageEventApproval.approved_at:type_name -> google.protobuf.Timestamp
-	59,  // 60: Superplane.ApproveStageEventResponse.event:type_name -> Superplane.StageEvent
-	94,  // 61: Superplane.StageCreated.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 62: Superplane.StageUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 63: Superplane.EventSourceCreated.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 64: Superplane.StageEventCreated.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 65: Superplane.StageEventApproved.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 66: Superplane.StageExecutionCreated.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 67: Superplane.StageExecutionStarted.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 68: Superplane.StageExecutionFinished.timestamp:type_name -> google.protobuf.Timestamp
-	94,  // 69: Superplane.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	94,  // 70: Superplane.EventSource.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	80,  // 71: Superplane.Secret.Local.data:type_name -> Superplane.Secret.Local.DataEntry
-	94,  // 72: Superplane.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	0,   // 73: Superplane.Secret.Spec.provider:type_name -> Superplane.Secret.Provider
-	77,  // 74: Superplane.Secret.Spec.local:type_name -> Superplane.Secret.Local
-	2,   // 75: Superplane.Connection.Filter.type:type_name -> Superplane.Connection.FilterType
-	82,  // 76: Superplane.Connection.Filter.data:type_name -> Superplane.Connection.DataFilter
-	83,  // 77: Superplane.Connection.Filter.header:type_name -> Superplane.Connection.HeaderFilter
-	94,  // 78: Superplane.Stage.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	35,  // 79: Superplane.Stage.Spec.connections:type_name -> Superplane.Connection
-	45,  // 80: Superplane.Stage.Spec.conditions:type_name -> Superplane.Condition
-	49,  // 81: Superplane.Stage.Spec.executor:type_name -> Superplane.ExecutorSpec
-	38,  // 82: Superplane.Stage.Spec.inputs:type_name -> Superplane.InputDefinition
-	39,  // 83: Superplane.Stage.Spec.input_mappings:type_name -> Superplane.InputMapping
-	37,  // 84: Superplane.Stage.Spec.outputs:type_name -> Superplane.OutputDefinition
-	40,  // 85: Superplane.Stage.Spec.secrets:type_name -> Superplane.ValueDefinition
-	87,  // 86: Superplane.InputMapping.When.triggered_by:type_name -> Superplane.InputMapping.WhenTriggeredBy
-	91,  // 87: Superplane.ExecutorSpec.Semaphore.parameters:type_name -> Superplane.ExecutorSpec.Semaphore.ParametersEntry
-	92,  // 88: Superplane.ExecutorSpec.HTTP.headers:type_name -> Superplane.ExecutorSpec.HTTP.HeadersEntry
-	93,  // 89: Superplane.ExecutorSpec.HTTP.payload:type_name -> Superplane.ExecutorSpec.HTTP.PayloadEntry
-	90,  // 90: Superplane.ExecutorSpec.HTTP.response_policy:type_name -> Superplane.ExecutorSpec.HTTPResponsePolicy
-	10,  // 91: Superplane.Superplane.ListCanvases:input_type -> Superplane.ListCanvasesRequest
-	13,  // 92: Superplane.Superplane.CreateCanvas:input_type -> Superplane.CreateCanvasRequest
-	23,  // 93: Superplane.Superplane.CreateSecret:input_type -> Superplane.CreateSecretRequest
-	20,  // 94: Superplane.Superplane.CreateEventSource:input_type -> Superplane.CreateEventSourceRequest
-	48,  // 95: Superplane.Superplane.CreateStage:input_type -> Superplane.CreateStageRequest
-	15,  // 96: Superplane.Superplane.DescribeCanvas:input_type -> Superplane.DescribeCanvasRequest
-	18,  // 97: Superplane.Superplane.DescribeStage:input_type -> Superplane.DescribeStageRequest
-	33,  // 98: Superplane.Superplane.DescribeEventSource:input_type -> Superplane.DescribeEventSourceRequest
-	27,  // 99: Superplane.Superplane.DescribeSecret:input_type -> Superplane.DescribeSecretRequest
-	53,  // 100: Superplane.Superplane.ListStages:input_type -> Superplane.ListStagesRequest
-	55,  // 101: Superplane.Superplane.ListEventSources:input_type -> Superplane.ListEventSourcesRequest
-	29,  // 102: Superplane.Superplane.ListSecrets:input_type -> Superplane.ListSecretsRequest
-	57,  // 103: Superplane.Superplane.ListStageEvents:input_type -> Superplane.ListStageEventsRequest
-	51,  // 104: Superplane.Superplane.UpdateStage:input_type -> Superplane.UpdateStageRequest
-	25,  // 105: Superplane.Superplane.UpdateSecret:input_type -> Superplane.UpdateSecretRequest
-	64,  // 106: Superplane.Superplane.ApproveStageEvent:input_type -> Superplane.ApproveStageEventRequest
-	31,  // 107: Superplane.Superplane.DeleteSecret:input_type -> Superplane.DeleteSecretRequest
-	11,  // 108: Superplane.Superplane.ListCanvases:output_type -> Superplane.ListCanvasesResponse
-	14,  // 109: Superplane.Superplane.CreateCanvas:output_type -> Superplane.CreateCanvasResponse
-	24,  // 110: Superplane.Superplane.CreateSecret:output_type -> Superplane.CreateSecretResponse
-	21,  // 111: Superplane.Superplane.CreateEventSource:output_type -> Superplane.CreateEventSourceResponse
-	50,  // 112: Superplane.Superplane.CreateStage:output_type -> Superplane.CreateStageResponse
-	16,  // 113: Superplane.Superplane.DescribeCanvas:output_type -> Superplane.DescribeCanvasResponse
-	19,  // 114: Superplane.Superplane.DescribeStage:output_type -> Superplane.DescribeStageResponse
-	34,  // 115: Superplane.Superplane.DescribeEventSource:output_type -> Superplane.DescribeEventSourceResponse
-	28,  // 116: Superplane.Superplane.DescribeSecret:output_type -> Superplane.DescribeSecretResponse
-	54,  // 117: Superplane.Superplane.ListStages:output_type -> Superplane.ListStagesResponse
-	56,  // 118: Superplane.Superplane.ListEventSources:output_type -> Superplane.ListEventSourcesResponse
-	30,  // 119: Superplane.Superplane.ListSecrets:output_type -> Superplane.ListSecretsResponse
-	58,  // 120: Superplane.Superplane.ListStageEvents:output_type -> Superplane.ListStageEventsResponse
-	52,  // 121: Superplane.Superplane.UpdateStage:output_type -> Superplane.UpdateStageResponse
-	26,  // 122: Superplane.Superplane.UpdateSecret:output_type -> Superplane.UpdateSecretResponse
-	65,  // 123: Superplane.Superplane.ApproveStageEvent:output_type -> Superplane.ApproveStageEventResponse
-	32,  // 124: Superplane.Superplane.DeleteSecret:output_type -> Superplane.DeleteSecretResponse
-	108, // [108:125] is the sub-list for method output_type
-	91,  // [91:108] is the sub-list for method input_type
-	91,  // [91:91] is the sub-list for extension type_name
-	91,  // [91:91] is the sub-list for extension extendee
-	0,   // [0:91] is the sub-list for field type_name
+	22,  // 0: Superplane.ListCanvasesResponse.canvases:type_name -> Superplane.Canvas
+	100, // 1: Superplane.Canvas.metadata:type_name -> Superplane.Canvas.Metadata
+	22,  // 2: Superplane.CreateCanvasRequest.canvas:type_name -> Superplane.Canvas
+	22,  // 3: Superplane.CreateCanvasResponse.canvas:type_name -> Superplane.Canvas
+	22,  // 4: Superplane.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvas
+	101, // 5: Superplane.EventSource.metadata:type_name -> Superplane.EventSource.Metadata
+	102, // 6: Superplane.EventSource.spec:type_name -> Superplane.EventSource.Spec
+	49,  // 7: Superplane.DescribeStageResponse.stage:type_name -> Superplane.Stage
+	27,  // 8: Superplane.CreateEventSourceRequest.event_source:type_name -> Superplane.EventSource
+	27,  // 9: Superplane.CreateEventSourceResponse.event_source:type_name -> Superplane.EventSource
+	106, // 10: Superplane.Secret.metadata:type_name -> Superplane.Secret.Metadata
+	107, // 11: Superplane.Secret.spec:type_name -> Superplane.Secret.Spec
+	32,  // 12: Superplane.CreateSecretRequest.secret:type_name -> Superplane.Secret
+	32,  // 13: Superplane.CreateSecretResponse.secret:type_name -> Superplane.Secret
+	32,  // 14: Superplane.UpdateSecretRequest.secret:type_name -> Superplane.Secret
+	32,  // 15: Superplane.UpdateSecretResponse.secret:type_name -> Superplane.Secret
+	32,  // 16: Superplane.DescribeSecretResponse.secret:type_name -> Superplane.Secret
+	32,  // 17: Superplane.ListSecretsResponse.secrets:type_name -> Superplane.Secret
+	109, // 18: Superplane.Agent.metadata:type_name -> Superplane.Agent.Metadata
+	43,  // 19: Superplane.CreateAgentRequest.agent:type_name -> Superplane.Agent
+	43,  // 20: Superplane.CreateAgentResponse.agent:type_name -> Superplane.Agent
+	27,  // 21: Superplane.DescribeEventSourceResponse.event_source:type_name -> Superplane.EventSource
+	4,   // 22: Superplane.Connection.type:type_name -> Superplane.Connection.Type
+	110, // 23: Superplane.Connection.filters:type_name -> Superplane.Connection.Filter
+	6,   // 24: Superplane.Connection.filter_operator:type_name -> Superplane.Connection.FilterOperator
+	113, // 25: Superplane.Stage.metadata:type_name -> Superplane.Stage.Metadata
+	114, // 26: Superplane.Stage.spec:type_name -> Superplane.Stage.Spec
+	53,  // 27: Superplane.InputMapping.values:type_name -> Superplane.ValueDefinition
+	115, // 28: Superplane.InputMapping.when:type_name -> Superplane.InputMapping.When
+	54,  // 29: Superplane.ValueDefinition.value_from:type_name -> Superplane.ValueFrom
+	55,  // 30: Superplane.ValueFrom.event_data:type_name -> Superplane.ValueFromEventData
+	56,  // 31: Superplane.ValueFrom.last_execution:type_name -> Superplane.ValueFromLastExecution
+	57,  // 32: Superplane.ValueFrom.secret:type_name -> Superplane.ValueFromSecret
+	16,  // 33: Superplane.ValueFromLastExecution.results:type_name -> Superplane.Execution.Result
+	8,   // 34: Superplane.Condition.type:type_name -> Superplane.Condition.Type
+	60,  // 35: Superplane.Condition.approval:type_name -> Superplane.ConditionApproval
+	61,  // 36: Superplane.Condition.time_window:type_name -> Superplane.ConditionTimeWindow
+	9,   // 37: Superplane.RetryPolicy.backoff_strategy:type_name -> Superplane.RetryPolicy.BackoffStrategy
+	10,  // 38: Superplane.RetryPolicy.retry_on:type_name -> Superplane.RetryPolicy.FailureKind
+	49,  // 39: Superplane.CreateStageRequest.stage:type_name -> Superplane.Stage
+	11,  // 40: Superplane.ExecutorSpec.type:type_name -> Superplane.ExecutorSpec.Type
+	117, // 41: Superplane.ExecutorSpec.semaphore:type_name -> Superplane.ExecutorSpec.Semaphore
+	118, // 42: Superplane.ExecutorSpec.http:type_name -> Superplane.ExecutorSpec.HTTP
+	122, // 43: Superplane.ExecutorSpec.github:type_name -> Superplane.ExecutorSpec.GitHub
+	123, // 44: Superplane.ExecutorSpec.gitlab:type_name -> Superplane.ExecutorSpec.GitLab
+	124, // 45: Superplane.ExecutorSpec.kubernetes:type_name -> Superplane.ExecutorSpec.Kubernetes
+	125, // 46: Superplane.ExecutorSpec.plugin:type_name -> Superplane.ExecutorSpec.Plugin
+	126, // 47: Superplane.ExecutorSpec.process:type_name -> Superplane.ExecutorSpec.Process
+	127, // 48: Superplane.ExecutorSpec.noop:type_name -> Superplane.ExecutorSpec.Noop
+	49,  // 49: Superplane.CreateStageResponse.stage:type_name -> Superplane.Stage
+	49,  // 50: Superplane.UpdateStageRequest.stage:type_name -> Superplane.Stage
+	49,  // 51: Superplane.UpdateStageResponse.stage:type_name -> Superplane.Stage
+	49,  // 52: Superplane.ListStagesResponse.stages:type_name -> Superplane.Stage
+	27,  // 53: Superplane.ListEventSourcesResponse.event_sources:type_name -> Superplane.EventSource
+	13,  // 54: Superplane.ListStageEventsRequest.states:type_name -> Superplane.StageEvent.State
+	14,  // 55: Superplane.ListStageEventsRequest.state_reasons:type_name -> Superplane.StageEvent.StateReason
+	73,  // 56: Superplane.ListStageEventsResponse.events:type_name -> Superplane.StageEvent
+	4,   // 57: Superplane.StageEvent.source_type:type_name -> Superplane.Connection.Type
+	13,  // 58: Superplane.StageEvent.state:type_name -> Superplane.StageEvent.State
+	14,  // 59: Superplane.StageEvent.state_reason:type_name -> Superplane.StageEvent.StateReason
+	138, // 60: Superplane.StageEvent.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 61: Superplane.StageEvent.approvals:type_name -> Superplane.StageEventApproval
+	76,  // 62: Superplane.StageEvent.execution:type_name -> Superplane.Execution
+	74,  // 63: Superplane.StageEvent.inputs:type_name -> Superplane.InputValue
+	15,  // 64: Superplane.Execution.state:type_name -> Superplane.Execution.State
+	16,  // 65: Superplane.Execution.result:type_name -> Superplane.Execution.Result
+	138, // 66: Superplane.Execution.created_at:type_name -> google.protobuf.Timestamp
+	138, // 67: Superplane.Execution.started_at:type_name -> google.protobuf.Timestamp
+	138, // 68: Superplane.Execution.finished_at:type_name -> google.protobuf.Timestamp
+	75,  // 69: Superplane.Execution.outputs:type_name -> Superplane.OutputValue
+	17,  // 70: Superplane.Execution.result_reason:type_name -> Superplane.Execution.ResultReason
+	138, // 71: Superplane.Execution.scheduled_at:type_name -> google.protobuf.Timestamp
+	138, // 72: Superplane.StageEventApproval.approved_at:type_name -> google.protobuf.Timestamp
+	73,  // 73: Superplane.ApproveStageEventResponse.event:type_name -> Superplane.StageEvent
+	76,  // 74: Superplane.CancelExecutionResponse.execution:type_name -> Superplane.Execution
+	76,  // 75: Superplane.RetryExecutionResponse.execution:type_name -> Superplane.Execution
+	18,  // 76: Superplane.Event.state:type_name -> Superplane.Event.State
+	138, // 77: Superplane.Event.received_at:type_name -> google.protobuf.Timestamp
+	139, // 78: Superplane.Event.payload:type_name -> google.protobuf.Struct
+	136, // 79: Superplane.Event.headers:type_name -> Superplane.Event.HeadersEntry
+	139, // 80: Superplane.CreateEventRequest.payload:type_name -> google.protobuf.Struct
+	137, // 81: Superplane.CreateEventRequest.headers:type_name -> Superplane.CreateEventRequest.HeadersEntry
+	84,  // 82: Superplane.CreateEventResponse.event:type_name -> Superplane.Event
+	84,  // 83: Superplane.ReplayEventResponse.event:type_name -> Superplane.Event
+	91,  // 84: Superplane.GetExecutionLogsResponse.logs:type_name -> Superplane.ExecutionLog
+	19,  // 85: Superplane.ExecutionLog.stream:type_name -> Superplane.ExecutionLog.Stream
+	138, // 86: Superplane.ExecutionLog.created_at:type_name -> google.protobuf.Timestamp
+	138, // 87: Superplane.StageCreated.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 88: Superplane.StageUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 89: Superplane.EventSourceCreated.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 90: Superplane.StageEventCreated.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 91: Superplane.StageEventApproved.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 92: Superplane.StageExecutionCreated.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 93: Superplane.StageExecutionStarted.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 94: Superplane.StageExecutionFinished.timestamp:type_name -> google.protobuf.Timestamp
+	16,  // 95: Superplane.StageExecutionFinished.result:type_name -> Superplane.Execution.Result
+	17,  // 96: Superplane.StageExecutionFinished.result_reason:type_name -> Superplane.Execution.ResultReason
+	138, // 97: Superplane.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	138, // 98: Superplane.EventSource.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	104, // 99: Superplane.EventSource.Spec.webhook:type_name -> Superplane.EventSource.Webhook
+	103, // 100: Superplane.EventSource.Spec.schedule:type_name -> Superplane.EventSource.Schedule
+	139, // 101: Superplane.EventSource.Schedule.payload:type_name -> google.protobuf.Struct
+	138, // 102: Superplane.EventSource.Schedule.last_fired_at:type_name -> google.protobuf.Timestamp
+	138, // 103: Superplane.EventSource.Schedule.next_fire_at:type_name -> google.protobuf.Timestamp
+	0,   // 104: Superplane.EventSource.Webhook.algorithm:type_name -> Superplane.EventSource.Webhook.Algorithm
+	1,   // 105: Superplane.EventSource.Webhook.encoding:type_name -> Superplane.EventSource.Webhook.Encoding
+	2,   // 106: Superplane.EventSource.Webhook.signed_content:type_name -> Superplane.EventSource.Webhook.SignedContent
+	108, // 107: Superplane.Secret.Local.data:type_name -> Superplane.Secret.Local.DataEntry
+	138, // 108: Superplane.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 109: Superplane.Secret.Spec.provider:type_name -> Superplane.Secret.Provider
+	105, // 110: Superplane.Secret.Spec.local:type_name -> Superplane.Secret.Local
+	138, // 111: Superplane.Agent.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	138, // 112: Superplane.Agent.Metadata.last_seen_at:type_name -> google.protobuf.Timestamp
+	5,   // 113: Superplane.Connection.Filter.type:type_name -> Superplane.Connection.FilterType
+	111, // 114: Superplane.Connection.Filter.data:type_name -> Superplane.Connection.DataFilter
+	112, // 115: Superplane.Connection.Filter.header:type_name -> Superplane.Connection.HeaderFilter
+	138, // 116: Superplane.Stage.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	48,  // 117: Superplane.Stage.Spec.connections:type_name -> Superplane.Connection
+	58,  // 118: Superplane.Stage.Spec.conditions:type_name -> Superplane.Condition
+	63,  // 119: Superplane.Stage.Spec.executor:type_name -> Superplane.ExecutorSpec
+	51,  // 120: Superplane.Stage.Spec.inputs:type_name -> Superplane.InputDefinition
+	52,  // 121: Superplane.Stage.Spec.input_mappings:type_name -> Superplane.InputMapping
+	50,  // 122: Superplane.Stage.Spec.outputs:type_name -> Superplane.OutputDefinition
+	53,  // 123: Superplane.Stage.Spec.secrets:type_name -> Superplane.ValueDefinition
+	7,   // 124: Superplane.Stage.Spec.queue_policy:type_name -> Superplane.Stage.QueuePolicy
+	59,  // 125: Superplane.Stage.Spec.retry_policy:type_name -> Superplane.RetryPolicy
+	116, // 126: Superplane.InputMapping.When.triggered_by:type_name -> Superplane.InputMapping.WhenTriggeredBy
+	128, // 127: Superplane.ExecutorSpec.Semaphore.parameters:type_name -> Superplane.ExecutorSpec.Semaphore.ParametersEntry
+	129, // 128: Superplane.ExecutorSpec.HTTP.headers:type_name -> Superplane.ExecutorSpec.HTTP.HeadersEntry
+	130, // 129: Superplane.ExecutorSpec.HTTP.payload:type_name -> Superplane.ExecutorSpec.HTTP.PayloadEntry
+	120, // 130: Superplane.ExecutorSpec.HTTP.response_policy:type_name -> Superplane.ExecutorSpec.HTTPResponsePolicy
+	12,  // 131: Superplane.ExecutorSpec.HTTP.mode:type_name -> Superplane.ExecutorSpec.HTTPMode
+	121, // 132: Superplane.ExecutorSpec.HTTP.status_policy:type_name -> Superplane.ExecutorSpec.HTTPStatusPolicy
+	131, // 133: Superplane.ExecutorSpec.HTTP.query_params:type_name -> Superplane.ExecutorSpec.HTTP.QueryParamsEntry
+	119, // 134: Superplane.ExecutorSpec.HTTP.outputs:type_name -> Superplane.ExecutorSpec.HTTPOutput
+	132, // 135: Superplane.ExecutorSpec.GitHub.inputs:type_name -> Superplane.ExecutorSpec.GitHub.InputsEntry
+	133, // 136: Superplane.ExecutorSpec.GitLab.variables:type_name -> Superplane.ExecutorSpec.GitLab.VariablesEntry
+	139, // 137: Superplane.ExecutorSpec.Plugin.config:type_name -> google.protobuf.Struct
+	134, // 138: Superplane.ExecutorSpec.Process.env:type_name -> Superplane.ExecutorSpec.Process.EnvEntry
+	135, // 139: Superplane.ExecutorSpec.Noop.outputs:type_name -> Superplane.ExecutorSpec.Noop.OutputsEntry
+	20,  // 140: Superplane.Superplane.ListCanvases:input_type -> Superplane.ListCanvasesRequest
+	23,  // 141: Superplane.Superplane.CreateCanvas:input_type -> Superplane.CreateCanvasRequest
+	33,  // 142: Superplane.Superplane.CreateSecret:input_type -> Superplane.CreateSecretRequest
+	30,  // 143: Superplane.Superplane.CreateEventSource:input_type -> Superplane.CreateEventSourceRequest
+	62,  // 144: Superplane.Superplane.CreateStage:input_type -> Superplane.CreateStageRequest
+	25,  // 145: Superplane.Superplane.DescribeCanvas:input_type -> Superplane.DescribeCanvasRequest
+	28,  // 146: Superplane.Superplane.DescribeStage:input_type -> Superplane.DescribeStageRequest
+	46,  // 147: Superplane.Superplane.DescribeEventSource:input_type -> Superplane.DescribeEventSourceRequest
+	37,  // 148: Superplane.Superplane.DescribeSecret:input_type -> Superplane.DescribeSecretRequest
+	67,  // 149: Superplane.Superplane.ListStages:input_type -> Superplane.ListStagesRequest
+	69,  // 150: Superplane.Superplane.ListEventSources:input_type -> Superplane.ListEventSourcesRequest
+	39,  // 151: Superplane.Superplane.ListSecrets:input_type -> Superplane.ListSecretsRequest
+	71,  // 152: Superplane.Superplane.ListStageEvents:input_type -> Superplane.ListStageEventsRequest
+	65,  // 153: Superplane.Superplane.UpdateStage:input_type -> Superplane.UpdateStageRequest
+	35,  // 154: Superplane.Superplane.UpdateSecret:input_type -> Superplane.UpdateSecretRequest
+	78,  // 155: Superplane.Superplane.ApproveStageEvent:input_type -> Superplane.ApproveStageEventRequest
+	80,  // 156: Superplane.Superplane.CancelExecution:input_type -> Superplane.CancelExecutionRequest
+	82,  // 157: Superplane.Superplane.RetryExecution:input_type -> Superplane.RetryExecutionRequest
+	89,  // 158: Superplane.Superplane.GetExecutionLogs:input_type -> Superplane.GetExecutionLogsRequest
+	41,  // 159: Superplane.Superplane.DeleteSecret:input_type -> Superplane.DeleteSecretRequest
+	85,  // 160: Superplane.Superplane.CreateEvent:input_type -> Superplane.CreateEventRequest
+	87,  // 161: Superplane.Superplane.ReplayEvent:input_type -> Superplane.ReplayEventRequest
+	44,  // 162: Superplane.Superplane.CreateAgent:input_type -> Superplane.CreateAgentRequest
+	21,  // 163: Superplane.Superplane.ListCanvases:output_type -> Superplane.ListCanvasesResponse
+	24,  // 164: Superplane.Superplane.CreateCanvas:output_type -> Superplane.CreateCanvasResponse
+	34,  // 165: Superplane.Superplane.CreateSecret:output_type -> Superplane.CreateSecretResponse
+	31,  // 166: Superplane.Superplane.CreateEventSource:output_type -> Superplane.CreateEventSourceResponse
+	64,  // 167: Superplane.Superplane.CreateStage:output_type -> Superplane.CreateStageResponse
+	26,  // 168: Superplane.Superplane.DescribeCanvas:output_type -> Superplane.DescribeCanvasResponse
+	29,  // 169: Superplane.Superplane.DescribeStage:output_type -> Superplane.DescribeStageResponse
+	47,  // 170: Superplane.Superplane.DescribeEventSource:output_type -> Superplane.DescribeEventSourceResponse
+	38,  // 171: Superplane.Superplane.DescribeSecret:output_type -> Superplane.DescribeSecretResponse
+	68,  // 172: Superplane.Superplane.ListStages:output_type -> Superplane.ListStagesResponse
+	70,  // 173: Superplane.Superplane.ListEventSources:output_type -> Superplane.ListEventSourcesResponse
+	40,  // 174: Superplane.Superplane.ListSecrets:output_type -> Superplane.ListSecretsResponse
+	72,  // 175: Superplane.Superplane.ListStageEvents:output_type -> Superplane.ListStageEventsResponse
+	66,  // 176: Superplane.Superplane.UpdateStage:output_type -> Superplane.UpdateStageResponse
+	36,  // 177: Superplane.Superplane.UpdateSecret:output_type -> Superplane.UpdateSecretResponse
+	79,  // 178: Superplane.Superplane.ApproveStageEvent:output_type -> Superplane.ApproveStageEventResponse
+	81,  // 179: Superplane.Superplane.CancelExecution:output_type -> Superplane.CancelExecutionResponse
+	83,  // 180: Superplane.Superplane.RetryExecution:output_type -> Superplane.RetryExecutionResponse
+	90,  // 181: Superplane.Superplane.GetExecutionLogs:output_type -> Superplane.GetExecutionLogsResponse
+	42,  // 182: Superplane.Superplane.DeleteSecret:output_type -> Superplane.DeleteSecretResponse
+	86,  // 183: Superplane.Superplane.CreateEvent:output_type -> Superplane.CreateEventResponse
+	88,  // 184: Superplane.Superplane.ReplayEvent:output_type -> Superplane.ReplayEventResponse
+	45,  // 185: Superplane.Superplane.CreateAgent:output_type -> Superplane.CreateAgentResponse
+	163, // [163:186] is the sub-list for method output_type
+	140, // [140:163] is the sub-list for method input_type
+	140, // [140:140] is the sub-list for extension type_name
+	140, // [140:140] is the sub-list for extension extendee
+	0,   // [0:140] is the sub-list for field type_name
 }
 
 func init() { file_superplane_proto_init() }
@@ -5713,8 +8600,8 @@ func file_superplane_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_superplane_proto_rawDesc), len(file_superplane_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   84,
+			NumEnums:      20,
+			NumMessages:   118,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
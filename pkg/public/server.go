@@ -3,8 +3,10 @@ package public
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -17,9 +19,12 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/apis/semaphore"
 	"github.com/superplanehq/superplane/pkg/authentication"
 
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
 	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
@@ -29,8 +34,10 @@ import (
 	"github.com/superplanehq/superplane/pkg/public/ws"
 	"github.com/superplanehq/superplane/pkg/web"
 	"github.com/superplanehq/superplane/pkg/web/assets"
+	"github.com/superplanehq/superplane/pkg/webhooks"
 	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
 )
 
 const (
@@ -39,8 +46,28 @@ const (
 
 	// The size of the stage execution outputs can be up to 4k
 	MaxExecutionOutputsSize = 4 * 1024
+
+	// Execution logs can be sent in chunks of up to 64k
+	MaxExecutionLogsSize = 64 * 1024
+
+	// Events received from GitHub, GitLab and Bitbucket also have their type in this header,
+	// so connection filters can use headers["x-event-type"] no matter where events come from.
+	EventTypeHeader = "X-Event-Type"
 )
 
+// GitLab sends the secret token configured for the webhook as is.
+var gitlabWebhook = models.WebhookSpec{
+	Algorithm:       models.WebhookAlgorithmBearer,
+	SignatureHeader: "X-Gitlab-Token",
+}
+
+var bitbucketWebhook = models.WebhookSpec{
+	Algorithm:       models.WebhookAlgorithmHMACSHA256,
+	SignatureHeader: "X-Hub-Signature",
+	SignaturePrefix: "sha256=",
+	Encoding:        models.WebhookEncodingHex,
+}
+
 type Server struct {
 	httpServer            *http.Server
 	encryptor             crypto.Encryptor
@@ -52,6 +79,20 @@ type Server struct {
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
 	isDev                 bool
+	health                HealthChecker
+}
+
+// HealthChecker reports the state of the process,
+// for the liveness and readiness endpoints.
+type HealthChecker interface {
+	Live() error
+	Ready() error
+}
+
+// SetHealthChecker makes the liveness and readiness endpoints
+// reflect the state of the checker. Without one, they always succeed.
+func (s *Server) SetHealthChecker(checker HealthChecker) {
+	s.health = checker
 }
 
 // WebsocketHub returns the websocket hub for this server
@@ -257,6 +298,8 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 
 	// Health check
 	publicRoute.HandleFunc("/", s.HealthCheck).Methods("GET")
+	publicRoute.HandleFunc("/health/live", s.HandleLiveness).Methods("GET")
+	publicRoute.HandleFunc("/health/ready", s.HandleReadiness).Methods("GET")
 
 	// Webhook endpoints (they have their own authentication)
 	publicRoute.
@@ -269,11 +312,36 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 		Headers("Content-Type", "application/json").
 		Methods("POST")
 
+	publicRoute.
+		HandleFunc(s.BasePath+"/sources/{sourceID}/gitlab", s.HandleGitlabWebhook).
+		Headers("Content-Type", "application/json").
+		Methods("POST")
+
+	publicRoute.
+		HandleFunc(s.BasePath+"/sources/{sourceID}/bitbucket", s.HandleBitbucketWebhook).
+		Headers("Content-Type", "application/json").
+		Methods("POST")
+
+	publicRoute.
+		HandleFunc(s.BasePath+"/sources/{sourceID}/webhook", s.HandleWebhook).
+		MatcherFunc(isJSONContentType).
+		Methods("POST")
+
 	publicRoute.
 		HandleFunc(s.BasePath+"/outputs", s.HandleExecutionOutputs).
 		Headers("Content-Type", "application/json").
 		Methods("POST")
 
+	publicRoute.
+		HandleFunc(s.BasePath+"/executions/finish", s.HandleFinishExecution).
+		Headers("Content-Type", "application/json").
+		Methods("POST")
+
+	publicRoute.
+		HandleFunc(s.BasePath+"/executions/logs", s.HandleExecutionLogs).
+		Headers("Content-Type", "application/json").
+		Methods("POST")
+
 	//
 	// Protected routes (authentication required)
 	//
@@ -326,6 +394,36 @@ func (s *Server) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// HandleLiveness fails if the process needs to be restarted.
+func (s *Server) HandleLiveness(w http.ResponseWriter, r *http.Request) {
+	if s.health == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	writeHealth(w, s.health.Live())
+}
+
+// HandleReadiness fails if the process should not receive new work,
+// e.g. while it is shutting down.
+func (s *Server) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	if s.health == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	writeHealth(w, s.health.Ready())
+}
+
+func writeHealth(w http.ResponseWriter, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 func (s *Server) Serve(host string, port int) error {
 	log.Infof("Starting server at %s:%d", host, port)
 
@@ -341,7 +439,12 @@ func (s *Server) Serve(host string, port int) error {
 		Handler:      s.Router,
 	}
 
-	return s.httpServer.ListenAndServe()
+	err := s.httpServer.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
 }
 
 func (s *Server) Close() {
@@ -350,25 +453,237 @@ func (s *Server) Close() {
 	}
 }
 
+// Shutdown stops accepting new requests and waits for the ones in progress,
+// until the context is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+
+	return s.httpServer.Shutdown(ctx)
+}
+
+// Requests sent on behalf of an execution,
+// authenticated with the execution token.
+type executionRequest interface {
+	GetExecutionID() string
+}
+
 type OutputsRequest struct {
 	ExecutionID string         `json:"execution_id"`
 	Outputs     map[string]any `json:"outputs"`
 }
 
+func (r *OutputsRequest) GetExecutionID() string {
+	return r.ExecutionID
+}
+
 func (s *Server) HandleExecutionOutputs(w http.ResponseWriter, r *http.Request) {
+	var req OutputsRequest
+	execution, ok := s.readExecutionRequest(w, r, &req, MaxExecutionOutputsSize)
+	if !ok {
+		return
+	}
+
+	stage, err := models.FindStageByID(execution.StageID.String())
+	if err != nil {
+		http.Error(w, "error finding stage", http.StatusInternalServerError)
+		return
+	}
+
+	outputs, err := s.parseExecutionOutputs(stage, req.Outputs)
+	if err != nil {
+		http.Error(w, "Error parsing outputs", http.StatusBadRequest)
+		return
+	}
+
+	err = execution.UpdateOutputs(outputs)
+	if err != nil {
+		http.Error(w, "Error updating outputs", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type FinishExecutionRequest struct {
+	ExecutionID string         `json:"execution_id"`
+	Result      string         `json:"result"`
+	Outputs     map[string]any `json:"outputs"`
+}
+
+func (r *FinishExecutionRequest) GetExecutionID() string {
+	return r.ExecutionID
+}
+
+// HandleFinishExecution is used by remote systems to report
+// the result of executions started by asynchronous executors.
+func (s *Server) HandleFinishExecution(w http.ResponseWriter, r *http.Request) {
+	var req FinishExecutionRequest
+	execution, ok := s.readExecutionRequest(w, r, &req, MaxExecutionOutputsSize)
+	if !ok {
+		return
+	}
+
+	if req.Result != models.StageExecutionResultPassed && req.Result != models.StageExecutionResultFailed {
+		http.Error(w, "result must be passed or failed", http.StatusBadRequest)
+		return
+	}
+
+	if execution.State != models.StageExecutionStarted {
+		http.Error(w, "execution is not running", http.StatusConflict)
+		return
+	}
+
+	stage, err := models.FindStageByID(execution.StageID.String())
+	if err != nil {
+		http.Error(w, "error finding stage", http.StatusInternalServerError)
+		return
+	}
+
+	//
+	// Execution tokens are also given to the systems running executions,
+	// e.g. CI pipelines, so they can push outputs. Only executions waiting
+	// for a callback can be finished with them.
+	//
+	spec := stage.ExecutorSpec.Data()
+	if spec.Type != models.ExecutorSpecTypeHTTP || spec.HTTP == nil || spec.HTTP.Mode != models.HTTPExecutorModeCallback {
+		http.Error(w, "execution is not waiting for a callback", http.StatusForbidden)
+		return
+	}
+
+	outputs, err := s.parseExecutionOutputs(stage, req.Outputs)
+	if err != nil {
+		http.Error(w, "Error parsing outputs", http.StatusBadRequest)
+		return
+	}
+
+	err = s.finishExecution(execution, stage, req.Result, outputs)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		http.Error(w, "execution is not running", http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Error finishing execution %s: %v", execution.ID, err)
+		http.Error(w, "Error finishing execution", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// finishExecution marks the execution as finished.
+// The outputs given are merged with the ones previously pushed,
+// and if any required output is still missing, the execution fails.
+// Workers might be finishing the same execution at the same time,
+// so if it is locked or not running anymore, gorm.ErrRecordNotFound is returned.
+func (s *Server) finishExecution(execution *models.StageExecution, stage *models.Stage, result string, outputs map[string]any) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockExecutionInState(tx, execution.ID, models.StageExecutionStarted)
+		if err != nil {
+			return err
+		}
+
+		*execution = *locked
+		if len(outputs) > 0 {
+			merged := execution.Outputs.Data()
+			if merged == nil {
+				merged = map[string]any{}
+			}
+
+			for k, v := range outputs {
+				merged[k] = v
+			}
+
+			if err := execution.UpdateOutputsInTransaction(tx, merged); err != nil {
+				return err
+			}
+		}
+
+		if missing := stage.MissingRequiredOutputs(execution.Outputs.Data()); len(missing) > 0 {
+			log.Infof("Execution %s has missing outputs %v - marking it as failed", execution.ID, missing)
+			result = models.StageExecutionResultFailed
+		}
+
+		if err := execution.FinishInTransaction(tx, stage, result); err != nil {
+			return err
+		}
+
+		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), execution).PublishInTransaction(tx)
+	})
+}
+
+type ExecutionLogsRequest struct {
+	ExecutionID string             `json:"execution_id"`
+	Logs        []ExecutionLogLine `json:"logs"`
+}
+
+type ExecutionLogLine struct {
+	Stream  string `json:"stream"`
+	Content string `json:"content"`
+}
+
+func (r *ExecutionLogsRequest) GetExecutionID() string {
+	return r.ExecutionID
+}
+
+// HandleExecutionLogs is used by remote systems
+// to add to the logs of the executions they are running.
+// Lines without a stream are stored as stdout.
+func (s *Server) HandleExecutionLogs(w http.ResponseWriter, r *http.Request) {
+	var req ExecutionLogsRequest
+	execution, ok := s.readExecutionRequest(w, r, &req, MaxExecutionLogsSize)
+	if !ok {
+		return
+	}
+
+	logs := []models.ExecutionLog{}
+	for _, line := range req.Logs {
+		stream := line.Stream
+		if stream == "" {
+			stream = models.ExecutionLogStreamStdout
+		}
+
+		if stream != models.ExecutionLogStreamStdout && stream != models.ExecutionLogStreamStderr {
+			http.Error(w, "stream must be stdout or stderr", http.StatusBadRequest)
+			return
+		}
+
+		if line.Content == "" {
+			continue
+		}
+
+		logs = append(logs, models.ExecutionLog{Stream: stream, Content: line.Content})
+	}
+
+	err := models.CreateExecutionLogs(execution.ID, logs)
+	if err != nil {
+		log.Errorf("Error storing logs for execution %s: %v", execution.ID, err)
+		http.Error(w, "Error storing logs", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// readExecutionRequest reads and decodes the body of a request sent on behalf of an execution,
+// and validates the execution token sent in the Authorization header.
+// If anything goes wrong, the error is written to the response and false is returned.
+func (s *Server) readExecutionRequest(w http.ResponseWriter, r *http.Request, req executionRequest, maxSize int64) (*models.StageExecution, bool) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
 		http.Error(w, "Missing Authorization header", http.StatusUnauthorized)
-		return
+		return nil, false
 	}
 
 	headerParts := strings.Split(authHeader, "Bearer ")
 	if len(headerParts) != 2 {
 		http.Error(w, "Malformed Authorization header", http.StatusUnauthorized)
-		return
+		return nil, false
 	}
 
-	r.Body = http.MaxBytesReader(w, r.Body, MaxExecutionOutputsSize)
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize)
 	defer r.Body.Close()
 
 	body, err := io.ReadAll(r.Body)
@@ -376,62 +691,43 @@ func (s *Server) HandleExecutionOutputs(w http.ResponseWriter, r *http.Request)
 		if _, ok := err.(*http.MaxBytesError); ok {
 			http.Error(
 				w,
-				fmt.Sprintf("Request body is too large - must be up to %d bytes", MaxExecutionOutputsSize),
+				fmt.Sprintf("Request body is too large - must be up to %d bytes", maxSize),
 				http.StatusRequestEntityTooLarge,
 			)
 
-			return
+			return nil, false
 		}
 
 		http.Error(w, "Error reading request body", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
-	var req OutputsRequest
-	err = json.Unmarshal(body, &req)
+	err = json.Unmarshal(body, req)
 	if err != nil {
 		http.Error(w, "Error decoding request body", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
-	executionID, err := uuid.Parse(req.ExecutionID)
+	executionID, err := uuid.Parse(req.GetExecutionID())
 	if err != nil {
 		http.Error(w, "execution not found", http.StatusNotFound)
-		return
+		return nil, false
 	}
 
 	token := headerParts[1]
-	err = s.jwt.Validate(token, req.ExecutionID)
+	err = s.jwt.Validate(token, executionID.String())
 	if err != nil {
 		http.Error(w, "Invalid token", http.StatusUnauthorized)
-		return
+		return nil, false
 	}
 
 	execution, err := models.FindExecutionByID(executionID)
 	if err != nil {
 		http.Error(w, "execution not found", http.StatusNotFound)
-		return
-	}
-
-	stage, err := models.FindStageByID(execution.StageID.String())
-	if err != nil {
-		http.Error(w, "error finding stage", http.StatusInternalServerError)
-		return
-	}
-
-	outputs, err := s.parseExecutionOutputs(stage, req.Outputs)
-	if err != nil {
-		http.Error(w, "Error parsing outputs", http.StatusBadRequest)
-		return
-	}
-
-	err = execution.UpdateOutputs(outputs)
-	if err != nil {
-		http.Error(w, "Error updating outputs", http.StatusInternalServerError)
-		return
+		return nil, false
 	}
 
-	w.WriteHeader(http.StatusOK)
+	return execution, true
 }
 
 func (s *Server) parseExecutionOutputs(stage *models.Stage, outputs map[string]any) (map[string]any, error) {
@@ -500,6 +796,10 @@ func (s *Server) HandleGithubWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if eventType := r.Header.Get("X-GitHub-Event"); eventType != "" {
+		r.Header.Set(EventTypeHeader, eventType)
+	}
+
 	headers, err := parseHeaders(&r.Header)
 	if err != nil {
 		http.Error(w, "Error parsing headers", http.StatusBadRequest)
@@ -610,9 +910,201 @@ func (s *Server) HandleSemaphoreWebhook(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	//
+	// The notification might also be for a pipeline started by a Semaphore executor.
+	// The event is already saved at this point, so errors here are only logged,
+	// and the execution poller finishes the execution later.
+	//
+	s.finishSemaphoreExecution(source, body)
+
 	w.WriteHeader(http.StatusOK)
 }
 
+// HandleWebhook receives events from any tool that sends webhooks.
+// How requests are verified is configured in the webhook spec of the event source.
+// Generic webhooks come from any kind of sender,
+// so the Content-Type can have parameters, e.g. "application/json; charset=utf-8".
+func isJSONContentType(r *http.Request, rm *mux.RouteMatch) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json"
+}
+
+func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookFor := func(source *models.EventSource) *models.WebhookSpec {
+		return source.Spec.Data().Webhook
+	}
+
+	s.receiveWebhookEvent(w, r, webhookFor, "")
+}
+
+func (s *Server) HandleGitlabWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookFor := func(source *models.EventSource) *models.WebhookSpec {
+		return &gitlabWebhook
+	}
+
+	s.receiveWebhookEvent(w, r, webhookFor, "X-Gitlab-Event")
+}
+
+func (s *Server) HandleBitbucketWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookFor := func(source *models.EventSource) *models.WebhookSpec {
+		return &bitbucketWebhook
+	}
+
+	s.receiveWebhookEvent(w, r, webhookFor, "X-Event-Key")
+}
+
+// receiveWebhookEvent verifies a webhook request for an event source, and saves the event.
+// If the sender puts the event type in its own header, it is copied to the EventTypeHeader.
+func (s *Server) receiveWebhookEvent(
+	w http.ResponseWriter,
+	r *http.Request,
+	webhookFor func(source *models.EventSource) *models.WebhookSpec,
+	eventTypeHeader string,
+) {
+	vars := mux.Vars(r)
+	sourceID, err := uuid.Parse(vars["sourceID"])
+	if err != nil {
+		http.Error(w, "source ID not found", http.StatusNotFound)
+		return
+	}
+
+	source, err := models.FindEventSource(sourceID)
+	if err != nil {
+		http.Error(w, "source ID not found", http.StatusNotFound)
+		return
+	}
+
+	webhook := webhookFor(source)
+	if webhook == nil {
+		http.Error(w, "source is not configured for webhooks", http.StatusNotFound)
+		return
+	}
+
+	spec := webhooks.WithDefaults(*webhook)
+	if r.Header.Get(spec.SignatureHeader) == "" {
+		http.Error(w, fmt.Sprintf("Missing %s header", spec.SignatureHeader), http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, MaxEventSize)
+	defer r.Body.Close()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if _, ok := err.(*http.MaxBytesError); ok {
+			http.Error(
+				w,
+				fmt.Sprintf("Request body is too large - must be up to %d bytes", MaxEventSize),
+				http.StatusRequestEntityTooLarge,
+			)
+
+			return
+		}
+
+		http.Error(w, "Error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	if eventType := r.Header.Get(eventTypeHeader); eventTypeHeader != "" && eventType != "" {
+		r.Header.Set(EventTypeHeader, eventType)
+	}
+
+	headers, err := parseHeaders(&r.Header)
+	if err != nil {
+		http.Error(w, "Error parsing headers", http.StatusBadRequest)
+		return
+	}
+
+	key, err := s.encryptor.Decrypt(r.Context(), source.Key, []byte(source.Name))
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if err := webhooks.Verify(spec, key, r.Header, body, time.Now()); err != nil {
+		log.Errorf("Invalid webhook request for source %s: %v", source.ID, err)
+		http.Error(w, "Invalid signature", http.StatusForbidden)
+		return
+	}
+
+	if _, err := models.CreateEvent(source.ID, source.Name, models.SourceTypeEventSource, body, headers); err != nil {
+		http.Error(w, "Error receiving event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) finishSemaphoreExecution(source *models.EventSource, body []byte) {
+	var hook semaphore.Hook
+	err := json.Unmarshal(body, &hook)
+	if err != nil || hook.Pipeline.State != semaphore.PipelineStateDone {
+		return
+	}
+
+	execution := findSemaphoreExecution(&hook)
+	if execution == nil || execution.State != models.StageExecutionStarted {
+		return
+	}
+
+	stage, err := models.FindStageByID(execution.StageID.String())
+	if err != nil {
+		log.Errorf("Error finding stage for execution %s: %v", execution.ID, err)
+		return
+	}
+
+	//
+	// Sources can only finish executions in their own canvas,
+	// and only the ones started by a Semaphore executor.
+	//
+	if stage.CanvasID != source.CanvasID || stage.ExecutorSpec.Data().Type != models.ExecutorSpecTypeSemaphore {
+		return
+	}
+
+	result := models.StageExecutionResultFailed
+	if hook.Pipeline.Result == semaphore.PipelineResultPassed {
+		result = models.StageExecutionResultPassed
+	}
+
+	err = s.finishExecution(execution, stage, result, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Infof("Execution %s is already being finished - skipping", execution.ID)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Error finishing execution %s: %v", execution.ID, err)
+		return
+	}
+
+	log.Infof("Execution %s finished by Semaphore notification with result %s", execution.ID, result)
+}
+
+// Promotions are referenced by the ID of the promoted pipeline.
+// Task and workflow runs are referenced by the workflow ID,
+// and their result is the result of the initial pipeline in the workflow.
+func findSemaphoreExecution(hook *semaphore.Hook) *models.StageExecution {
+	execution, err := models.FindExecutionByReference(hook.Pipeline.ID)
+	if err == nil {
+		return execution
+	}
+
+	if hook.Workflow.ID == "" || hook.Pipeline.ID != hook.Workflow.InitialPipelineID {
+		return nil
+	}
+
+	execution, err = models.FindExecutionByReference(hook.Workflow.ID)
+	if err != nil {
+		return nil
+	}
+
+	return execution
+}
+
 func parseHeaders(headers *http.Header) ([]byte, error) {
 	parsedHeaders := make(map[string]string, len(*headers))
 	for key, value := range *headers {
@@ -7,6 +7,8 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -38,6 +40,42 @@ func Test__HealthCheckEndpoint(t *testing.T) {
 	require.Equal(t, 200, response.Code)
 }
 
+type testHealthChecker struct {
+	live  error
+	ready error
+}
+
+func (c *testHealthChecker) Live() error  { return c.live }
+func (c *testHealthChecker) Ready() error { return c.ready }
+
+func Test__LivenessAndReadinessEndpoints(t *testing.T) {
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	t.Run("no health checker -> 200", func(t *testing.T) {
+		require.Equal(t, 200, execRequest(server, requestParams{method: "GET", path: "/health/live"}).Code)
+		require.Equal(t, 200, execRequest(server, requestParams{method: "GET", path: "/health/ready"}).Code)
+	})
+
+	t.Run("shutting down -> live but not ready", func(t *testing.T) {
+		server.SetHealthChecker(&testHealthChecker{ready: errors.New("shutting down")})
+
+		require.Equal(t, 200, execRequest(server, requestParams{method: "GET", path: "/health/live"}).Code)
+		response := execRequest(server, requestParams{method: "GET", path: "/health/ready"})
+		require.Equal(t, 503, response.Code)
+		require.Equal(t, "shutting down\n", response.Body.String())
+	})
+
+	t.Run("worker stopped -> not live", func(t *testing.T) {
+		server.SetHealthChecker(&testHealthChecker{live: errors.New("worker stopped")})
+
+		response := execRequest(server, requestParams{method: "GET", path: "/health/live"})
+		require.Equal(t, 503, response.Code)
+		require.Equal(t, "worker stopped\n", response.Body.String())
+	})
+}
+
 func Test__ReceiveGitHubEvent(t *testing.T) {
 	require.NoError(t, database.TruncateTables())
 
@@ -52,7 +90,7 @@ func Test__ReceiveGitHubEvent(t *testing.T) {
 	canvas, err := models.CreateCanvas(userID, org.ID, "test")
 	require.NoError(t, err)
 
-	eventSource, err := canvas.CreateEventSource("github-repo-1", []byte("my-key"))
+	eventSource, err := canvas.CreateEventSource("github-repo-1", []byte("my-key"), models.EventSourceSpec{})
 	require.NoError(t, err)
 
 	validEvent := []byte(`{"action": "created"}`)
@@ -170,6 +208,272 @@ func Test__ReceiveGitHubEvent(t *testing.T) {
 	})
 }
 
+func Test__ReceiveGitlabEvent(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	org, err := models.CreateOrganization(uuid.New(), "test", "test")
+	require.NoError(t, err)
+
+	canvas, err := models.CreateCanvas(uuid.New(), org.ID, "test")
+	require.NoError(t, err)
+
+	eventSource, err := canvas.CreateEventSource("gitlab-repo-1", []byte("my-key"), models.EventSourceSpec{})
+	require.NoError(t, err)
+
+	validEvent := []byte(`{"object_kind": "push"}`)
+	validURL := "/sources/" + eventSource.ID.String() + "/gitlab"
+
+	t.Run("missing token header -> 400", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 400, response.Code)
+		assert.Equal(t, "Missing X-Gitlab-Token header\n", response.Body.String())
+	})
+
+	t.Run("invalid token -> 403", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			signature:   "not-my-key",
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 403, response.Code)
+		assert.Equal(t, "Invalid signature\n", response.Body.String())
+	})
+
+	t.Run("event with valid token is received with normalized event type -> 200", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			signature:   "my-key",
+			contentType: "application/json",
+			headers:     map[string]string{"X-Gitlab-Event": "Push Hook"},
+		})
+
+		assert.Equal(t, 200, response.Code)
+		events, err := models.ListEventsBySourceID(eventSource.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, validEvent, []byte(events[0].Raw))
+
+		headers, err := events[0].GetHeaders()
+		require.NoError(t, err)
+		assert.Equal(t, "Push Hook", headers[EventTypeHeader])
+	})
+}
+
+func Test__ReceiveBitbucketEvent(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	org, err := models.CreateOrganization(uuid.New(), "test", "test")
+	require.NoError(t, err)
+
+	canvas, err := models.CreateCanvas(uuid.New(), org.ID, "test")
+	require.NoError(t, err)
+
+	eventSource, err := canvas.CreateEventSource("bitbucket-repo-1", []byte("my-key"), models.EventSourceSpec{})
+	require.NoError(t, err)
+
+	validEvent := []byte(`{"action": "created"}`)
+	validSignature := "sha256=ee9f99fa8d06b44ffc69ee1c2a7e32e848e8b40536bb5e8405dabb3bbbcaf619"
+	validURL := "/sources/" + eventSource.ID.String() + "/bitbucket"
+
+	t.Run("missing signature header -> 400", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 400, response.Code)
+		assert.Equal(t, "Missing X-Hub-Signature header\n", response.Body.String())
+	})
+
+	t.Run("invalid signature -> 403", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			signature:   "sha256=823a7b73b066321f4f644e70e1d32c15dc8f4677968149c1f35eb07639013271",
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 403, response.Code)
+		assert.Equal(t, "Invalid signature\n", response.Body.String())
+	})
+
+	t.Run("properly signed event is received with normalized event type -> 200", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        validURL,
+			body:        validEvent,
+			signature:   validSignature,
+			contentType: "application/json",
+			headers:     map[string]string{"X-Event-Key": "repo:push"},
+		})
+
+		assert.Equal(t, 200, response.Code)
+		events, err := models.ListEventsBySourceID(eventSource.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, validEvent, []byte(events[0].Raw))
+
+		headers, err := events[0].GetHeaders()
+		require.NoError(t, err)
+		assert.Equal(t, "repo:push", headers[EventTypeHeader])
+	})
+}
+
+func Test__ReceiveWebhookEvent(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	org, err := models.CreateOrganization(uuid.New(), "test", "test")
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	canvas, err := models.CreateCanvas(userID, org.ID, "test")
+	require.NoError(t, err)
+
+	hmacSource, err := canvas.CreateEventSource("hmac", []byte("my-key"), models.EventSourceSpec{
+		Webhook: &models.WebhookSpec{
+			Algorithm:       models.WebhookAlgorithmHMACSHA256,
+			SignatureHeader: "X-Hub-Signature-256",
+			SignaturePrefix: "sha256=",
+			Encoding:        models.WebhookEncodingHex,
+			SignedContent:   models.WebhookSignedContentBody,
+		},
+	})
+
+	require.NoError(t, err)
+
+	bearerSource, err := canvas.CreateEventSource("bearer", []byte("my-key"), models.EventSourceSpec{
+		Webhook: &models.WebhookSpec{Algorithm: models.WebhookAlgorithmBearer},
+	})
+
+	require.NoError(t, err)
+
+	validEvent := []byte(`{"action": "created"}`)
+	validSignature := "sha256=ee9f99fa8d06b44ffc69ee1c2a7e32e848e8b40536bb5e8405dabb3bbbcaf619"
+
+	t.Run("source without webhook configuration -> 404", func(t *testing.T) {
+		source, err := canvas.CreateEventSource("no-webhook", []byte("my-key"), models.EventSourceSpec{})
+		require.NoError(t, err)
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + source.ID.String() + "/webhook",
+			body:        validEvent,
+			signature:   validSignature,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 404, response.Code)
+		assert.Equal(t, "source is not configured for webhooks\n", response.Body.String())
+	})
+
+	t.Run("invalid signature -> 403", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + hmacSource.ID.String() + "/webhook",
+			body:        validEvent,
+			signature:   "sha256=823a7b73b066321f4f644e70e1d32c15dc8f4677968149c1f35eb07639013271",
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 403, response.Code)
+		assert.Equal(t, "Invalid signature\n", response.Body.String())
+	})
+
+	t.Run("properly signed event is received -> 200", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + hmacSource.ID.String() + "/webhook",
+			body:        validEvent,
+			signature:   validSignature,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 200, response.Code)
+		events, err := models.ListEventsBySourceID(hmacSource.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventStatePending, events[0].State)
+		assert.Equal(t, validEvent, []byte(events[0].Raw))
+	})
+
+	t.Run("wrong bearer token -> 403", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + bearerSource.ID.String() + "/webhook",
+			body:        validEvent,
+			authToken:   "not-my-key",
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 403, response.Code)
+	})
+
+	t.Run("event with valid bearer token is received -> 200", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + bearerSource.ID.String() + "/webhook",
+			body:        validEvent,
+			authToken:   "my-key",
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 200, response.Code)
+		events, err := models.ListEventsBySourceID(bearerSource.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("Content-Type with charset -> 200", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + bearerSource.ID.String() + "/webhook",
+			body:        validEvent,
+			authToken:   "my-key",
+			contentType: "application/json; charset=utf-8",
+		})
+
+		assert.Equal(t, 200, response.Code)
+	})
+
+	t.Run("unsupported Content-Type header -> 404", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + bearerSource.ID.String() + "/webhook",
+			body:        validEvent,
+			authToken:   "my-key",
+			contentType: "application/jsonx",
+		})
+
+		assert.Equal(t, 404, response.Code)
+	})
+}
+
 func Test__ReceiveSemaphoreEvent(t *testing.T) {
 	require.NoError(t, database.TruncateTables())
 
@@ -184,7 +488,7 @@ func Test__ReceiveSemaphoreEvent(t *testing.T) {
 	canvas, err := models.CreateCanvas(userID, org.ID, "test")
 	require.NoError(t, err)
 
-	eventSource, err := canvas.CreateEventSource("semaphore-source-1", []byte("my-key"))
+	eventSource, err := canvas.CreateEventSource("semaphore-source-1", []byte("my-key"), models.EventSourceSpec{})
 	require.NoError(t, err)
 
 	// No need to include organization ID in the payload anymore
@@ -316,6 +620,93 @@ func Test__ReceiveSemaphoreEvent(t *testing.T) {
 	})
 }
 
+func Test__SemaphoreNotificationFinishesExecution(t *testing.T) {
+	r := support.Setup(t)
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	send := func(source *models.EventSource, workflowID, initialPipelineID, pipelineID, result string) *httptest.ResponseRecorder {
+		body := []byte(fmt.Sprintf(
+			`{"workflow": {"id": "%s", "initial_pipeline_id": "%s"}, "pipeline": {"id": "%s", "state": "done", "result": "%s"}}`,
+			workflowID, initialPipelineID, pipelineID, result,
+		))
+
+		mac := hmac.New(sha256.New, []byte("my-key"))
+		mac.Write(body)
+
+		return execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/sources/" + source.ID.String() + "/semaphore",
+			body:        body,
+			signature:   "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+			contentType: "application/json",
+		})
+	}
+
+	t.Run("initial pipeline of workflow finishes -> execution finishes", func(t *testing.T) {
+		workflowID := uuid.New().String()
+		pipelineID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		response := send(r.Source, workflowID, pipelineID, pipelineID, "passed")
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultPassed, execution.Result)
+	})
+
+	t.Run("promoted pipeline finishes -> execution finishes", func(t *testing.T) {
+		pipelineID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.StartWithReferenceID(pipelineID))
+
+		response := send(r.Source, uuid.New().String(), uuid.New().String(), pipelineID, "failed")
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultFailed, execution.Result)
+	})
+
+	t.Run("other pipeline of workflow finishes -> execution is still running", func(t *testing.T) {
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		response := send(r.Source, workflowID, uuid.New().String(), uuid.New().String(), "passed")
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+	})
+
+	t.Run("notification from source in another canvas -> execution is still running", func(t *testing.T) {
+		canvas, err := models.CreateCanvas(r.User, r.Organization.ID, "another")
+		require.NoError(t, err)
+		source, err := canvas.CreateEventSource("another", []byte("my-key"), models.EventSourceSpec{})
+		require.NoError(t, err)
+
+		workflowID := uuid.New().String()
+		pipelineID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		response := send(source, workflowID, pipelineID, pipelineID, "passed")
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+	})
+}
+
 func Test__HandleExecutionOutputs(t *testing.T) {
 	r := support.SetupWithOptions(t, support.SetupOptions{
 		Source: true,
@@ -329,7 +720,7 @@ func Test__HandleExecutionOutputs(t *testing.T) {
 	}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{
 		{Name: "version", Required: true},
 		{Name: "sha", Required: true},
-	}, []models.ValueDefinition{})
+	}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 	require.NoError(t, err)
 	stage, err := r.Canvas.FindStageByName("stage-1")
@@ -491,6 +882,362 @@ func Test__HandleExecutionOutputs(t *testing.T) {
 	})
 }
 
+func Test__HandleFinishExecution(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{
+		Source: true,
+	})
+
+	callbackSpec := models.ExecutorSpec{
+		Type: models.ExecutorSpecTypeHTTP,
+		HTTP: &models.HTTPExecutorSpec{
+			URL:  "http://localhost:8000",
+			Mode: models.HTTPExecutorModeCallback,
+		},
+	}
+
+	err := r.Canvas.CreateStage("stage-1", r.User.String(), []models.StageCondition{}, callbackSpec, []models.StageConnection{
+		{
+			SourceID:   r.Source.ID,
+			SourceType: models.SourceTypeEventSource,
+		},
+	}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{
+		{Name: "version", Required: true},
+	}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+
+	require.NoError(t, err)
+	stage, err := r.Canvas.FindStageByName("stage-1")
+	require.NoError(t, err)
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	t.Run("missing authorization header -> 401", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 401, response.Code)
+		assert.Equal(t, "Missing Authorization header\n", response.Body.String())
+	})
+
+	t.Run("token for another execution -> 401", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		token, err := signer.Generate(uuid.NewString(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 401, response.Code)
+		assert.Equal(t, "Invalid token\n", response.Body.String())
+	})
+
+	t.Run("invalid result -> 400", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(execution.ID.String()))
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      "done",
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 400, response.Code)
+		assert.Equal(t, "result must be passed or failed\n", response.Body.String())
+		require.NoError(t, execution.Finish(stage, models.StageExecutionResultCancelled))
+	})
+
+	t.Run("execution not started -> 409", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 409, response.Code)
+		require.NoError(t, execution.Finish(stage, models.StageExecutionResultCancelled))
+	})
+
+	t.Run("proper request -> 200 and execution is finished with outputs", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(execution.ID.String()))
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+			Outputs:     map[string]any{"version": "v1.0.0"},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 200, response.Code)
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultPassed, execution.Result)
+		assert.Equal(t, map[string]any{"version": "v1.0.0"}, execution.Outputs.Data())
+	})
+
+	t.Run("missing required outputs -> execution fails", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(execution.ID.String()))
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 200, response.Code)
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultFailed, execution.Result)
+	})
+
+	t.Run("execution already finished -> 409 and outputs are not changed", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(execution.ID.String()))
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+			Outputs:     map[string]any{"version": "v1.0.0"},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		require.Equal(t, 200, response.Code)
+
+		body, _ = json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultFailed,
+			Outputs:     map[string]any{"version": "v2.0.0"},
+		})
+
+		response = execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 409, response.Code)
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionResultPassed, execution.Result)
+		assert.Equal(t, map[string]any{"version": "v1.0.0"}, execution.Outputs.Data())
+	})
+
+	t.Run("stage not using HTTP callback -> 403", func(t *testing.T) {
+		err := r.Canvas.CreateStage("stage-2", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+
+		require.NoError(t, err)
+		semaphoreStage, err := r.Canvas.FindStageByName("stage-2")
+		require.NoError(t, err)
+
+		execution := support.CreateExecution(t, r.Source, semaphoreStage)
+		require.NoError(t, execution.StartWithReferenceID(execution.ID.String()))
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&FinishExecutionRequest{
+			ExecutionID: execution.ID.String(),
+			Result:      models.StageExecutionResultPassed,
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/finish",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 403, response.Code)
+		assert.Equal(t, "execution is not waiting for a callback\n", response.Body.String())
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+	})
+}
+
+func Test__HandleExecutionLogs(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{
+		Source: true,
+		Stage:  true,
+	})
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(&crypto.NoOpEncryptor{}, signer, "", "")
+	require.NoError(t, err)
+
+	t.Run("missing authorization header -> 401", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		body, _ := json.Marshal(&ExecutionLogsRequest{
+			ExecutionID: execution.ID.String(),
+			Logs:        []ExecutionLogLine{{Content: "hello\n"}},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/logs",
+			body:        body,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 401, response.Code)
+		assert.Equal(t, "Missing Authorization header\n", response.Body.String())
+	})
+
+	t.Run("token for another execution -> 401", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		token, err := signer.Generate(uuid.NewString(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&ExecutionLogsRequest{
+			ExecutionID: execution.ID.String(),
+			Logs:        []ExecutionLogLine{{Content: "hello\n"}},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/logs",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 401, response.Code)
+		assert.Equal(t, "Invalid token\n", response.Body.String())
+	})
+
+	t.Run("invalid stream -> 400", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&ExecutionLogsRequest{
+			ExecutionID: execution.ID.String(),
+			Logs:        []ExecutionLogLine{{Stream: models.ExecutionLogStreamSystem, Content: "hello\n"}},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/logs",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 400, response.Code)
+		assert.Equal(t, "stream must be stdout or stderr\n", response.Body.String())
+	})
+
+	t.Run("proper request -> 200 and logs are stored", func(t *testing.T) {
+		execution := support.CreateExecution(t, r.Source, r.Stage)
+		token, err := signer.Generate(execution.ID.String(), time.Hour)
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(&ExecutionLogsRequest{
+			ExecutionID: execution.ID.String(),
+			Logs: []ExecutionLogLine{
+				{Content: "building\n"},
+				{Stream: models.ExecutionLogStreamStderr, Content: "warning\n"},
+				{Content: ""},
+			},
+		})
+
+		response := execRequest(server, requestParams{
+			method:      "POST",
+			path:        "/executions/logs",
+			body:        body,
+			authToken:   token,
+			contentType: "application/json",
+		})
+
+		assert.Equal(t, 200, response.Code)
+		logs, err := models.ListExecutionLogs(execution.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, models.ExecutionLogStreamStdout, logs[0].Stream)
+		assert.Equal(t, "building\n", logs[0].Content)
+		assert.Equal(t, models.ExecutionLogStreamStderr, logs[1].Stream)
+		assert.Equal(t, "warning\n", logs[1].Content)
+	})
+}
+
 // Test__OpenAPIEndpoints tests that the OpenAPI endpoints serve the files correctly
 func Test__OpenAPIEndpoints(t *testing.T) {
 	checkSwaggerFiles(t)
@@ -620,6 +1367,7 @@ type requestParams struct {
 	signature   string
 	authToken   string
 	contentType string
+	headers     map[string]string
 }
 
 func execRequest(server *Server, params requestParams) *httptest.ResponseRecorder {
@@ -635,6 +1383,10 @@ func execRequest(server *Server, params requestParams) *httptest.ResponseRecorde
 			req.Header.Add("X-Hub-Signature-256", params.signature)
 		} else if strings.Contains(params.path, "/semaphore") {
 			req.Header.Add("X-Semaphore-Signature-256", params.signature)
+		} else if strings.Contains(params.path, "/gitlab") {
+			req.Header.Add("X-Gitlab-Token", params.signature)
+		} else if strings.Contains(params.path, "/bitbucket") {
+			req.Header.Add("X-Hub-Signature", params.signature)
 		} else {
 			// Default to GitHub header for backward compatibility
 			req.Header.Add("X-Hub-Signature-256", params.signature)
@@ -645,6 +1397,10 @@ func execRequest(server *Server, params requestParams) *httptest.ResponseRecorde
 		req.Header.Add("Authorization", "Bearer "+params.authToken)
 	}
 
+	for k, v := range params.headers {
+		req.Header.Add(k, v)
+	}
+
 	res := httptest.NewRecorder()
 	server.Router.ServeHTTP(res, req)
 	return res
@@ -0,0 +1,257 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	DefaultSignatureHeader     = "X-Signature"
+	DefaultAuthorizationHeader = "Authorization"
+	DefaultTimestampSeparator  = "."
+
+	//
+	// When the timestamp and the signature are sent in the same header,
+	// like Stripe does with "t=1492774577,v1=5257a869...",
+	// this is the key used for the timestamp.
+	//
+	timestampKey = "t="
+)
+
+// WithDefaults fills in what was not specified in a webhook spec.
+func WithDefaults(spec models.WebhookSpec) models.WebhookSpec {
+	if spec.Algorithm == "" {
+		spec.Algorithm = models.WebhookAlgorithmHMACSHA256
+	}
+
+	switch spec.Algorithm {
+	case models.WebhookAlgorithmBearer:
+		if spec.SignatureHeader == "" {
+			spec.SignatureHeader = DefaultAuthorizationHeader
+		}
+
+		if spec.SignaturePrefix == "" && spec.SignatureHeader == DefaultAuthorizationHeader {
+			spec.SignaturePrefix = "Bearer "
+		}
+
+	case models.WebhookAlgorithmBasic:
+		if spec.SignatureHeader == "" {
+			spec.SignatureHeader = DefaultAuthorizationHeader
+		}
+
+		if spec.SignaturePrefix == "" {
+			spec.SignaturePrefix = "Basic "
+		}
+
+	default:
+		if spec.SignatureHeader == "" {
+			spec.SignatureHeader = DefaultSignatureHeader
+		}
+
+		if spec.Encoding == "" {
+			spec.Encoding = models.WebhookEncodingHex
+		}
+	}
+
+	if spec.SignedContent == "" {
+		spec.SignedContent = models.WebhookSignedContentBody
+	}
+
+	if spec.SignedContent == models.WebhookSignedContentTimestampAndBody && spec.TimestampSeparator == "" {
+		spec.TimestampSeparator = DefaultTimestampSeparator
+	}
+
+	return spec
+}
+
+// Verify checks that a request sent to the generic webhook endpoint
+// of an event source was sent by someone who knows the event source key.
+func Verify(spec models.WebhookSpec, key []byte, headers http.Header, body []byte, now time.Time) error {
+	spec = WithDefaults(spec)
+
+	value := headers.Get(spec.SignatureHeader)
+	if value == "" {
+		return fmt.Errorf("missing %s header", spec.SignatureHeader)
+	}
+
+	timestamp, err := findTimestamp(spec, headers, value)
+	if err != nil {
+		return err
+	}
+
+	if err := checkReplayWindow(spec, timestamp, now); err != nil {
+		return err
+	}
+
+	switch spec.Algorithm {
+	case models.WebhookAlgorithmBearer:
+		return verifyBearer(spec, key, value)
+	case models.WebhookAlgorithmBasic:
+		return verifyBasic(spec, key, value)
+	case models.WebhookAlgorithmHMACSHA1, models.WebhookAlgorithmHMACSHA256, models.WebhookAlgorithmHMACSHA512:
+		return verifyHMAC(spec, key, value, signedContent(spec, timestamp, body))
+	default:
+		return fmt.Errorf("unsupported algorithm %s", spec.Algorithm)
+	}
+}
+
+func verifyBearer(spec models.WebhookSpec, key []byte, value string) error {
+	token, found := strings.CutPrefix(value, spec.SignaturePrefix)
+	if !found {
+		return fmt.Errorf("invalid token")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), key) != 1 {
+		return fmt.Errorf("invalid token")
+	}
+
+	return nil
+}
+
+func verifyBasic(spec models.WebhookSpec, key []byte, value string) error {
+	encoded, found := strings.CutPrefix(value, spec.SignaturePrefix)
+	if !found {
+		return fmt.Errorf("invalid credentials")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("invalid credentials")
+	}
+
+	//
+	// The username is not used. Only the password is checked,
+	// and it must be the event source key.
+	//
+	_, password, found := strings.Cut(string(decoded), ":")
+	if !found || subtle.ConstantTimeCompare([]byte(password), key) != 1 {
+		return fmt.Errorf("invalid credentials")
+	}
+
+	return nil
+}
+
+func verifyHMAC(spec models.WebhookSpec, key []byte, value string, content []byte) error {
+	h := hmac.New(hashFor(spec.Algorithm), key)
+	h.Write(content)
+	expected := h.Sum(nil)
+
+	//
+	// Some senders include more than one signature in the header,
+	// e.g. while they rotate their secrets, so any of them can match.
+	//
+	for _, signature := range signatures(spec, value) {
+		decoded, err := decode(spec.Encoding, signature)
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(expected, decoded) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid signature")
+}
+
+func signatures(spec models.WebhookSpec, value string) []string {
+	signatures := []string{}
+	for _, part := range strings.Split(value, ",") {
+		signature, found := strings.CutPrefix(strings.TrimSpace(part), spec.SignaturePrefix)
+		if found {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	return signatures
+}
+
+func signedContent(spec models.WebhookSpec, timestamp string, body []byte) []byte {
+	if spec.SignedContent != models.WebhookSignedContentTimestampAndBody {
+		return body
+	}
+
+	content := spec.SignedContentPrefix + timestamp + spec.TimestampSeparator
+	return append([]byte(content), body...)
+}
+
+func findTimestamp(spec models.WebhookSpec, headers http.Header, signatureValue string) (string, error) {
+	if spec.TimestampHeader == "" {
+		if spec.SignedContent == models.WebhookSignedContentTimestampAndBody || spec.ReplayWindow > 0 {
+			return "", fmt.Errorf("timestamp header is not configured")
+		}
+
+		return "", nil
+	}
+
+	var timestamp string
+	if http.CanonicalHeaderKey(spec.TimestampHeader) == http.CanonicalHeaderKey(spec.SignatureHeader) {
+		for _, part := range strings.Split(signatureValue, ",") {
+			if t, found := strings.CutPrefix(strings.TrimSpace(part), timestampKey); found {
+				timestamp = t
+			}
+		}
+	} else {
+		timestamp = headers.Get(spec.TimestampHeader)
+	}
+
+	if timestamp == "" {
+		return "", fmt.Errorf("missing timestamp in %s header", spec.TimestampHeader)
+	}
+
+	return timestamp, nil
+}
+
+func checkReplayWindow(spec models.WebhookSpec, timestamp string, now time.Time) error {
+	if spec.ReplayWindow <= 0 {
+		return nil
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %s", timestamp)
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age < 0 {
+		age = -age
+	}
+
+	if age > time.Duration(spec.ReplayWindow)*time.Second {
+		return fmt.Errorf("timestamp %s is outside of the replay window", timestamp)
+	}
+
+	return nil
+}
+
+func hashFor(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case models.WebhookAlgorithmHMACSHA1:
+		return sha1.New
+	case models.WebhookAlgorithmHMACSHA512:
+		return sha512.New
+	default:
+		return sha256.New
+	}
+}
+
+func decode(encoding, signature string) ([]byte, error) {
+	if encoding == models.WebhookEncodingBase64 {
+		return base64.StdEncoding.DecodeString(signature)
+	}
+
+	return hex.DecodeString(strings.ToLower(signature))
+}
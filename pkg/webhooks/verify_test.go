@@ -0,0 +1,164 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test__Verify(t *testing.T) {
+	key := []byte("my-key")
+	body := []byte(`{"hello":"world"}`)
+	now := time.Now()
+	timestamp := fmt.Sprintf("%d", now.Unix())
+
+	t.Run("hmac algorithms with hex encoding -> no error", func(t *testing.T) {
+		algorithms := map[string]func() hash.Hash{
+			models.WebhookAlgorithmHMACSHA1:   sha1.New,
+			models.WebhookAlgorithmHMACSHA256: sha256.New,
+			models.WebhookAlgorithmHMACSHA512: sha512.New,
+		}
+
+		for algorithm, h := range algorithms {
+			spec := models.WebhookSpec{Algorithm: algorithm}
+			headers := http.Header{}
+			headers.Set(DefaultSignatureHeader, hex.EncodeToString(sign(h, key, body)))
+			assert.NoError(t, Verify(spec, key, headers, body, now), algorithm)
+		}
+	})
+
+	t.Run("base64 encoding and signature prefix -> no error", func(t *testing.T) {
+		spec := models.WebhookSpec{
+			Algorithm:       models.WebhookAlgorithmHMACSHA256,
+			SignatureHeader: "X-Hub-Signature-256",
+			SignaturePrefix: "sha256=",
+			Encoding:        models.WebhookEncodingBase64,
+		}
+
+		headers := http.Header{}
+		headers.Set("X-Hub-Signature-256", "sha256="+base64.StdEncoding.EncodeToString(sign(sha256.New, key, body)))
+		require.NoError(t, Verify(spec, key, headers, body, now))
+	})
+
+	t.Run("missing signature header -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmHMACSHA256}
+		require.ErrorContains(t, Verify(spec, key, http.Header{}, body, now), "missing X-Signature header")
+	})
+
+	t.Run("signature for a different body -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmHMACSHA256}
+		headers := http.Header{}
+		headers.Set(DefaultSignatureHeader, hex.EncodeToString(sign(sha256.New, key, []byte("{}"))))
+		require.ErrorContains(t, Verify(spec, key, headers, body, now), "invalid signature")
+	})
+
+	t.Run("timestamp and body in separate headers, like Slack -> no error", func(t *testing.T) {
+		spec := models.WebhookSpec{
+			Algorithm:           models.WebhookAlgorithmHMACSHA256,
+			SignatureHeader:     "X-Slack-Signature",
+			SignaturePrefix:     "v0=",
+			SignedContent:       models.WebhookSignedContentTimestampAndBody,
+			SignedContentPrefix: "v0:",
+			TimestampHeader:     "X-Slack-Request-Timestamp",
+			TimestampSeparator:  ":",
+			ReplayWindow:        300,
+		}
+
+		content := append([]byte("v0:"+timestamp+":"), body...)
+		headers := http.Header{}
+		headers.Set("X-Slack-Request-Timestamp", timestamp)
+		headers.Set("X-Slack-Signature", "v0="+hex.EncodeToString(sign(sha256.New, key, content)))
+		require.NoError(t, Verify(spec, key, headers, body, now))
+	})
+
+	t.Run("timestamp and signatures in the same header, like Stripe -> no error", func(t *testing.T) {
+		spec := models.WebhookSpec{
+			Algorithm:       models.WebhookAlgorithmHMACSHA256,
+			SignatureHeader: "Stripe-Signature",
+			SignaturePrefix: "v1=",
+			SignedContent:   models.WebhookSignedContentTimestampAndBody,
+			TimestampHeader: "Stripe-Signature",
+			ReplayWindow:    300,
+		}
+
+		content := append([]byte(timestamp+"."), body...)
+		signature := hex.EncodeToString(sign(sha256.New, key, content))
+		headers := http.Header{}
+		headers.Set("Stripe-Signature", fmt.Sprintf("t=%s,v1=%s,v1=%s", timestamp, "deadbeef", signature))
+		require.NoError(t, Verify(spec, key, headers, body, now))
+	})
+
+	t.Run("timestamp outside of replay window -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{
+			Algorithm:       models.WebhookAlgorithmHMACSHA256,
+			SignedContent:   models.WebhookSignedContentTimestampAndBody,
+			TimestampHeader: "X-Timestamp",
+			ReplayWindow:    300,
+		}
+
+		old := fmt.Sprintf("%d", now.Add(-10*time.Minute).Unix())
+		content := append([]byte(old+"."), body...)
+		headers := http.Header{}
+		headers.Set("X-Timestamp", old)
+		headers.Set(DefaultSignatureHeader, hex.EncodeToString(sign(sha256.New, key, content)))
+		require.ErrorContains(t, Verify(spec, key, headers, body, now), "outside of the replay window")
+	})
+
+	t.Run("missing timestamp -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{
+			Algorithm:       models.WebhookAlgorithmHMACSHA256,
+			SignedContent:   models.WebhookSignedContentTimestampAndBody,
+			TimestampHeader: "X-Timestamp",
+		}
+
+		headers := http.Header{}
+		headers.Set(DefaultSignatureHeader, hex.EncodeToString(sign(sha256.New, key, body)))
+		require.ErrorContains(t, Verify(spec, key, headers, body, now), "missing timestamp in X-Timestamp header")
+	})
+
+	t.Run("bearer token -> no error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmBearer}
+		headers := http.Header{}
+		headers.Set("Authorization", "Bearer my-key")
+		require.NoError(t, Verify(spec, key, headers, body, now))
+	})
+
+	t.Run("wrong bearer token -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmBearer}
+		headers := http.Header{}
+		headers.Set("Authorization", "Bearer not-my-key")
+		require.ErrorContains(t, Verify(spec, key, headers, body, now), "invalid token")
+	})
+
+	t.Run("basic auth with key as password -> no error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmBasic}
+		headers := http.Header{}
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("anyone:my-key")))
+		require.NoError(t, Verify(spec, key, headers, body, now))
+	})
+
+	t.Run("basic auth with wrong password -> error", func(t *testing.T) {
+		spec := models.WebhookSpec{Algorithm: models.WebhookAlgorithmBasic}
+		headers := http.Header{}
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("anyone:nope")))
+		require.ErrorContains(t, Verify(spec, key, headers, body, now), "invalid credentials")
+	})
+}
+
+func sign(h func() hash.Hash, key, content []byte) []byte {
+	mac := hmac.New(h, key)
+	mac.Write(content)
+	return mac.Sum(nil)
+}
@@ -3,46 +3,39 @@ package workers
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/renderedtext/go-tackle"
 	log "github.com/sirupsen/logrus"
-	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/bus"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
-	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/public/ws"
 	"github.com/superplanehq/superplane/pkg/workers/eventdistributer"
 )
 
-// EventDistributer coordinates message consumption from RabbitMQ
+// EventDistributer coordinates message consumption from the message bus
 // and distributes events to websocket clients
 type EventDistributer struct {
-	wsHub    *ws.Hub
-	shutdown chan struct{}
+	bus   bus.Bus
+	wsHub *ws.Hub
 }
 
 // NewEventDistributer creates a new event distributer coordinator
-func NewEventDistributer(wsHub *ws.Hub) *EventDistributer {
+func NewEventDistributer(b bus.Bus, wsHub *ws.Hub) *EventDistributer {
 	return &EventDistributer{
-		wsHub:    wsHub,
-		shutdown: make(chan struct{}),
+		bus:   b,
+		wsHub: wsHub,
 	}
 }
 
-// Start begins consuming messages from RabbitMQ for all relevant routing keys
-func (e *EventDistributer) Start() error {
+// Start begins consuming messages from the message bus for all relevant routing keys,
+// until the context is done
+func (e *EventDistributer) Start(ctx context.Context) error {
 	log.Info("Starting EventDistributer worker")
-	
-	amqpURL, err := config.RabbitMQURL()
-	if err != nil {
-		return fmt.Errorf("failed to get RabbitMQ URL: %w", err)
-	}
 
 	// Define the routes to consume with their handlers
 	routes := []struct {
 		Exchange   string
 		RoutingKey string
-		Handler    func(delivery tackle.Delivery) error
+		Handler    bus.Handler
 	}{
 		{messages.DeliveryHubCanvasExchange, messages.StageEventCreatedRoutingKey, e.createHandler(eventdistributer.HandleStageEventCreated)},
 		{messages.DeliveryHubCanvasExchange, messages.StageEventApprovedRoutingKey, e.createHandler(eventdistributer.HandleStageEventApproved)},
@@ -54,21 +47,25 @@ func (e *EventDistributer) Start() error {
 		{messages.DeliveryHubCanvasExchange, "stage-updated", e.createHandler(eventdistributer.HandleStageUpdated)},
 	}
 
-	// Start a consumer for each route
+	// Start a subscription for each route
 	for _, route := range routes {
-		go e.consumeMessages(amqpURL, route.Exchange, route.RoutingKey, route.Handler)
+		subscription := bus.Subscription{
+			Exchange:   route.Exchange,
+			RoutingKey: route.RoutingKey,
+			Queue:      fmt.Sprintf("superplane.%s.%s.consumer", route.Exchange, route.RoutingKey),
+		}
+
+		go e.bus.Subscribe(ctx, subscription, route.Handler)
 	}
 
 	// Block until shutdown signal
-	<-e.shutdown
+	<-ctx.Done()
 	return nil
 }
 
-// createHandler returns a tackle handler that calls the given processing function
-func (e *EventDistributer) createHandler(processFn func([]byte, *ws.Hub) error) func(delivery tackle.Delivery) error {
-	return func(delivery tackle.Delivery) error {
-		// Call the Body() function to get the message body bytes
-		messageBody := delivery.Body()
+// createHandler returns a bus handler that calls the given processing function
+func (e *EventDistributer) createHandler(processFn func([]byte, *ws.Hub) error) bus.Handler {
+	return func(messageBody []byte) error {
 		err := processFn(messageBody, e.wsHub)
 		if err != nil {
 			log.Errorf("Error processing message: %v", err)
@@ -77,48 +74,3 @@ func (e *EventDistributer) createHandler(processFn func([]byte, *ws.Hub) error)
 		return nil // Always ack the message regardless of processing success
 	}
 }
-
-// consumeMessages sets up a consumer for a specific routing key
-func (e *EventDistributer) consumeMessages(amqpURL, exchange, routingKey string, handler func(delivery tackle.Delivery) error) {
-	queueName := fmt.Sprintf("superplane.%s.%s.consumer", exchange, routingKey)
-	
-	for {
-		log.Infof("Connecting to RabbitMQ queue %s for %s events", queueName, routingKey)
-		
-		logger := logging.NewTackleLogger(log.StandardLogger().WithFields(log.Fields{
-			"consumer":   "event_distributer",
-			"route_handler": routingKey,
-		}))
-
-		// Create a new consumer
-		consumer := tackle.NewConsumer()
-		consumer.SetLogger(logger)
-
-		// Start the consumer with appropriate options
-		err := consumer.Start(&tackle.Options{
-			URL:            amqpURL,
-			RemoteExchange: exchange,
-			Service:        queueName,
-			RoutingKey:     routingKey,
-		}, handler)
-
-
-		if err != nil {
-			log.Errorf("Error consuming messages from %s: %v", routingKey, err)
-			// Wait before attempting to reconnect
-			time.Sleep(5 * time.Second)
-			continue
-		}
-
-		// If we reach here, the connection has been closed
-		log.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", routingKey)
-		time.Sleep(5 * time.Second)
-	}
-}
-
-// Shutdown gracefully stops the worker
-func (e *EventDistributer) Shutdown(ctx context.Context) error {
-	log.Info("Shutting down EventDistributer worker")
-	close(e.shutdown)
-	return nil
-}
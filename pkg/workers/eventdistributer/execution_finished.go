@@ -30,14 +30,15 @@ func HandleExecutionFinished(messageBody []byte, wsHub *ws.Hub) error {
 
 	// Create the websocket event
 	payload := map[string]interface{}{
-		"id":        rawMsg["id"],
-		"stage_id":  rawMsg["stage_id"],
-		"canvas_id": canvasID,
-		"result":    rawMsg["result"],
-		"timestamp": rawMsg["timestamp"],
+		"id":            rawMsg["id"],
+		"stage_id":      rawMsg["stage_id"],
+		"canvas_id":     canvasID,
+		"result":        rawMsg["result"],
+		"result_reason": rawMsg["result_reason"],
+		"timestamp":     rawMsg["timestamp"],
 	}
 	wsEvent := map[string]interface{}{
-		"event":    "execution_finished",
+		"event":   "execution_finished",
 		"payload": payload,
 	}
 
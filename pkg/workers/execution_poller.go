@@ -1,39 +1,58 @@
 package workers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/executors"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
 
+// Semaphore executions are finished by the Semaphore notifications,
+// so polling for them is only a fallback for missed notifications,
+// and happens less often than for other executors.
+const SemaphorePollInterval = 2 * time.Minute
+
+// Executions are claimed before their executor is called, and only get
+// a reference ID once the executor returns. Executions started without one
+// for longer than this were left behind by a worker that stopped in between.
+const StartWithoutReferenceGracePeriod = 5 * time.Minute
+
 type ExecutionPoller struct {
 	Encryptor   crypto.Encryptor
 	SpecBuilder executors.SpecBuilder
+
+	nowFunc     func() time.Time
+	lastChecked map[uuid.UUID]time.Time
 }
 
 func NewExecutionPoller(encryptor crypto.Encryptor) *ExecutionPoller {
 	return &ExecutionPoller{
 		Encryptor:   encryptor,
 		SpecBuilder: executors.SpecBuilder{},
+		nowFunc:     time.Now,
+		lastChecked: map[uuid.UUID]time.Time{},
 	}
 }
 
-func (w *ExecutionPoller) Start() error {
-	for {
-		err := w.Tick()
-		if err != nil {
+func (w *ExecutionPoller) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, 15*time.Second, func() {
+		if err := w.Tick(); err != nil {
 			log.Errorf("Error processing started executions: %v", err)
 		}
+	})
 
-		time.Sleep(15 * time.Second)
-	}
+	return nil
 }
 
 func (w *ExecutionPoller) Tick() error {
@@ -42,6 +61,8 @@ func (w *ExecutionPoller) Tick() error {
 		return err
 	}
 
+	w.forgetFinishedExecutions(executions)
+
 	for _, execution := range executions {
 		e := execution
 		logger := logging.ForExecution(&e)
@@ -61,6 +82,15 @@ func (w *ExecutionPoller) ProcessExecution(logger *log.Entry, execution *models.
 		return err
 	}
 
+	if execution.ReferenceID == "" {
+		return w.processExecutionWithoutReference(logger, stage, execution)
+	}
+
+	if !w.shouldCheck(execution, stage.ExecutorSpec.Data().Type) {
+		logger.Info("Checked recently - skipping")
+		return nil
+	}
+
 	inputMap, err := execution.GetInputs()
 	if err != nil {
 		return err
@@ -76,7 +106,7 @@ func (w *ExecutionPoller) ProcessExecution(logger *log.Entry, execution *models.
 		return err
 	}
 
-	executor, err := executors.NewExecutor(spec.Type, *execution, nil)
+	executor, err := executors.NewExecutor(spec.Type, *execution, nil, w.Encryptor)
 	if err != nil {
 		return err
 	}
@@ -86,6 +116,8 @@ func (w *ExecutionPoller) ProcessExecution(logger *log.Entry, execution *models.
 		return err
 	}
 
+	storeExecutorLogs(logger, *execution, status)
+
 	if !status.Finished() {
 		logger.Info("Not finished yet")
 		return nil
@@ -96,14 +128,51 @@ func (w *ExecutionPoller) ProcessExecution(logger *log.Entry, execution *models.
 		result = models.StageExecutionResultPassed
 	}
 
+	skipped := false
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
-		outputs := execution.Outputs.Data()
+		//
+		// Another worker, or the remote system through the API,
+		// might be finishing the same execution at the same time.
+		// We lock it, and skip it if it is not running anymore.
+		//
+		locked, err := models.LockExecutionInState(tx, execution.ID, models.StageExecutionStarted)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			skipped = true
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error locking execution: %v", err)
+		}
+
+		*execution = *locked
+
+		//
+		// Some executors return outputs when the execution finishes.
+		// Those are merged with the outputs pushed through the /outputs API.
+		//
+		if outputs := status.Outputs(); len(outputs) > 0 {
+			merged := execution.Outputs.Data()
+			if merged == nil {
+				merged = map[string]any{}
+			}
+
+			for k, v := range outputs {
+				if stage.HasOutputDefinition(k) {
+					merged[k] = v
+				}
+			}
+
+			if err := execution.UpdateOutputsInTransaction(tx, merged); err != nil {
+				return err
+			}
+		}
 
 		//
 		// Check if all required outputs were pushed.
 		// If any output wasn't pushed, mark the execution as failed.
 		//
-		missingOutputs := stage.MissingRequiredOutputs(outputs)
+		missingOutputs := stage.MissingRequiredOutputs(execution.Outputs.Data())
 		if len(missingOutputs) > 0 {
 			logger.Infof("Missing outputs %v - marking the execution as failed", missingOutputs)
 			result = models.StageExecutionResultFailed
@@ -115,18 +184,83 @@ func (w *ExecutionPoller) ProcessExecution(logger *log.Entry, execution *models.
 		}
 
 		logger.Infof("Execution state updated: %s", result)
-		return nil
+		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), execution).PublishInTransaction(tx)
 	})
 
 	if err != nil {
 		return err
 	}
 
+	if skipped {
+		logger.Info("Finished by another worker - skipping")
+		return nil
+	}
+
 	logger.Infof("Finished with result: %s", result)
-	err = messages.NewExecutionFinishedMessage(stage.CanvasID.String(), execution).Publish()
+	return nil
+}
+
+// Executions without a reference ID are still being started by the executor,
+// or were never started in it, so there is nothing to check for them yet.
+// After the grace period, we fail them, since they are not running anywhere.
+func (w *ExecutionPoller) processExecutionWithoutReference(logger *log.Entry, stage *models.Stage, execution *models.StageExecution) error {
+	if execution.StartedAt != nil && w.nowFunc().Sub(*execution.StartedAt) < StartWithoutReferenceGracePeriod {
+		logger.Info("Not started in executor yet - skipping")
+		return nil
+	}
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockExecutionInState(tx, execution.ID, models.StageExecutionStarted)
+		if err != nil {
+			return err
+		}
+
+		*execution = *locked
+		err = execution.FinishWithReasonInTransaction(tx, stage, models.StageExecutionResultFailed, models.StageExecutionResultReasonError)
+		if err != nil {
+			return fmt.Errorf("error moving execution to failed state: %v", err)
+		}
+
+		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), execution).PublishInTransaction(tx)
+	})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Info("Finished by another worker - skipping")
+		return nil
+	}
+
 	if err != nil {
-		logger.Errorf("Error publishing execution finished message: %v", err)
+		return err
 	}
 
+	logger.Infof("Not started in executor after %v - execution failed", StartWithoutReferenceGracePeriod)
 	return nil
 }
+
+func (w *ExecutionPoller) shouldCheck(execution *models.StageExecution, executorType string) bool {
+	if executorType != models.ExecutorSpecTypeSemaphore {
+		return true
+	}
+
+	now := w.nowFunc()
+	lastChecked, ok := w.lastChecked[execution.ID]
+	if ok && now.Sub(lastChecked) < SemaphorePollInterval {
+		return false
+	}
+
+	w.lastChecked[execution.ID] = now
+	return true
+}
+
+func (w *ExecutionPoller) forgetFinishedExecutions(started []models.StageExecution) {
+	ids := make(map[uuid.UUID]bool, len(started))
+	for _, execution := range started {
+		ids[execution.ID] = true
+	}
+
+	for id := range w.lastChecked {
+		if !ids[id] {
+			delete(w.lastChecked, id)
+		}
+	}
+}
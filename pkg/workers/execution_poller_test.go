@@ -34,7 +34,7 @@ func Test__ExecutionPoller(t *testing.T) {
 	}
 
 	spec := support.ExecutorSpecWithURL(r.SemaphoreAPIMock.Server.URL)
-	err := r.Canvas.CreateStage("stage-1", r.User.String(), []models.StageCondition{}, spec, connections, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{})
+	err := r.Canvas.CreateStage("stage-1", r.User.String(), []models.StageCondition{}, spec, connections, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 	require.NoError(t, err)
 	stage, err := r.Canvas.FindStageByName("stage-1")
 	require.NoError(t, err)
@@ -101,6 +101,7 @@ func Test__ExecutionPoller(t *testing.T) {
 		assert.NotEmpty(t, e.Execution.CreatedAt)
 		assert.NotEmpty(t, e.Execution.StartedAt)
 		assert.NotEmpty(t, e.Execution.FinishedAt)
+		support.RelayOutbox(t)
 		require.True(t, testconsumer.HasReceivedMessage())
 	})
 
@@ -116,7 +117,7 @@ func Test__ExecutionPoller(t *testing.T) {
 			},
 		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{
 			{Name: "MY_OUTPUT", Required: true},
-		}, []models.ValueDefinition{})
+		}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 		stageWithOutput, err := r.Canvas.FindStageByName("stage-with-output")
@@ -217,8 +218,170 @@ func Test__ExecutionPoller(t *testing.T) {
 		assert.NotEmpty(t, e.Execution.CreatedAt)
 		assert.NotEmpty(t, e.Execution.StartedAt)
 		assert.NotEmpty(t, e.Execution.FinishedAt)
+		support.RelayOutbox(t)
 		require.True(t, testconsumer.HasReceivedMessage())
 	})
+
+	t.Run("semaphore execution checked recently -> not checked again until interval passes", func(t *testing.T) {
+		now := time.Now()
+		w := NewExecutionPoller(encryptor)
+		w.nowFunc = func() time.Time { return now }
+
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		//
+		// First tick checks the execution, but the pipeline is still running.
+		//
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, "")
+		require.NoError(t, w.Tick())
+
+		//
+		// Pipeline finishes, but the execution was checked recently.
+		//
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultPassed)
+		require.NoError(t, w.Tick())
+		e, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, e.State)
+
+		//
+		// After the interval passes, the execution is checked again.
+		//
+		now = now.Add(SemaphorePollInterval)
+		require.NoError(t, w.Tick())
+		e, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, e.State)
+		assert.Equal(t, models.StageExecutionResultPassed, e.Result)
+	})
+
+	t.Run("failed pipeline with retry policy -> new attempt is scheduled", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		retryPolicy := models.RetryPolicy{
+			MaxAttempts:     2,
+			Backoff:         30,
+			BackoffStrategy: models.BackoffStrategyFixed,
+			RetryOn:         []string{models.RetryOnFailed},
+		}
+
+		err := r.Canvas.CreateStage("stage-with-retries", r.User.String(), []models.StageCondition{}, spec, connections, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, retryPolicy)
+		require.NoError(t, err)
+		stageWithRetries, err := r.Canvas.FindStageByName("stage-with-retries")
+		require.NoError(t, err)
+
+		//
+		// Create execution, mock failed result and tick worker
+		//
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stageWithRetries)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultFailed)
+		require.NoError(t, w.Tick())
+
+		//
+		// First attempt fails, and a second attempt is scheduled for the same event.
+		//
+		attempts, err := models.ListExecutionAttempts(execution.StageEventID)
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.Equal(t, models.StageExecutionFinished, attempts[0].State)
+		assert.Equal(t, models.StageExecutionResultFailed, attempts[0].Result)
+		assert.Equal(t, 2, attempts[1].Attempt)
+		assert.Equal(t, models.StageExecutionPending, attempts[1].State)
+		require.NotNil(t, attempts[1].PreviousExecutionID)
+		assert.Equal(t, execution.ID, *attempts[1].PreviousExecutionID)
+		require.NotNil(t, attempts[1].ScheduledAt)
+		assert.True(t, attempts[1].ScheduledAt.After(time.Now()))
+
+		//
+		// No completion event is emitted while attempts remain.
+		//
+		list, err := models.ListEventsBySourceID(stageWithRetries.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 0)
+
+		//
+		// Second attempt also fails, and no more attempts are made.
+		//
+		secondWorkflowID := uuid.New().String()
+		second := attempts[1]
+		require.NoError(t, second.StartWithReferenceID(secondWorkflowID))
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), secondWorkflowID, semaphore.PipelineResultFailed)
+		require.NoError(t, w.Tick())
+
+		attempts, err = models.ListExecutionAttempts(execution.StageEventID)
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+		assert.Equal(t, models.StageExecutionFinished, attempts[1].State)
+
+		list, err = models.ListEventsBySourceID(stageWithRetries.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		e, err := unmarshalCompletionEvent(list[0].Raw)
+		require.NoError(t, err)
+		assert.Equal(t, second.ID.String(), e.Execution.ID)
+		assert.Equal(t, 2, e.Execution.Attempt)
+	})
+
+	t.Run("concurrent ticks -> execution is finished only once", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultPassed)
+
+		//
+		// Each replica has its own poller.
+		//
+		tickConcurrently(t, 5, func() error {
+			return NewExecutionPoller(encryptor).Tick()
+		})
+
+		e, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, e.State)
+		assert.Equal(t, models.StageExecutionResultPassed, e.Result)
+
+		list, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+
+	t.Run("execution started without reference ID -> fails after grace period", func(t *testing.T) {
+		//
+		// Execution is claimed, but the worker stops before calling the executor.
+		//
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.Claim())
+
+		now := time.Now()
+		w := NewExecutionPoller(encryptor)
+		w.nowFunc = func() time.Time { return now }
+
+		//
+		// Within the grace period, the executor might still be starting it.
+		//
+		require.NoError(t, w.Tick())
+		e, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, e.State)
+
+		//
+		// After the grace period, the execution fails.
+		//
+		now = now.Add(StartWithoutReferenceGracePeriod)
+		require.NoError(t, w.Tick())
+		e, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, e.State)
+		assert.Equal(t, models.StageExecutionResultFailed, e.Result)
+		assert.Equal(t, models.StageExecutionResultReasonError, e.ResultReason)
+	})
 }
 
 func unmarshalCompletionEvent(raw []byte) (*models.StageExecutionCompletion, error) {
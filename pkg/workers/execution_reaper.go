@@ -0,0 +1,120 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/executors"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+// ExecutionReaper fails executions that are running for longer
+// than the execution timeout configured on their stage.
+// Without it, an execution that never finishes blocks the stage forever.
+type ExecutionReaper struct {
+	nowFunc   func() time.Time
+	encryptor crypto.Encryptor
+}
+
+func NewExecutionReaper(nowFunc func() time.Time, encryptor crypto.Encryptor) (*ExecutionReaper, error) {
+	if nowFunc == nil {
+		return nil, fmt.Errorf("nowFunc is required")
+	}
+
+	if encryptor == nil {
+		return nil, fmt.Errorf("encryptor is required")
+	}
+
+	return &ExecutionReaper{nowFunc: nowFunc, encryptor: encryptor}, nil
+}
+
+func (w *ExecutionReaper) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, 30*time.Second, func() {
+		if err := w.Tick(); err != nil {
+			log.Errorf("Error reaping timed out executions: %v", err)
+		}
+	})
+
+	return nil
+}
+
+func (w *ExecutionReaper) Tick() error {
+	executions, err := models.ListTimedOutExecutions(w.nowFunc())
+	if err != nil {
+		return fmt.Errorf("error listing timed out executions: %v", err)
+	}
+
+	for _, execution := range executions {
+		e := execution
+		if err := w.ProcessExecution(&e); err != nil {
+			return fmt.Errorf("error processing execution %s: %v", e.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *ExecutionReaper) ProcessExecution(execution *models.StageExecution) error {
+	stage, err := models.FindStageByID(execution.StageID.String())
+	if err != nil {
+		return fmt.Errorf("error finding stage %s: %v", execution.StageID, err)
+	}
+
+	logger := logging.ForExecution(execution)
+
+	//
+	// Other reapers, or the execution poller, might be finishing
+	// the same execution at the same time, so it is locked first,
+	// in the state it was listed in, since pending executions also time out.
+	// If another worker holds the lock, or already moved it on, we skip it.
+	//
+	skipped := false
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockExecutionInState(tx, execution.ID, execution.State)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			skipped = true
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error locking execution: %v", err)
+		}
+
+		err = locked.FinishWithReasonInTransaction(tx, stage, models.StageExecutionResultFailed, models.StageExecutionResultReasonTimeout)
+		if err != nil {
+			return fmt.Errorf("error timing out execution: %v", err)
+		}
+
+		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), locked).PublishInTransaction(tx)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if skipped {
+		logger.Info("Finished by another worker - skipping")
+		return nil
+	}
+
+	logger.Infof("Execution timed out after %v", stage.ExecutionTimeoutDuration())
+
+	//
+	// We try to stop the execution in the executor, but even if that fails,
+	// it is already timed out here, so the stage can move on.
+	//
+	if err := executors.Cancel(w.encryptor, stage, execution); err != nil {
+		logger.Errorf("Error stopping timed out execution in executor: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/apis/semaphore"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	testconsumer "github.com/superplanehq/superplane/test/test_consumer"
+)
+
+func Test__ExecutionReaper(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{
+		Source:       true,
+		SemaphoreAPI: true,
+	})
+
+	defer r.Close()
+
+	connections := []models.StageConnection{
+		{
+			SourceID:   r.Source.ID,
+			SourceType: models.SourceTypeEventSource,
+		},
+	}
+
+	spec := support.ExecutorSpecWithURL(r.SemaphoreAPIMock.Server.URL)
+	err := r.Canvas.CreateStage("stage-with-timeout", r.User.String(), []models.StageCondition{}, spec, connections, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 60, models.RetryPolicy{})
+	require.NoError(t, err)
+	stage, err := r.Canvas.FindStageByName("stage-with-timeout")
+	require.NoError(t, err)
+
+	err = r.Canvas.CreateStage("stage-without-timeout", r.User.String(), []models.StageCondition{}, spec, connections, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+	require.NoError(t, err)
+	stageWithoutTimeout, err := r.Canvas.FindStageByName("stage-without-timeout")
+	require.NoError(t, err)
+
+	amqpURL, _ := config.RabbitMQURL()
+
+	t.Run("execution within timeout -> nothing happens", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		w, _ := NewExecutionReaper(func() time.Time {
+			return time.Now().Add(30 * time.Second)
+		}, &crypto.NoOpEncryptor{})
+
+		require.NoError(t, w.Tick())
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+		assert.Empty(t, execution.ResultReason)
+	})
+
+	t.Run("stage without timeout -> nothing happens", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		workflowID := uuid.New().String()
+		execution := support.CreateExecution(t, r.Source, stageWithoutTimeout)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		w, _ := NewExecutionReaper(func() time.Time {
+			return time.Now().Add(24 * time.Hour)
+		}, &crypto.NoOpEncryptor{})
+
+		require.NoError(t, w.Tick())
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+		require.NoError(t, execution.Finish(stageWithoutTimeout, models.StageExecutionResultPassed))
+	})
+
+	t.Run("execution past timeout -> execution fails with timeout reason", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		testconsumer := testconsumer.New(amqpURL, ExecutionFinishedRoutingKey)
+		testconsumer.Start()
+		defer testconsumer.Stop()
+
+		workflowID := uuid.New().String()
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultPassed)
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		w, _ := NewExecutionReaper(func() time.Time {
+			return time.Now().Add(2 * time.Minute)
+		}, &crypto.NoOpEncryptor{})
+
+		require.NoError(t, w.Tick())
+
+		//
+		// Execution is finished with the timeout reason,
+		// and the workflow is stopped in Semaphore.
+		//
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultFailed, execution.Result)
+		assert.Equal(t, models.StageExecutionResultReasonTimeout, execution.ResultReason)
+		assert.Contains(t, r.SemaphoreAPIMock.StoppedWorkflows, workflowID)
+
+		//
+		// Stage completion event includes the reason.
+		//
+		list, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		e, err := unmarshalCompletionEvent(list[0].Raw)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionResultFailed, e.Execution.Result)
+		assert.Equal(t, models.StageExecutionResultReasonTimeout, e.Execution.ResultReason)
+		support.RelayOutbox(t)
+		require.True(t, testconsumer.HasReceivedMessage())
+	})
+
+	t.Run("pending execution past timeout -> execution fails with timeout reason", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		execution := support.CreateExecution(t, r.Source, stage)
+		w, _ := NewExecutionReaper(func() time.Time {
+			return time.Now().Add(2 * time.Minute)
+		}, &crypto.NoOpEncryptor{})
+
+		require.NoError(t, w.Tick())
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultFailed, execution.Result)
+		assert.Equal(t, models.StageExecutionResultReasonTimeout, execution.ResultReason)
+
+		list, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+
+	t.Run("concurrent ticks -> execution is timed out only once", func(t *testing.T) {
+		require.NoError(t, database.Conn().Exec(`truncate table events`).Error)
+
+		workflowID := uuid.New().String()
+		r.SemaphoreAPIMock.AddPipeline(uuid.New().String(), workflowID, semaphore.PipelineResultPassed)
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, execution.StartWithReferenceID(workflowID))
+
+		w, _ := NewExecutionReaper(func() time.Time {
+			return time.Now().Add(2 * time.Minute)
+		}, &crypto.NoOpEncryptor{})
+
+		tickConcurrently(t, 5, w.Tick)
+
+		execution, err := models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionResultReasonTimeout, execution.ResultReason)
+
+		list, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+}
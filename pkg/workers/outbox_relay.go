@@ -0,0 +1,100 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+const (
+	OutboxRelayBatchSize  = 100
+	OutboxRelayMaxBackoff = 5 * time.Minute
+	OutboxRetention       = 24 * time.Hour
+)
+
+// OutboxRelay publishes the messages written to the outbox.
+// Messages are locked while they are being published, so many relays can run at once,
+// but a message can still be published more than once if the relay dies
+// before the transaction that marks it as sent commits.
+type OutboxRelay struct {
+	nowFunc func() time.Time
+	publish func(exchange, routingKey string, body []byte) error
+}
+
+func NewOutboxRelay(nowFunc func() time.Time) (*OutboxRelay, error) {
+	if nowFunc == nil {
+		return nil, fmt.Errorf("nowFunc is required")
+	}
+
+	return &OutboxRelay{nowFunc: nowFunc, publish: messages.Publish}, nil
+}
+
+func (w *OutboxRelay) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, time.Second, func() {
+		if err := w.Tick(); err != nil {
+			log.Errorf("Error relaying outbox messages: %v", err)
+		}
+	})
+
+	return nil
+}
+
+func (w *OutboxRelay) Tick() error {
+	now := w.nowFunc()
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		outboxMessages, err := models.LockUnsentOutboxMessages(tx, now, OutboxRelayBatchSize)
+		if err != nil {
+			return fmt.Errorf("error locking outbox messages: %v", err)
+		}
+
+		for _, message := range outboxMessages {
+			m := message
+			if err := w.relay(tx, &m, now); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := models.DeleteSentOutboxMessages(now.Add(-OutboxRetention)); err != nil {
+		return fmt.Errorf("error deleting sent outbox messages: %v", err)
+	}
+
+	return nil
+}
+
+// A message that cannot be published is retried later, with an exponential backoff,
+// and does not block the messages after it.
+func (w *OutboxRelay) relay(tx *gorm.DB, message *models.OutboxMessage, now time.Time) error {
+	err := w.publish(message.Exchange, message.RoutingKey, message.Body)
+	if err == nil {
+		return message.MarkAsSentInTransaction(tx, now)
+	}
+
+	nextAttemptAt := now.Add(outboxBackoff(message.Attempts))
+	log.Errorf("Error publishing outbox message %s - retrying at %v: %v", message.ID, nextAttemptAt, err)
+	return message.MarkAsFailedInTransaction(tx, err.Error(), nextAttemptAt)
+}
+
+func outboxBackoff(attempts int) time.Duration {
+	backoff := time.Duration(math.Pow(2, float64(attempts))) * time.Second
+	if backoff > OutboxRelayMaxBackoff || backoff <= 0 {
+		return OutboxRelayMaxBackoff
+	}
+
+	return backoff
+}
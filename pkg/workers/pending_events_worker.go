@@ -1,6 +1,8 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/inputs"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
@@ -16,15 +19,14 @@ import (
 
 type PendingEventsWorker struct{}
 
-func (w *PendingEventsWorker) Start() {
-	for {
-		err := w.Tick()
-		if err != nil {
+func (w *PendingEventsWorker) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, time.Second, func() {
+		if err := w.Tick(); err != nil {
 			log.Errorf("Error processing pending events: %v", err)
 		}
+	})
 
-		time.Sleep(time.Second)
-	}
+	return nil
 }
 
 func (w *PendingEventsWorker) Tick() error {
@@ -58,12 +60,19 @@ func (w *PendingEventsWorker) ProcessEvent(logger *log.Entry, event *models.Even
 		return fmt.Errorf("error listing connections: %v", err)
 	}
 
+	//
+	// Replays can target a single stage.
+	//
+	if event.ReplayStageID != nil {
+		connections = w.connectionsForStage(*event.ReplayStageID, connections)
+	}
+
 	//
 	// If the source is not connected to any stage, we discard the event.
 	//
 	if len(connections) == 0 {
 		logger.Info("Unconnected source - discarding")
-		err := event.Discard()
+		err := w.withEventLock(logger, event, event.DiscardInTransaction)
 		if err != nil {
 			return fmt.Errorf("error discarding event: %v", err)
 		}
@@ -82,9 +91,21 @@ func (w *PendingEventsWorker) ProcessEvent(logger *log.Entry, event *models.Even
 
 	logger.Infof("Connected stages: %v", stageIDs)
 
-	stages, err = w.filterStages(logger, event, stages, connections)
-	if err != nil {
-		return fmt.Errorf("error applying filters: %v", err)
+	//
+	// Re-delivered events skip the current filters and input mappings,
+	// and go to the stages that received the original event, with the same inputs.
+	//
+	var previousInputs map[uuid.UUID]map[string]any
+	if event.IsRedelivery() {
+		stages, previousInputs, err = w.redeliveredStages(logger, event, stages)
+		if err != nil {
+			return fmt.Errorf("error finding stages for re-delivery: %v", err)
+		}
+	} else {
+		stages, err = w.filterStages(logger, event, stages, connections)
+		if err != nil {
+			return fmt.Errorf("error applying filters: %v", err)
+		}
 	}
 
 	//
@@ -94,7 +115,7 @@ func (w *PendingEventsWorker) ProcessEvent(logger *log.Entry, event *models.Even
 	//
 	if len(stages) == 0 {
 		logger.Info("No connections after filtering")
-		err := event.MarkAsProcessed()
+		err := w.withEventLock(logger, event, event.MarkAsProcessedInTransaction)
 		if err != nil {
 			return fmt.Errorf("error discarding event: %v", err)
 		}
@@ -102,7 +123,10 @@ func (w *PendingEventsWorker) ProcessEvent(logger *log.Entry, event *models.Even
 		return nil
 	}
 
-	err = w.enqueueEvent(event, stages)
+	err = w.withEventLock(logger, event, func(tx *gorm.DB) error {
+		return w.enqueueEvent(tx, event, stages, previousInputs)
+	})
+
 	if err != nil {
 		return err
 	}
@@ -121,6 +145,44 @@ func findConnectionForStage(stageID string, connections []models.StageConnection
 	return models.StageConnection{}, fmt.Errorf("connection not found for stage ID: %s", stageID)
 }
 
+func (w *PendingEventsWorker) connectionsForStage(stageID uuid.UUID, connections []models.StageConnection) []models.StageConnection {
+	filtered := []models.StageConnection{}
+	for _, connection := range connections {
+		if connection.StageID == stageID {
+			filtered = append(filtered, connection)
+		}
+	}
+
+	return filtered
+}
+
+// Only the stages that received the original event are used,
+// with the inputs recorded for the original event on each of them.
+func (w *PendingEventsWorker) redeliveredStages(logger *log.Entry, event *models.Event, stages []models.Stage) ([]models.Stage, map[uuid.UUID]map[string]any, error) {
+	stageEvents, err := models.ListStageEventsForEvent(*event.ReplayOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previousInputs := map[uuid.UUID]map[string]any{}
+	for _, stageEvent := range stageEvents {
+		previousInputs[stageEvent.StageID] = stageEvent.Inputs.Data()
+	}
+
+	filtered := []models.Stage{}
+	for _, stage := range stages {
+		if _, ok := previousInputs[stage.ID]; !ok {
+			logger.Infof("Not sending to stage %s - original event %s was not sent to it", stage.ID, *event.ReplayOf)
+			continue
+		}
+
+		logger.Infof("Re-delivering to stage %s", stage.ID)
+		filtered = append(filtered, stage)
+	}
+
+	return filtered, previousInputs, nil
+}
+
 func (w *PendingEventsWorker) filterStages(logger *log.Entry, event *models.Event, stages []models.Stage, connections []models.StageConnection) ([]models.Stage, error) {
 	filtered := []models.Stage{}
 
@@ -151,31 +213,59 @@ func (w *PendingEventsWorker) filterStages(logger *log.Entry, event *models.Even
 	return filtered, nil
 }
 
-func (w *PendingEventsWorker) enqueueEvent(event *models.Event, stages []models.Stage) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
-		for _, stage := range stages {
-			inputs, err := w.buildInputs(tx, event, stage)
-			if err != nil {
-				return err
-			}
+// Other workers might be processing the same event at the same time,
+// so the event is locked before anything is written for it.
+// If another worker holds the lock, or already processed the event, we skip it.
+func (w *PendingEventsWorker) withEventLock(logger *log.Entry, event *models.Event, f func(tx *gorm.DB) error) error {
+	skipped := false
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		_, err := models.LockPendingEvent(tx, event.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			skipped = true
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error locking event: %v", err)
+		}
+
+		return f(tx)
+	})
 
-			stageEvent, err := models.CreateStageEventInTransaction(tx, stage.ID, event, models.StageEventStatePending, "", inputs)
+	if skipped {
+		logger.Info("Processed by another worker - skipping")
+	}
+
+	return err
+}
+
+func (w *PendingEventsWorker) enqueueEvent(tx *gorm.DB, event *models.Event, stages []models.Stage, previousInputs map[uuid.UUID]map[string]any) error {
+	for _, stage := range stages {
+		inputs, ok := previousInputs[stage.ID]
+		if !ok {
+			var err error
+			inputs, err = w.buildInputs(tx, event, stage)
 			if err != nil {
 				return err
 			}
+		}
 
-			err = messages.NewStageEventCreatedMessage(stage.CanvasID.String(), stageEvent).Publish()
-			if err != nil {
-				logging.ForStage(&stage).Errorf("failed to publish stage event created message: %v", err)
-			}
+		stageEvent, err := models.CreateStageEventInTransaction(tx, stage.ID, event, models.StageEventStatePending, "", inputs)
+		if err != nil {
+			return err
 		}
 
-		if err := event.MarkAsProcessedInTransaction(tx); err != nil {
-			return fmt.Errorf("error enqueueing event %s: %v", event.ID, err)
+		err = messages.NewStageEventCreatedMessage(stage.CanvasID.String(), stageEvent).PublishInTransaction(tx)
+		if err != nil {
+			return fmt.Errorf("error publishing stage event created message: %v", err)
 		}
+	}
 
-		return nil
-	})
+	if err := event.MarkAsProcessedInTransaction(tx); err != nil {
+		return fmt.Errorf("error enqueueing event %s: %v", event.ID, err)
+	}
+
+	return nil
 }
 
 func (w *PendingEventsWorker) buildInputs(tx *gorm.DB, event *models.Event, stage models.Stage) (map[string]any, error) {
@@ -1,8 +1,10 @@
 package workers
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/config"
@@ -60,7 +62,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 
@@ -87,7 +89,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 		amqpURL, _ := config.RabbitMQURL()
@@ -127,6 +129,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, stage2Events, 1)
 		assert.Equal(t, r.Source.ID, stage2Events[0].SourceID)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 		assert.Equal(t, map[string]any{"VERSION": "v1"}, stage1Events[0].Inputs.Data())
 	})
@@ -165,7 +168,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 				Name:     "VERSION",
 				Required: true,
 			},
-		}, []models.ValueDefinition{})
+		}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 		firstStage, err := r.Canvas.FindStageByName("stage-3")
@@ -194,7 +197,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 
@@ -247,7 +250,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 
@@ -265,7 +268,7 @@ func Test__PendingEventsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{})
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
 
 		require.NoError(t, err)
 
@@ -299,4 +302,180 @@ func Test__PendingEventsWorker(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, events, 0)
 	})
+
+	t.Run("concurrent ticks -> event is added to stage queue only once", func(t *testing.T) {
+		source, err := r.Canvas.CreateEventSource("concurrent-source", []byte("my-key"), models.EventSourceSpec{})
+		require.NoError(t, err)
+
+		err = r.Canvas.CreateStage("concurrent-stage", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+
+		require.NoError(t, err)
+		stage, err := r.Canvas.FindStageByName("concurrent-stage")
+		require.NoError(t, err)
+
+		event, err := models.CreateEvent(source.ID, source.Name, models.SourceTypeEventSource, eventData, eventHeaders)
+		require.NoError(t, err)
+
+		tickConcurrently(t, 5, w.Tick)
+
+		event, err = models.FindEventByID(event.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EventStateProcessed, event.State)
+
+		stageEvents, err := stage.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 1)
+	})
+}
+
+func Test__PendingEventsWorker__Replays(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{Source: true})
+	w := PendingEventsWorker{}
+
+	connections := []models.StageConnection{
+		{
+			SourceID:   r.Source.ID,
+			SourceType: models.SourceTypeEventSource,
+		},
+	}
+
+	inputs := []models.InputDefinition{{Name: "VERSION"}}
+	inputMappingFromRef := []models.InputMapping{
+		{
+			Values: []models.ValueDefinition{
+				{
+					Name: "VERSION",
+					ValueFrom: &models.ValueDefinitionFrom{
+						EventData: &models.ValueDefinitionFromEventData{
+							Connection: r.Source.Name,
+							Expression: "ref",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, name := range []string{"stage-1", "stage-2"} {
+		err := r.Canvas.CreateStage(name, r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), connections, inputs, inputMappingFromRef, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+		require.NoError(t, err)
+	}
+
+	stage1, err := r.Canvas.FindStageByName("stage-1")
+	require.NoError(t, err)
+	stage2, err := r.Canvas.FindStageByName("stage-2")
+	require.NoError(t, err)
+
+	original, err := models.CreateEvent(r.Source.ID, r.Source.Name, models.SourceTypeEventSource, []byte(`{"ref":"v1"}`), []byte(`{}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Tick())
+
+	//
+	// After the original event is processed, the first stage
+	// maps its input to a constant, and a new stage is connected to the source.
+	//
+	newVersion := "v2"
+	err = r.Canvas.UpdateStage(stage1.ID.String(), r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), connections, inputs, []models.InputMapping{
+		{
+			Values: []models.ValueDefinition{{Name: "VERSION", Value: &newVersion}},
+		},
+	}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+	require.NoError(t, err)
+
+	err = r.Canvas.CreateStage("stage-3", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), connections, inputs, inputMappingFromRef, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{})
+	require.NoError(t, err)
+	stage3, err := r.Canvas.FindStageByName("stage-3")
+	require.NoError(t, err)
+
+	t.Run("re-delivery to a single stage -> only that stage receives it, with the original inputs", func(t *testing.T) {
+		replay, err := original.CreateReplay(&stage1.ID, false, r.User)
+		require.NoError(t, err)
+		require.NoError(t, w.Tick())
+
+		replay, err = models.FindEventByID(replay.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EventStateProcessed, replay.State)
+
+		stageEvents, err := stage1.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 2)
+		assert.Equal(t, replay.ID, stageEvents[0].EventID)
+		assert.Equal(t, map[string]any{"VERSION": "v1"}, stageEvents[0].Inputs.Data())
+
+		stageEvents, err = stage2.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 1)
+	})
+
+	t.Run("re-delivery to all stages -> stages that did not receive the original are skipped", func(t *testing.T) {
+		_, err := original.CreateReplay(nil, false, r.User)
+		require.NoError(t, err)
+		require.NoError(t, w.Tick())
+
+		stageEvents, err := stage1.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 3)
+
+		stageEvents, err = stage2.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 2)
+
+		stageEvents, err = stage3.ListPendingEvents()
+		require.NoError(t, err)
+		require.Empty(t, stageEvents)
+	})
+
+	t.Run("replay with current spec -> current connections and input mappings are used", func(t *testing.T) {
+		_, err := original.CreateReplay(nil, true, r.User)
+		require.NoError(t, err)
+		require.NoError(t, w.Tick())
+
+		stageEvents, err := stage1.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 4)
+		assert.Equal(t, map[string]any{"VERSION": "v2"}, stageEvents[0].Inputs.Data())
+
+		stageEvents, err = stage3.ListPendingEvents()
+		require.NoError(t, err)
+		require.Len(t, stageEvents, 1)
+		assert.Equal(t, map[string]any{"VERSION": "v1"}, stageEvents[0].Inputs.Data())
+	})
+
+	t.Run("replay targets a stage that is not connected -> event is discarded", func(t *testing.T) {
+		otherStage := uuid.New()
+		replay, err := original.CreateReplay(&otherStage, true, r.User)
+		require.NoError(t, err)
+		require.NoError(t, w.Tick())
+
+		replay, err = models.FindEventByID(replay.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EventStateDiscarded, replay.State)
+	})
+}
+
+// tickConcurrently runs the same worker tick from many goroutines at once,
+// like many replicas of the worker would do.
+func tickConcurrently(t *testing.T, n int, tick func() error) {
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- tick()
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
 }
@@ -1,16 +1,21 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/executors"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
 )
 
 type PendingExecutionsWorker struct {
@@ -19,19 +24,18 @@ type PendingExecutionsWorker struct {
 	SpecBuilder executors.SpecBuilder
 }
 
-func (w *PendingExecutionsWorker) Start() {
-	for {
-		err := w.Tick()
-		if err != nil {
+func (w *PendingExecutionsWorker) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, time.Second, func() {
+		if err := w.Tick(); err != nil {
 			log.Errorf("Error processing pending executions: %v", err)
 		}
+	})
 
-		time.Sleep(time.Second)
-	}
+	return nil
 }
 
 func (w *PendingExecutionsWorker) Tick() error {
-	executions, err := models.ListStageExecutionsInState(models.StageExecutionPending)
+	executions, err := models.ListPendingStageExecutions(time.Now())
 	if err != nil {
 		return fmt.Errorf("error listing pending stage executions: %v", err)
 	}
@@ -51,9 +55,6 @@ func (w *PendingExecutionsWorker) Tick() error {
 	return nil
 }
 
-// TODO
-// There is an issue here where, if we are having issues updating the state of the execution in the database,
-// we might end up creating more executions than we should.
 func (w *PendingExecutionsWorker) ProcessExecution(logger *log.Entry, stage *models.Stage, execution models.StageExecution) error {
 	inputMap, err := execution.GetInputs()
 	if err != nil {
@@ -70,12 +71,21 @@ func (w *PendingExecutionsWorker) ProcessExecution(logger *log.Entry, stage *mod
 		return err
 	}
 
-	executor, err := executors.NewExecutor(spec.Type, execution, w.JwtSigner)
+	executor, err := executors.NewExecutor(spec.Type, execution, w.JwtSigner, w.Encryptor)
 	if err != nil {
 		return fmt.Errorf("error creating executor: %v", err)
 	}
 
-	err = execution.Start()
+	//
+	// Other workers might be processing the same execution at the same time,
+	// so we claim it before calling the executor, to only run it once.
+	//
+	err = execution.Claim()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Infof("Execution %s claimed by another worker - skipping", execution.ID)
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error moving execution to started state: %v", err)
 	}
@@ -86,15 +96,18 @@ func (w *PendingExecutionsWorker) ProcessExecution(logger *log.Entry, stage *mod
 	response, err := executor.Execute(*spec)
 	if err != nil {
 		logger.Errorf("Error calling executor: %v - failing execution", err)
-		err := execution.Finish(stage, models.StageExecutionResultFailed)
-		if err != nil {
-			return fmt.Errorf("error moving execution to failed state: %v", err)
-		}
-
-		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), &execution).Publish()
+		return database.Conn().Transaction(func(tx *gorm.DB) error {
+			err := execution.FinishWithReasonInTransaction(tx, stage, models.StageExecutionResultFailed, models.StageExecutionResultReasonError)
+			if err != nil {
+				return fmt.Errorf("error moving execution to failed state: %v", err)
+			}
 
+			return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), &execution).PublishInTransaction(tx)
+		})
 	}
 
+	storeExecutorLogs(logger, execution, response)
+
 	if response.Finished() {
 		return w.handleSyncResource(logger, response, execution, stage)
 	}
@@ -124,28 +137,56 @@ func (w *PendingExecutionsWorker) handleSyncResource(logger *log.Entry, response
 		result = models.StageExecutionResultFailed
 	}
 
-	err := execution.Finish(stage, result)
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		if err := execution.FinishInTransaction(tx, stage, result); err != nil {
+			return err
+		}
+
+		return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), &execution).PublishInTransaction(tx)
+	})
+
 	if err != nil {
 		return err
 	}
 
 	logger.Infof("Finished execution: %s", result)
-
-	return messages.NewExecutionFinishedMessage(stage.CanvasID.String(), &execution).Publish()
+	return nil
 }
 
 func (w *PendingExecutionsWorker) handleAsyncResource(logger *log.Entry, response executors.Response, stage *models.Stage, execution models.StageExecution) error {
-	err := execution.StartWithReferenceID(response.Id())
-	if err != nil {
-		return fmt.Errorf("error moving execution to started state: %v", err)
-	}
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := execution.StartWithReferenceIDInTransaction(tx, response.Id())
+		if err != nil {
+			return fmt.Errorf("error moving execution to started state: %v", err)
+		}
+
+		err = messages.NewExecutionStartedMessage(stage.CanvasID.String(), &execution).PublishInTransaction(tx)
+		if err != nil {
+			return fmt.Errorf("error publishing execution started message: %v", err)
+		}
+
+		return nil
+	})
 
-	err = messages.NewExecutionStartedMessage(stage.CanvasID.String(), &execution).Publish()
 	if err != nil {
-		return fmt.Errorf("error publishing execution started message: %v", err)
+		return err
 	}
 
 	logger.Infof("Started execution %s", response.Id())
 
 	return nil
 }
+
+// Failing to store the logs does not fail the execution,
+// since the executor already did its work.
+func storeExecutorLogs(logger *log.Entry, execution models.StageExecution, response executors.Response) {
+	r, ok := response.(executors.LoggingResponse)
+	if !ok {
+		return
+	}
+
+	err := models.CreateExecutionLogs(execution.ID, r.Logs())
+	if err != nil {
+		logger.Errorf("Error storing executor logs: %v", err)
+	}
+}
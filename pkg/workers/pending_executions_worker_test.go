@@ -1,6 +1,9 @@
 package workers
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"testing"
 
@@ -43,7 +46,7 @@ func Test__PendingExecutionsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-task")
 
@@ -68,6 +71,7 @@ func Test__PendingExecutionsWorker(t *testing.T) {
 		assert.Equal(t, models.StageExecutionStarted, execution.State)
 		assert.NotEmpty(t, execution.ReferenceID)
 		assert.NotEmpty(t, execution.StartedAt)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 
 		req := r.SemaphoreAPIMock.LastTaskTrigger
@@ -122,7 +126,7 @@ func Test__PendingExecutionsWorker(t *testing.T) {
 					},
 				},
 			},
-		}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-task-2")
 		require.NoError(t, err)
@@ -152,6 +156,7 @@ func Test__PendingExecutionsWorker(t *testing.T) {
 		assert.Equal(t, models.StageExecutionStarted, execution.State)
 		assert.NotEmpty(t, execution.ReferenceID)
 		assert.NotEmpty(t, execution.StartedAt)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 
 		req := r.SemaphoreAPIMock.LastTaskTrigger
@@ -163,6 +168,110 @@ func Test__PendingExecutionsWorker(t *testing.T) {
 			"REF_TYPE": "branch",
 		})
 	})
+
+	t.Run("HTTP request is finished right away and summarized in the logs", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		defer server.Close()
+
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeHTTP,
+			HTTP: &models.HTTPExecutorSpec{
+				URL:            server.URL,
+				ResponsePolicy: &models.HTTPResponsePolicy{StatusCodes: []uint32{200}},
+			},
+		}
+
+		require.NoError(t, r.Canvas.CreateStage("stage-http", r.User.String(), []models.StageCondition{}, spec, []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-http")
+		require.NoError(t, err)
+
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		execution, err = stage.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultPassed, execution.Result)
+
+		logs, err := models.ListExecutionLogs(execution.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, models.ExecutionLogStreamSystem, logs[0].Stream)
+		assert.Equal(t, "POST "+server.URL+"\nResponse: 200 OK\n", logs[0].Content)
+	})
+
+	t.Run("noop stage passes right away and emits completion event with outputs", func(t *testing.T) {
+		spec := models.ExecutorSpec{
+			Type: models.ExecutorSpecTypeNoop,
+			Noop: &models.NoopExecutorSpec{
+				Outputs: map[string]string{"APPROVED": "yes"},
+			},
+		}
+
+		require.NoError(t, r.Canvas.CreateStage("stage-gate", r.User.String(), []models.StageCondition{}, spec, []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{
+			{Name: "APPROVED", Required: true},
+		}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-gate")
+		require.NoError(t, err)
+
+		execution := support.CreateExecution(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		execution, err = stage.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionFinished, execution.State)
+		assert.Equal(t, models.StageExecutionResultPassed, execution.Result)
+		assert.Equal(t, map[string]any{"APPROVED": "yes"}, execution.Outputs.Data())
+
+		list, err := models.ListEventsBySourceID(stage.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		e := models.StageExecutionCompletion{}
+		require.NoError(t, json.Unmarshal(list[0].Raw, &e))
+		assert.Equal(t, models.StageExecutionCompletionType, e.Type)
+		assert.Equal(t, models.StageExecutionResultPassed, e.Execution.Result)
+		assert.Equal(t, map[string]any{"APPROVED": "yes"}, e.Outputs)
+	})
+
+	t.Run("concurrent ticks -> execution is started only once", func(t *testing.T) {
+		spec := support.ExecutorSpecWithURL(r.SemaphoreAPIMock.Server.URL)
+		require.NoError(t, r.Canvas.CreateStage("stage-concurrent-ticks", r.User.String(), []models.StageCondition{}, spec, []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-concurrent-ticks")
+		require.NoError(t, err)
+
+		execution := support.CreateExecution(t, r.Source, stage)
+		triggered := r.SemaphoreAPIMock.TaskTriggers
+
+		tickConcurrently(t, 5, w.Tick)
+
+		execution, err = stage.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StageExecutionStarted, execution.State)
+		assert.NotEmpty(t, execution.ReferenceID)
+		assert.Equal(t, triggered+1, r.SemaphoreAPIMock.TaskTriggers)
+	})
 }
 
 func assertParameters(t *testing.T, trigger *semaphore.TaskTrigger, execution *models.StageExecution, parameters map[string]string) {
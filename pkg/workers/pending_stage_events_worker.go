@@ -1,39 +1,48 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/executors"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
 
 type PendingStageEventsWorker struct {
-	nowFunc func() time.Time
+	nowFunc   func() time.Time
+	encryptor crypto.Encryptor
 }
 
-func NewPendingStageEventsWorker(nowFunc func() time.Time) (*PendingStageEventsWorker, error) {
+func NewPendingStageEventsWorker(nowFunc func() time.Time, encryptor crypto.Encryptor) (*PendingStageEventsWorker, error) {
 	if nowFunc == nil {
 		return nil, fmt.Errorf("nowFunc is required")
 	}
 
-	return &PendingStageEventsWorker{nowFunc: nowFunc}, nil
+	if encryptor == nil {
+		return nil, fmt.Errorf("encryptor is required")
+	}
+
+	return &PendingStageEventsWorker{nowFunc: nowFunc, encryptor: encryptor}, nil
 }
 
-func (w *PendingStageEventsWorker) Start() {
-	for {
-		err := w.Tick()
-		if err != nil {
+func (w *PendingStageEventsWorker) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, time.Second, func() {
+		if err := w.Tick(); err != nil {
 			log.Errorf("Error processing pending events: %v", err)
 		}
+	})
 
-		time.Sleep(time.Second)
-	}
+	return nil
 }
 
 func (w *PendingStageEventsWorker) Tick() error {
@@ -66,31 +75,187 @@ func (w *PendingStageEventsWorker) ProcessStage(stageID uuid.UUID) error {
 	}
 
 	//
-	// For each stage, we are only interested in the oldest pending event.
+	// If newer events supersede older ones for this stage,
+	// we only need to process the newest pending event.
+	//
+	if stage.SupersedesOlderEvents() {
+		return w.ProcessNewestEvent(stage)
+	}
+
+	//
+	// For each stage, we are only interested in the oldest pending events.
+	// Since at most MaxConcurrentExecutions executions can be created for it,
+	// there is no point in looking at more events than that.
 	//
-	event, err := models.FindOldestPendingStageEvent(stageID)
+	events, err := models.ListOldestPendingStageEvents(stageID, stage.ConcurrencyLimit())
 	if err != nil {
 		return fmt.Errorf("error listing pending events for stage")
 	}
 
-	return w.ProcessEvent(stage, event)
+	for _, event := range events {
+		e := event
+		err := w.ProcessEvent(stage, &e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PendingStageEventsWorker) ProcessNewestEvent(stage *models.Stage) error {
+	logger := logging.ForStage(stage)
+
+	//
+	// Pending events are sorted by creation time, newest first.
+	//
+	events, err := stage.ListPendingEvents()
+	if err != nil {
+		return fmt.Errorf("error listing pending events for stage")
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	newest := events[0]
+	superseded := []string{}
+	for _, event := range events[1:] {
+		superseded = append(superseded, event.ID.String())
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		err := lockStageAndEvent(tx, stage, &newest)
+		if err != nil {
+			return err
+		}
+
+		//
+		// Older events waiting for approval or for a time window
+		// are discarded too, since they would not run anymore.
+		//
+		waiting, err := stage.ListEventsInTransaction(tx, []string{models.StageEventStateWaiting}, []string{
+			models.StageEventStateReasonApproval,
+			models.StageEventStateReasonTimeWindow,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing waiting events: %v", err)
+		}
+
+		for _, event := range waiting {
+			if event.CreatedAt.Before(*newest.CreatedAt) {
+				superseded = append(superseded, event.ID.String())
+			}
+		}
+
+		if len(superseded) == 0 {
+			return nil
+		}
+
+		err = models.UpdateStageEventsInTransaction(
+			tx, superseded, models.StageEventStateProcessed, models.StageEventStateReasonCancelled,
+		)
+
+		if err != nil {
+			return fmt.Errorf("error cancelling superseded events: %v", err)
+		}
+
+		logger.Infof("Cancelled %d events superseded by %s", len(superseded), newest.ID)
+		return nil
+	})
+
+	if errors.Is(err, errStageBusy) {
+		logger.Infof("Stage is being processed by another worker - skipping")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return w.ProcessEvent(stage, &newest)
+}
+
+// With the cancel-running queue policy, executions in progress are for older events,
+// so they are cancelled when an execution for a newer event is about to be created.
+// Executions being finished by other workers are skipped,
+// since they will not be in progress anymore.
+func cancelRunningExecutionsInTransaction(tx *gorm.DB, logger *log.Entry, stage *models.Stage, event *models.StageEvent) ([]models.StageExecution, error) {
+	executions, err := models.ListExecutionsInStateInTransaction(tx, stage.ID, []string{
+		models.StageExecutionPending,
+		models.StageExecutionStarted,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing executions in progress: %v", err)
+	}
+
+	cancelled := []models.StageExecution{}
+	for _, execution := range executions {
+		e, err := models.LockExecutionInState(tx, execution.ID, execution.State)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error locking execution %s: %v", execution.ID, err)
+		}
+
+		if err := e.FinishInTransaction(tx, stage, models.StageExecutionResultCancelled); err != nil {
+			return nil, fmt.Errorf("error cancelling execution %s: %v", e.ID, err)
+		}
+
+		err = messages.NewExecutionFinishedMessage(stage.CanvasID.String(), e).PublishInTransaction(tx)
+		if err != nil {
+			return nil, fmt.Errorf("error publishing execution finished message: %v", err)
+		}
+
+		cancelled = append(cancelled, *e)
+		logger.Infof("Cancelled execution %s superseded by %s", e.ID, event.ID)
+	}
+
+	return cancelled, nil
+}
+
+// The executors are only called after the executions are cancelled here,
+// so nothing is stopped remotely if the cancellation is rolled back.
+// If the executor is unable to stop the execution, we only log it,
+// since the newest event should not wait for it.
+func (w *PendingStageEventsWorker) stopCancelledExecutions(logger *log.Entry, stage *models.Stage, executions []models.StageExecution) {
+	for _, execution := range executions {
+		e := execution
+		if err := executors.Cancel(w.encryptor, stage, &e); err != nil {
+			logger.Errorf("Error stopping execution %s in executor: %v", e.ID, err)
+		}
+	}
 }
 
 func (w *PendingStageEventsWorker) ProcessEvent(stage *models.Stage, event *models.StageEvent) error {
 	logger := logging.ForStageEvent(event)
 
 	//
-	// Check if another execution is already in progress.
+	// Check if the stage can still run more executions.
 	// TODO: this could probably be built into the query that we do above.
 	//
-	_, err := models.FindExecutionInState(event.StageID, []string{
+	inProgress, err := models.CountExecutionsInState(event.StageID, []string{
 		models.StageExecutionPending,
 		models.StageExecutionStarted,
 	})
 
+	if err != nil {
+		return fmt.Errorf("error counting executions in progress: %v", err)
+	}
+
+	//
+	// With the cancel-running queue policy, executions in progress
+	// are cancelled once this event is ready to run, so they don't block it.
+	//
+	cancelRunning := stage.QueuePolicy == models.StageQueuePolicyCancelRunning
+
 	// TODO: move to waiting state too?
-	if err == nil {
-		logger.Infof("Another execution is already in progress - skipping %s", event.ID)
+	if !cancelRunning && inProgress >= int64(stage.ConcurrencyLimit()) {
+		logger.Infof("Stage already has %d executions in progress - skipping %s", inProgress, event.ID)
 		return nil
 	}
 
@@ -112,8 +277,37 @@ func (w *PendingStageEventsWorker) ProcessEvent(stage *models.Stage, event *mode
 	// If we get here, we can start an execution for this event.
 	//
 	var execution *models.StageExecution
+	cancelled := []models.StageExecution{}
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
-		var err error
+		//
+		// Other workers might be creating executions for this stage at the same time,
+		// so we lock the stage and the event, and count the executions in progress again.
+		//
+		err := lockStageAndEvent(tx, stage, event)
+		if err != nil {
+			return err
+		}
+
+		if cancelRunning {
+			cancelled, err = cancelRunningExecutionsInTransaction(tx, logger, stage, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		inProgress, err := models.CountExecutionsInStateInTransaction(tx, event.StageID, []string{
+			models.StageExecutionPending,
+			models.StageExecutionStarted,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error counting executions in progress: %v", err)
+		}
+
+		if inProgress >= int64(stage.ConcurrencyLimit()) {
+			return errStageBusy
+		}
+
 		execution, err = models.CreateStageExecutionInTransaction(tx, stage.ID, event.ID)
 		if err != nil {
 			return fmt.Errorf("error creating stage execution: %v", err)
@@ -121,6 +315,11 @@ func (w *PendingStageEventsWorker) ProcessEvent(stage *models.Stage, event *mode
 
 		logger.Infof("Created stage execution %s", execution.ID)
 
+		err = messages.NewExecutionCreatedMessage(stage.CanvasID.String(), execution).PublishInTransaction(tx)
+		if err != nil {
+			return fmt.Errorf("error publishing execution created message: %v", err)
+		}
+
 		if err := event.UpdateStateInTransaction(tx, models.StageEventStateWaiting, models.StageEventStateReasonExecution); err != nil {
 			return fmt.Errorf("error updating event state: %v", err)
 		}
@@ -129,16 +328,44 @@ func (w *PendingStageEventsWorker) ProcessEvent(stage *models.Stage, event *mode
 		return nil
 	})
 
+	if errors.Is(err, errStageBusy) {
+		logger.Infof("Stage is busy or event was processed by another worker - skipping %s", event.ID)
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
 
-	err = messages.NewExecutionCreatedMessage(stage.CanvasID.String(), execution).Publish()
+	w.stopCancelledExecutions(logger, stage, cancelled)
+
+	logging.ForStage(stage).Infof("Created execution %s", execution.ID)
+	return nil
+}
+
+var errStageBusy = errors.New("stage is busy")
+
+// If another worker holds the lock for the stage or event,
+// or the event is not pending anymore, errStageBusy is returned.
+func lockStageAndEvent(tx *gorm.DB, stage *models.Stage, event *models.StageEvent) error {
+	_, err := models.LockStage(tx, stage.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errStageBusy
+	}
+
 	if err != nil {
-		logging.ForStage(stage).Errorf("failed to publish execution created message: %v", err)
+		return fmt.Errorf("error locking stage: %v", err)
+	}
+
+	_, err = models.LockPendingStageEvent(tx, event.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errStageBusy
+	}
+
+	if err != nil {
+		return fmt.Errorf("error locking stage event: %v", err)
 	}
 
-	logging.ForStage(stage).Infof("Created execution %s", execution.ID)
 	return nil
 }
 
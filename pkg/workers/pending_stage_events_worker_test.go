@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 	testconsumer "github.com/superplanehq/superplane/test/test_consumer"
@@ -19,7 +20,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 	r := support.SetupWithOptions(t, support.SetupOptions{Source: true})
 	w, _ := NewPendingStageEventsWorker(func() time.Time {
 		return time.Now()
-	})
+	}, &crypto.NoOpEncryptor{})
 
 	amqpURL, _ := config.RabbitMQURL()
 
@@ -32,7 +33,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-no-approval-1")
 		require.NoError(t, err)
@@ -61,6 +62,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		assert.Equal(t, execution.StageID, stage.ID)
 		assert.Equal(t, execution.StageEventID, event.ID)
 		assert.Equal(t, execution.State, models.StageExecutionPending)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
@@ -77,7 +79,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-with-approval-1")
 		require.NoError(t, err)
@@ -110,7 +112,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-with-approval-2")
 		require.NoError(t, err)
@@ -141,6 +143,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		assert.Equal(t, execution.StageID, stage.ID)
 		assert.Equal(t, execution.StageEventID, event.ID)
 		assert.Equal(t, execution.State, models.StageExecutionPending)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
@@ -163,7 +166,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-with-time-window")
 		require.NoError(t, err)
@@ -175,7 +178,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		require.NoError(t, event.Approve(uuid.New()))
 		w, _ := NewPendingStageEventsWorker(func() time.Time {
 			return time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
-		})
+		}, &crypto.NoOpEncryptor{})
 
 		err = w.Tick()
 		require.NoError(t, err)
@@ -212,7 +215,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-with-time-window-2")
 		require.NoError(t, err)
@@ -224,7 +227,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		require.NoError(t, event.Approve(uuid.New()))
 		w, _ := NewPendingStageEventsWorker(func() time.Time {
 			return time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
-		})
+		}, &crypto.NoOpEncryptor{})
 
 		err = w.Tick()
 		require.NoError(t, err)
@@ -243,6 +246,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		assert.Equal(t, execution.StageID, stage.ID)
 		assert.Equal(t, execution.StageEventID, event.ID)
 		assert.Equal(t, execution.State, models.StageExecutionPending)
+		support.RelayOutbox(t)
 		assert.True(t, testconsumer.HasReceivedMessage())
 	})
 
@@ -255,7 +259,7 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 				SourceID:   r.Source.ID,
 				SourceType: models.SourceTypeEventSource,
 			},
-		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 		stage, err := r.Canvas.FindStageByName("stage-no-approval-3")
 		require.NoError(t, err)
@@ -283,4 +287,275 @@ func Test__PendingStageEventsWorker(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, models.StageEventStatePending, event.State)
 	})
+
+	t.Run("stage allows concurrent executions -> multiple executions are created", func(t *testing.T) {
+		//
+		// Create stage that allows two executions at the same time.
+		//
+		require.NoError(t, r.Canvas.CreateStage("stage-concurrent", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 2, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-concurrent")
+		require.NoError(t, err)
+
+		//
+		// Create three pending stage events and trigger the worker.
+		// The first two should be moved to waiting(execution),
+		// and the third one should remain pending.
+		//
+		event1 := support.CreateStageEvent(t, r.Source, stage)
+		event2 := support.CreateStageEvent(t, r.Source, stage)
+		event3 := support.CreateStageEvent(t, r.Source, stage)
+		err = w.Tick()
+		require.NoError(t, err)
+
+		for _, e := range []*models.StageEvent{event1, event2} {
+			event, err := models.FindStageEventByID(e.ID.String(), stage.ID.String())
+			require.NoError(t, err)
+			require.Equal(t, models.StageEventStateWaiting, event.State)
+			require.Equal(t, models.StageEventStateReasonExecution, event.StateReason)
+		}
+
+		event, err := models.FindStageEventByID(event3.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStatePending, event.State)
+
+		//
+		// Ticking again does not create more executions,
+		// since the limit is already reached.
+		//
+		err = w.Tick()
+		require.NoError(t, err)
+		event, err = models.FindStageEventByID(event3.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStatePending, event.State)
+	})
+
+	t.Run("concurrent ticks -> concurrency limit is respected", func(t *testing.T) {
+		require.NoError(t, r.Canvas.CreateStage("stage-concurrent-ticks", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-concurrent-ticks")
+		require.NoError(t, err)
+
+		//
+		// Many workers processing the same stage at the same time
+		// still create only one execution, since the stage allows only one.
+		//
+		for i := 0; i < 3; i++ {
+			support.CreateStageEvent(t, r.Source, stage)
+		}
+
+		tickConcurrently(t, 5, w.Tick)
+
+		inProgress, err := models.CountExecutionsInState(stage.ID, []string{
+			models.StageExecutionPending,
+			models.StageExecutionStarted,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), inProgress)
+
+		pending, err := stage.ListPendingEvents()
+		require.NoError(t, err)
+		assert.Len(t, pending, 2)
+	})
+
+	t.Run("latest queue policy -> older pending events are cancelled", func(t *testing.T) {
+		require.NoError(t, r.Canvas.CreateStage("stage-latest", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyLatest, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-latest")
+		require.NoError(t, err)
+
+		//
+		// Create three pending stage events and trigger the worker.
+		// Only the newest one should get an execution,
+		// and the older ones should be cancelled.
+		//
+		event1 := support.CreateStageEvent(t, r.Source, stage)
+		event2 := support.CreateStageEvent(t, r.Source, stage)
+		event3 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		for _, e := range []*models.StageEvent{event1, event2} {
+			event, err := models.FindStageEventByID(e.ID.String(), stage.ID.String())
+			require.NoError(t, err)
+			require.Equal(t, models.StageEventStateProcessed, event.State)
+			require.Equal(t, models.StageEventStateReasonCancelled, event.StateReason)
+		}
+
+		event, err := models.FindStageEventByID(event3.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateWaiting, event.State)
+		require.Equal(t, models.StageEventStateReasonExecution, event.StateReason)
+		execution, err := models.FindExecutionByStageEventID(event3.ID)
+		require.NoError(t, err)
+
+		//
+		// New events while the execution is in progress
+		// do not affect the execution, but only the newest remains pending.
+		//
+		event4 := support.CreateStageEvent(t, r.Source, stage)
+		event5 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		event, err = models.FindStageEventByID(event4.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateProcessed, event.State)
+		require.Equal(t, models.StageEventStateReasonCancelled, event.StateReason)
+		event, err = models.FindStageEventByID(event5.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStatePending, event.State)
+		execution, err = models.FindExecutionByID(execution.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionPending, execution.State)
+	})
+
+	t.Run("cancel-running queue policy -> execution in progress is cancelled", func(t *testing.T) {
+		require.NoError(t, r.Canvas.CreateStage("stage-cancel-running", r.User.String(), []models.StageCondition{}, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyCancelRunning, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-cancel-running")
+		require.NoError(t, err)
+
+		event1 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+		execution1, err := models.FindExecutionByStageEventID(event1.ID)
+		require.NoError(t, err)
+
+		//
+		// A newer event arrives, so the execution for the older one is cancelled,
+		// and a new execution is created for the newer one.
+		//
+		event2 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		execution1, err = models.FindExecutionByID(execution1.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionFinished, execution1.State)
+		require.Equal(t, models.StageExecutionResultCancelled, execution1.Result)
+
+		event, err := models.FindStageEventByID(event1.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateProcessed, event.State)
+
+		event, err = models.FindStageEventByID(event2.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateWaiting, event.State)
+		require.Equal(t, models.StageEventStateReasonExecution, event.StateReason)
+		execution2, err := models.FindExecutionByStageEventID(event2.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionPending, execution2.State)
+	})
+
+	t.Run("cancel-running queue policy and newer event not approved -> execution in progress is not cancelled", func(t *testing.T) {
+		conditions := []models.StageCondition{
+			{Type: models.StageConditionTypeApproval, Approval: &models.ApprovalCondition{Count: 1}},
+		}
+
+		require.NoError(t, r.Canvas.CreateStage("stage-cancel-running-approval", r.User.String(), conditions, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyCancelRunning, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-cancel-running-approval")
+		require.NoError(t, err)
+
+		event1 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, event1.Approve(uuid.New()))
+		require.NoError(t, w.Tick())
+		execution1, err := models.FindExecutionByStageEventID(event1.ID)
+		require.NoError(t, err)
+
+		//
+		// A newer event arrives, but it is not approved yet,
+		// so the execution for the older one keeps running.
+		//
+		event2 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		execution1, err = models.FindExecutionByID(execution1.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionPending, execution1.State)
+
+		event, err := models.FindStageEventByID(event2.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateWaiting, event.State)
+		require.Equal(t, models.StageEventStateReasonApproval, event.StateReason)
+
+		//
+		// Once the newer event is approved,
+		// the execution for the older one is cancelled.
+		//
+		require.NoError(t, event.Approve(uuid.New()))
+		require.NoError(t, event.UpdateState(models.StageEventStatePending, ""))
+		require.NoError(t, w.Tick())
+
+		execution1, err = models.FindExecutionByID(execution1.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionFinished, execution1.State)
+		require.Equal(t, models.StageExecutionResultCancelled, execution1.Result)
+		execution2, err := models.FindExecutionByStageEventID(event2.ID)
+		require.NoError(t, err)
+		require.Equal(t, models.StageExecutionPending, execution2.State)
+	})
+
+	t.Run("latest queue policy and older event waiting for approval -> older event is cancelled", func(t *testing.T) {
+		conditions := []models.StageCondition{
+			{Type: models.StageConditionTypeApproval, Approval: &models.ApprovalCondition{Count: 1}},
+		}
+
+		require.NoError(t, r.Canvas.CreateStage("stage-latest-approval", r.User.String(), conditions, support.ExecutorSpec(), []models.StageConnection{
+			{
+				SourceID:   r.Source.ID,
+				SourceType: models.SourceTypeEventSource,
+			},
+		}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyLatest, 0, models.RetryPolicy{}))
+
+		stage, err := r.Canvas.FindStageByName("stage-latest-approval")
+		require.NoError(t, err)
+
+		event1 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+		event, err := models.FindStageEventByID(event1.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateWaiting, event.State)
+		require.Equal(t, models.StageEventStateReasonApproval, event.StateReason)
+
+		//
+		// A newer event arrives, so the older one
+		// does not wait for approval anymore.
+		//
+		event2 := support.CreateStageEvent(t, r.Source, stage)
+		require.NoError(t, w.Tick())
+
+		event, err = models.FindStageEventByID(event1.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateProcessed, event.State)
+		require.Equal(t, models.StageEventStateReasonCancelled, event.StateReason)
+
+		event, err = models.FindStageEventByID(event2.ID.String(), stage.ID.String())
+		require.NoError(t, err)
+		require.Equal(t, models.StageEventStateWaiting, event.State)
+		require.Equal(t, models.StageEventStateReasonApproval, event.StateReason)
+	})
 }
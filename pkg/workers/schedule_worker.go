@@ -0,0 +1,122 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+const (
+	ScheduleWorkerBatchSize = 100
+
+	//
+	// When the worker is behind, e.g. after being down for a while,
+	// every run that was missed still fires, but only this many at a time for each source,
+	// so a single tick does not create an unbounded number of events.
+	//
+	ScheduleWorkerMaxRunsPerTick = 100
+)
+
+// ScheduleWorker emits the events for scheduled event sources.
+// The next time each source fires is stored with it, and only moves forward
+// in the same transaction that creates the event, so runs are not fired twice or skipped.
+type ScheduleWorker struct {
+	nowFunc func() time.Time
+}
+
+func NewScheduleWorker(nowFunc func() time.Time) (*ScheduleWorker, error) {
+	if nowFunc == nil {
+		return nil, fmt.Errorf("nowFunc is required")
+	}
+
+	return &ScheduleWorker{nowFunc: nowFunc}, nil
+}
+
+func (w *ScheduleWorker) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, 10*time.Second, func() {
+		if err := w.Tick(); err != nil {
+			log.Errorf("Error firing schedules: %v", err)
+		}
+	})
+
+	return nil
+}
+
+func (w *ScheduleWorker) Tick() error {
+	now := w.nowFunc()
+
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		sources, err := models.LockDueScheduledEventSources(tx, now, ScheduleWorkerBatchSize)
+		if err != nil {
+			return fmt.Errorf("error locking scheduled event sources: %v", err)
+		}
+
+		for _, source := range sources {
+			s := source
+			if err := w.fireDueRuns(tx, &s, now); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (w *ScheduleWorker) fireDueRuns(tx *gorm.DB, source *models.EventSource, now time.Time) error {
+	for i := 0; i < ScheduleWorkerMaxRunsPerTick; i++ {
+		if source.NextFireAt == nil || source.NextFireAt.After(now) {
+			return nil
+		}
+
+		if err := w.fire(tx, source); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *ScheduleWorker) fire(tx *gorm.DB, source *models.EventSource) error {
+	firedAt := *source.NextFireAt
+	schedule := source.Spec.Data().Schedule
+	if schedule == nil {
+		log.Warnf("Event source %s has a fire time but no schedule - clearing it", source.ID)
+		return source.UpdateFireTimesInTransaction(tx, firedAt, nil)
+	}
+
+	nextFireAt, err := schedule.NextFireAt(firedAt)
+	if err != nil {
+		log.Errorf("Error finding next fire time for event source %s - stopping schedule: %v", source.ID, err)
+		nextFireAt = nil
+	}
+
+	//
+	// Payloads are validated when the source is created,
+	// so errors here are unexpected, and only skip this run.
+	//
+	payload, err := schedule.BuildPayload(firedAt)
+	if err != nil {
+		log.Errorf("Error building payload for event source %s - skipping run at %v: %v", source.ID, firedAt, err)
+		return source.UpdateFireTimesInTransaction(tx, firedAt, nextFireAt)
+	}
+
+	headers, err := json.Marshal(map[string]string{"X-Scheduled-At": firedAt.UTC().Format(time.RFC3339)})
+	if err != nil {
+		return err
+	}
+
+	_, err = models.CreateEventInTransaction(tx, source.ID, source.Name, models.SourceTypeEventSource, payload, headers)
+	if err != nil {
+		return fmt.Errorf("error creating event for event source %s: %v", source.ID, err)
+	}
+
+	log.Infof("Schedule for event source %s fired at %v", source.ID, firedAt)
+	return source.UpdateFireTimesInTransaction(tx, firedAt, nextFireAt)
+}
@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__ScheduleWorker(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+
+	source, err := r.Canvas.CreateEventSource("nightly", []byte("my-key"), models.EventSourceSpec{
+		Schedule: &models.ScheduleSpec{
+			Cron:     "0 2 * * *",
+			Timezone: "UTC",
+			Payload:  map[string]any{"date": "${{ schedule.date }}"},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, source.NextFireAt)
+
+	firstRun := *source.NextFireAt
+
+	t.Run("schedule is not due -> no events", func(t *testing.T) {
+		w, _ := NewScheduleWorker(func() time.Time { return firstRun.Add(-time.Minute) })
+		require.NoError(t, w.Tick())
+
+		events, err := models.ListEventsBySourceID(source.ID)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("schedule is due -> event is created and fire times move forward", func(t *testing.T) {
+		w, _ := NewScheduleWorker(func() time.Time { return firstRun.Add(time.Minute) })
+		require.NoError(t, w.Tick())
+
+		events, err := models.ListEventsBySourceID(source.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		data, err := events[0].GetData()
+		require.NoError(t, err)
+		assert.Equal(t, firstRun.UTC().Format(time.DateOnly), data["date"])
+
+		source, err = models.FindEventSource(source.ID)
+		require.NoError(t, err)
+		assert.True(t, firstRun.Equal(*source.LastFiredAt))
+		assert.True(t, firstRun.Add(24*time.Hour).Equal(*source.NextFireAt))
+	})
+
+	t.Run("same time again -> run is not fired twice", func(t *testing.T) {
+		w, _ := NewScheduleWorker(func() time.Time { return firstRun.Add(time.Minute) })
+		require.NoError(t, w.Tick())
+
+		events, err := models.ListEventsBySourceID(source.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("worker was down for a few runs -> missed runs are fired", func(t *testing.T) {
+		w, _ := NewScheduleWorker(func() time.Time { return firstRun.Add(3*24*time.Hour + time.Minute) })
+		require.NoError(t, w.Tick())
+
+		events, err := models.ListEventsBySourceID(source.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 4)
+
+		source, err = models.FindEventSource(source.ID)
+		require.NoError(t, err)
+		assert.True(t, firstRun.Add(3*24*time.Hour).Equal(*source.LastFiredAt))
+		assert.True(t, firstRun.Add(4*24*time.Hour).Equal(*source.NextFireAt))
+	})
+}
@@ -1,12 +1,12 @@
 package workers
 
 import (
+	"context"
 	"errors"
-	"time"
 
 	"github.com/google/uuid"
-	"github.com/renderedtext/go-tackle"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/bus"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -16,58 +16,26 @@ import (
 )
 
 const StageEventApprovedServiceName = "superplane" + "." + messages.DeliveryHubCanvasExchange + "." + messages.StageEventApprovedRoutingKey + ".worker-consumer"
-const StageEventApprovedConnectionName = "superplane"
 
 type StageEventApprovedConsumer struct {
-	Consumer    *tackle.Consumer
-	RabbitMQURL string
+	bus bus.Bus
 }
 
-func NewStageEventApprovedConsumer(rabbitMQURL string) *StageEventApprovedConsumer {
-	logger := logging.NewTackleLogger(log.StandardLogger().WithFields(log.Fields{
-		"consumer": "stage_event_approved",
-	}))
-
-	consumer := tackle.NewConsumer()
-	consumer.SetLogger(logger)
-
-	return &StageEventApprovedConsumer{
-		RabbitMQURL: rabbitMQURL,
-		Consumer:    consumer,
-	}
-}
-
-func (c *StageEventApprovedConsumer) Start() error {
-	options := tackle.Options{
-		URL:            c.RabbitMQURL,
-		ConnectionName: StageEventApprovedConnectionName,
-		Service:        StageEventApprovedServiceName,
-		RemoteExchange: messages.DeliveryHubCanvasExchange,
-		RoutingKey:     messages.StageEventApprovedRoutingKey,
-	}
-
-	for {
-		log.Infof("Connecting to RabbitMQ queue for %s events", messages.StageEventApprovedRoutingKey)
-
-		err := c.Consumer.Start(&options, c.Consume)
-		if err != nil {
-			log.Errorf("Error consuming messages from %s: %v", messages.StageEventApprovedRoutingKey, err)
-			time.Sleep(5 * time.Second)
-			continue
-		}
-
-		log.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", messages.StageEventApprovedRoutingKey)
-		time.Sleep(5 * time.Second)
-	}
+func NewStageEventApprovedConsumer(b bus.Bus) *StageEventApprovedConsumer {
+	return &StageEventApprovedConsumer{bus: b}
 }
 
-func (c *StageEventApprovedConsumer) Stop() {
-	c.Consumer.Stop()
+func (c *StageEventApprovedConsumer) Start(ctx context.Context) error {
+	return c.bus.Subscribe(ctx, bus.Subscription{
+		Exchange:   messages.DeliveryHubCanvasExchange,
+		RoutingKey: messages.StageEventApprovedRoutingKey,
+		Queue:      StageEventApprovedServiceName,
+	}, c.Consume)
 }
 
-func (c *StageEventApprovedConsumer) Consume(delivery tackle.Delivery) error {
+func (c *StageEventApprovedConsumer) Consume(body []byte) error {
 	data := &protos.StageEventApproved{}
-	err := proto.Unmarshal(delivery.Body(), data)
+	err := proto.Unmarshal(body, data)
 	if err != nil {
 		return err
 	}
@@ -106,6 +74,15 @@ func (c *StageEventApprovedConsumer) Consume(delivery tackle.Delivery) error {
 		return err
 	}
 
+	//
+	// Events superseded by newer ones are not waiting for approval anymore,
+	// so approving them should not put them back in the queue.
+	//
+	if event.State == models.StageEventStateProcessed {
+		logger.Infof("Stage event %s was already processed - skipping", data.EventId)
+		return nil
+	}
+
 	approvals, err := event.FindApprovals()
 	if err != nil {
 		logger.Errorf("Error finding approvals for stage event %s: %v", data.EventId, err)
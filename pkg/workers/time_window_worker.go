@@ -1,10 +1,12 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/lifecycle"
 	"github.com/superplanehq/superplane/pkg/models"
 )
 
@@ -20,15 +22,14 @@ func NewTimeWindowWorker(nowFunc func() time.Time) (*TimeWindowWorker, error) {
 	return &TimeWindowWorker{nowFunc: nowFunc}, nil
 }
 
-func (w *TimeWindowWorker) Start() {
-	for {
-		err := w.Tick()
-		if err != nil {
+func (w *TimeWindowWorker) Start(ctx context.Context) error {
+	lifecycle.RunEvery(ctx, time.Minute, func() {
+		if err := w.Tick(); err != nil {
 			log.Errorf("Error processing events: %v", err)
 		}
+	})
 
-		time.Sleep(time.Minute)
-	}
+	return nil
 }
 
 func (w *TimeWindowWorker) Tick() error {
@@ -31,7 +31,7 @@ func Test__TimeWindowWorker(t *testing.T) {
 			SourceID:   r.Source.ID,
 			SourceType: models.SourceTypeEventSource,
 		},
-	}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}))
+	}, []models.InputDefinition{}, []models.InputMapping{}, []models.OutputDefinition{}, []models.ValueDefinition{}, 1, models.StageQueuePolicyFIFO, 0, models.RetryPolicy{}))
 
 	stage, err := r.Canvas.FindStageByName("stage-1")
 	require.NoError(t, err)
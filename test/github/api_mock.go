@@ -0,0 +1,197 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/apis/github"
+)
+
+type GitHubAPIMock struct {
+	Server *httptest.Server
+	Runs   []github.WorkflowRun
+
+	LastDispatch  *github.WorkflowDispatch
+	CancelledRuns []int64
+
+	mu sync.Mutex
+}
+
+func NewGitHubAPIMock() *GitHubAPIMock {
+	return &GitHubAPIMock{Runs: []github.WorkflowRun{}}
+}
+
+func (s *GitHubAPIMock) Close() {
+	s.Server.Close()
+}
+
+// FinishRun completes the run created for the execution with the given conclusion.
+func (s *GitHubAPIMock) FinishRun(executionID, conclusion string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, run := range s.Runs {
+		if strings.Contains(run.DisplayTitle, executionID) {
+			s.Runs[i].Status = github.WorkflowRunStatusCompleted
+			s.Runs[i].Conclusion = conclusion
+		}
+	}
+}
+
+func (s *GitHubAPIMock) Init() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/dispatches") {
+			s.DispatchWorkflow(w, r)
+			return
+		}
+
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/runs") {
+			s.ListWorkflowRuns(w, r)
+			return
+		}
+
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cancel") {
+			s.CancelWorkflowRun(w, r)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	s.Server = server
+}
+
+func (s *GitHubAPIMock) DispatchWorkflow(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	var dispatch github.WorkflowDispatch
+	err = json.Unmarshal(body, &dispatch)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	//
+	// The run title is what the workflow sets through its run-name,
+	// which we assume to be the execution ID, if it is sent.
+	//
+	run := github.WorkflowRun{
+		ID:           int64(len(s.Runs) + 1),
+		DisplayTitle: fmt.Sprintf("Execution %s", dispatch.Inputs["SUPERPLANE_EXECUTION_ID"]),
+		Event:        "workflow_dispatch",
+		HeadBranch:   dispatch.Ref,
+		Status:       "queued",
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	log.Infof("Dispatching workflow run %d", run.ID)
+
+	s.Runs = append(s.Runs, run)
+	s.LastDispatch = &dispatch
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWorkflowRuns lists runs newest first, like the GitHub API does,
+// filtering them by the created query parameter, and paginating them.
+func (s *GitHubAPIMock) ListWorkflowRuns(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var createdAfter time.Time
+	if created := r.URL.Query().Get("created"); created != "" {
+		t, err := time.Parse(time.RFC3339, strings.TrimPrefix(created, ">="))
+		if err != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		createdAfter = t
+	}
+
+	runs := []github.WorkflowRun{}
+	for i := len(s.Runs) - 1; i >= 0; i-- {
+		if !s.Runs[i].CreatedAt.Before(createdAfter) {
+			runs = append(runs, s.Runs[i])
+		}
+	}
+
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil {
+		perPage = 30
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		page = 1
+	}
+
+	total := len(runs)
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+
+	data, _ := json.Marshal(github.WorkflowRunsResponse{
+		TotalCount:   total,
+		WorkflowRuns: runs[start:end],
+	})
+
+	w.Write(data)
+}
+
+// AddRun adds a run that was not created through the workflow dispatch API.
+func (s *GitHubAPIMock) AddRun(run github.WorkflowRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run.ID = int64(len(s.Runs) + 1)
+	s.Runs = append(s.Runs, run)
+}
+
+// FinishLastRun completes the last run created with the given conclusion.
+func (s *GitHubAPIMock) FinishLastRun(conclusion string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Runs[len(s.Runs)-1].Status = github.WorkflowRunStatusCompleted
+	s.Runs[len(s.Runs)-1].Conclusion = conclusion
+}
+
+func (s *GitHubAPIMock) CancelWorkflowRun(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(r.URL.Path, "/")
+	runID, err := strconv.ParseInt(path[len(path)-2], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	log.Infof("Cancelling workflow run: %d", runID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, run := range s.Runs {
+		if run.ID == runID {
+			s.Runs[i].Status = github.WorkflowRunStatusCompleted
+			s.Runs[i].Conclusion = github.WorkflowRunConclusionCanceled
+			s.CancelledRuns = append(s.CancelledRuns, runID)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
@@ -0,0 +1,141 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/apis/gitlab"
+)
+
+type GitLabAPIMock struct {
+	Server    *httptest.Server
+	Pipelines map[int64]*gitlab.Pipeline
+
+	LastPipelineRequest *gitlab.CreatePipelineRequest
+	LastProjectPath     string
+	CancelledPipelines  []int64
+
+	mu sync.Mutex
+}
+
+func NewGitLabAPIMock() *GitLabAPIMock {
+	return &GitLabAPIMock{Pipelines: map[int64]*gitlab.Pipeline{}}
+}
+
+func (s *GitLabAPIMock) Close() {
+	s.Server.Close()
+}
+
+func (s *GitLabAPIMock) SetPipelineStatus(pipelineID int64, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pipeline, ok := s.Pipelines[pipelineID]; ok {
+		pipeline.Status = status
+	}
+}
+
+func (s *GitLabAPIMock) Init() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/pipeline") {
+			s.CreatePipeline(w, r)
+			return
+		}
+
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cancel") {
+			s.CancelPipeline(w, r)
+			return
+		}
+
+		if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/pipelines/") {
+			s.GetPipeline(w, r)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	s.Server = server
+}
+
+func (s *GitLabAPIMock) CreatePipeline(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	var request gitlab.CreatePipelineRequest
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pipeline := &gitlab.Pipeline{
+		ID:     int64(len(s.Pipelines) + 1),
+		Ref:    request.Ref,
+		Status: gitlab.PipelineStatusCreated,
+	}
+
+	log.Infof("Creating pipeline %d", pipeline.ID)
+
+	s.Pipelines[pipeline.ID] = pipeline
+	s.LastPipelineRequest = &request
+	s.LastProjectPath = r.URL.RawPath
+	data, _ := json.Marshal(pipeline)
+	w.WriteHeader(http.StatusCreated)
+	w.Write(data)
+}
+
+func (s *GitLabAPIMock) GetPipeline(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(r.URL.Path, "/")
+	pipelineID, _ := strconv.ParseInt(path[len(path)-1], 10, 64)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pipeline, ok := s.Pipelines[pipelineID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	data, _ := json.Marshal(pipeline)
+	w.Write(data)
+}
+
+func (s *GitLabAPIMock) CancelPipeline(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(r.URL.Path, "/")
+	pipelineID, _ := strconv.ParseInt(path[len(path)-2], 10, 64)
+
+	log.Infof("Cancelling pipeline: %d", pipelineID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pipeline, ok := s.Pipelines[pipelineID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	pipeline.Status = gitlab.PipelineStatusCanceled
+	s.CancelledPipelines = append(s.CancelledPipelines, pipelineID)
+	data, _ := json.Marshal(pipeline)
+	w.Write(data)
+}
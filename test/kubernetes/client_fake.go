@@ -0,0 +1,108 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/apis/kubernetes"
+)
+
+// FakeClient keeps jobs in memory, so the Kubernetes executor
+// can be tested without a cluster.
+type FakeClient struct {
+	Jobs        map[string]*kubernetes.Job
+	DeletedJobs []string
+
+	mu sync.Mutex
+}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{Jobs: map[string]*kubernetes.Job{}}
+}
+
+func (c *FakeClient) CreateJob(namespace string, job *kubernetes.Job) (*kubernetes.Job, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := namespace + "/" + job.Metadata.Name
+	if _, ok := c.Jobs[key]; ok {
+		return nil, fmt.Errorf("job %s already exists", key)
+	}
+
+	//
+	// The client should not keep references to what is stored,
+	// so we store and return copies of the job.
+	//
+	stored, err := copyJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	stored.Metadata.Namespace = namespace
+	stored.Status = kubernetes.JobStatus{Active: 1}
+	c.Jobs[key] = stored
+	return copyJob(stored)
+}
+
+func (c *FakeClient) GetJob(namespace, name string) (*kubernetes.Job, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	job, ok := c.Jobs[namespace+"/"+name]
+	if !ok {
+		return nil, kubernetes.ErrNotFound
+	}
+
+	return copyJob(job)
+}
+
+func (c *FakeClient) DeleteJob(namespace, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := namespace + "/" + name
+	if _, ok := c.Jobs[key]; !ok {
+		return kubernetes.ErrNotFound
+	}
+
+	delete(c.Jobs, key)
+	c.DeletedJobs = append(c.DeletedJobs, key)
+	return nil
+}
+
+// FinishJob marks the job as complete or failed,
+// like the job controller does when its pods finish.
+func (c *FakeClient) FinishJob(namespace, name string, successful bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	job, ok := c.Jobs[namespace+"/"+name]
+	if !ok {
+		return
+	}
+
+	condition := kubernetes.JobCondition{Type: kubernetes.JobConditionComplete, Status: kubernetes.ConditionStatusTrue}
+	job.Status = kubernetes.JobStatus{Succeeded: 1}
+	if !successful {
+		condition = kubernetes.JobCondition{Type: kubernetes.JobConditionFailed, Status: kubernetes.ConditionStatusTrue}
+		job.Status = kubernetes.JobStatus{Failed: 1}
+	}
+
+	job.Status.Conditions = []kubernetes.JobCondition{condition}
+}
+
+func copyJob(job *kubernetes.Job) (*kubernetes.Job, error) {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	var c kubernetes.Job
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,131 @@
+package plugins
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ExecutorPluginMock is an executor plugin served over a local gRPC server.
+// It requires a "target" in its config, and defaults "timeout" to 60.
+type ExecutorPluginMock struct {
+	Executions  map[string]*pb.ExecutionStatus
+	LastExecute *pb.ExecuteRequest
+	Cancelled   []string
+
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	mu     sync.Mutex
+}
+
+func NewExecutorPluginMock() *ExecutorPluginMock {
+	return &ExecutorPluginMock{Executions: map[string]*pb.ExecutionStatus{}}
+}
+
+func (p *ExecutorPluginMock) Start() (pb.ExecutorPluginClient, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	p.server = grpc.NewServer()
+	pb.RegisterExecutorPluginServer(p.server, p)
+	go p.server.Serve(listener)
+
+	p.conn, err = grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return pb.NewExecutorPluginClient(p.conn), nil
+}
+
+func (p *ExecutorPluginMock) Close() {
+	p.conn.Close()
+	p.server.Stop()
+}
+
+func (p *ExecutorPluginMock) Finish(id string, successful bool, outputs map[string]any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	execution, ok := p.Executions[id]
+	if !ok {
+		return
+	}
+
+	execution.Finished = true
+	execution.Successful = successful
+	execution.Outputs, _ = structpb.NewStruct(outputs)
+}
+
+func (p *ExecutorPluginMock) Validate(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	config := req.Spec.AsMap()
+	if config["target"] == nil || config["target"] == "" {
+		return nil, status.Error(codes.InvalidArgument, "target is required")
+	}
+
+	if _, ok := config["timeout"]; !ok {
+		config["timeout"] = 60
+	}
+
+	spec, err := structpb.NewStruct(config)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ValidateResponse{Spec: spec}, nil
+}
+
+func (p *ExecutorPluginMock) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	execution := &pb.ExecutionStatus{Id: "run-" + req.Execution.Id}
+	p.Executions[execution.Id] = execution
+	p.LastExecute = req
+
+	return &pb.ExecuteResponse{
+		Status: &pb.ExecutionStatus{Id: execution.Id},
+	}, nil
+}
+
+func (p *ExecutorPluginMock) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	execution, ok := p.Executions[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "execution %s not found", req.Id)
+	}
+
+	return &pb.CheckResponse{
+		Status: &pb.ExecutionStatus{
+			Finished:   execution.Finished,
+			Successful: execution.Successful,
+			Outputs:    execution.Outputs,
+		},
+	}, nil
+}
+
+func (p *ExecutorPluginMock) Cancel(ctx context.Context, req *pb.CancelRequest) (*pb.CancelResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	execution, ok := p.Executions[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "execution %s not found", req.Id)
+	}
+
+	execution.Finished = true
+	execution.Successful = false
+	p.Cancelled = append(p.Cancelled, req.Id)
+	return &pb.CancelResponse{}, nil
+}
@@ -13,19 +13,43 @@ import (
 )
 
 type SemaphoreAPIMock struct {
-	Server    *httptest.Server
-	Workflows map[string]Pipeline
+	Server     *httptest.Server
+	Workflows  map[string]Pipeline
+	Pipelines  map[string]Pipeline
+	Promotions map[string][]semaphore.Promotion
 
-	LastTaskTrigger *semaphore.TaskTrigger
+	TaskTriggers     int
+	LastTaskTrigger  *semaphore.TaskTrigger
+	LastRunWorkflow  *semaphore.RunWorkflowRequest
+	LastPromotion    map[string]any
+	StoppedWorkflows []string
+	StoppedPipelines []string
+
+	// Promotions added here are triggered right after the next one,
+	// as if someone else promoted the same pipeline at the same time.
+	ConcurrentPromotions []semaphore.Promotion
 }
 
+// Pipelines without a result are still running.
 type Pipeline struct {
 	ID     string
 	Result string
 }
 
+func (p *Pipeline) State() string {
+	if p.Result == "" {
+		return "running"
+	}
+
+	return semaphore.PipelineStateDone
+}
+
 func NewSemaphoreAPIMock() *SemaphoreAPIMock {
-	return &SemaphoreAPIMock{Workflows: map[string]Pipeline{}}
+	return &SemaphoreAPIMock{
+		Workflows:  map[string]Pipeline{},
+		Pipelines:  map[string]Pipeline{},
+		Promotions: map[string][]semaphore.Promotion{},
+	}
 }
 
 func (s *SemaphoreAPIMock) Close() {
@@ -48,11 +72,36 @@ func (s *SemaphoreAPIMock) Init() {
 			return
 		}
 
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/terminate") {
+			s.StopWorkflow(w, r)
+			return
+		}
+
 		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/triggers") {
 			s.TriggerTask(w, r)
 			return
 		}
 
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1alpha/plumber-workflows" {
+			s.RunWorkflow(w, r)
+			return
+		}
+
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1alpha/promotions" {
+			s.Promote(w, r)
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1alpha/promotions" {
+			s.ListPromotions(w, r)
+			return
+		}
+
+		if r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/api/v1alpha/pipelines") {
+			s.StopPipeline(w, r)
+			return
+		}
+
 		w.WriteHeader(http.StatusNotFound)
 	}))
 
@@ -88,7 +137,7 @@ func (s *SemaphoreAPIMock) DescribePipeline(w http.ResponseWriter, r *http.Reque
 				Pipeline: &semaphore.Pipeline{
 					ID:         p.ID,
 					WorkflowID: wfID,
-					State:      semaphore.PipelineStateDone,
+					State:      p.State(),
 					Result:     p.Result,
 				},
 			})
@@ -98,9 +147,37 @@ func (s *SemaphoreAPIMock) DescribePipeline(w http.ResponseWriter, r *http.Reque
 		}
 	}
 
+	if p, ok := s.Pipelines[pipelineID]; ok {
+		data, _ := json.Marshal(semaphore.PipelineResponse{
+			Pipeline: &semaphore.Pipeline{
+				ID:     p.ID,
+				State:  p.State(),
+				Result: p.Result,
+			},
+		})
+
+		w.Write(data)
+		return
+	}
+
 	w.WriteHeader(http.StatusNotFound)
 }
 
+func (s *SemaphoreAPIMock) StopWorkflow(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(r.URL.Path, "/")
+	workflowID := path[4]
+
+	log.Infof("Stopping workflow: %s", workflowID)
+
+	if _, ok := s.Workflows[workflowID]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.StoppedWorkflows = append(s.StoppedWorkflows, workflowID)
+	w.WriteHeader(http.StatusOK)
+}
+
 func (s *SemaphoreAPIMock) TriggerTask(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -123,6 +200,108 @@ func (s *SemaphoreAPIMock) TriggerTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.TaskTriggers++
 	s.LastTaskTrigger = &trigger
 	w.Write(data)
 }
+
+func (s *SemaphoreAPIMock) RunWorkflow(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	var request semaphore.RunWorkflowRequest
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	response := semaphore.RunWorkflowResponse{
+		WorkflowID: uuid.New().String(),
+		PipelineID: uuid.New().String(),
+	}
+
+	s.AddPipeline(response.PipelineID, response.WorkflowID, semaphore.PipelineResultPassed)
+	s.LastRunWorkflow = &request
+	data, _ := json.Marshal(response)
+	w.Write(data)
+}
+
+// Every promotion creates a new pipeline that passes.
+func (s *SemaphoreAPIMock) Promote(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	var request map[string]any
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+
+	pipelineID, _ := request["pipeline_id"].(string)
+	name, _ := request["name"].(string)
+
+	log.Infof("Promoting pipeline %s: %s", pipelineID, name)
+
+	parameters := map[string]string{}
+	for k, v := range request {
+		if value, ok := v.(string); ok && k != "pipeline_id" && k != "name" {
+			parameters[k] = value
+		}
+	}
+
+	promotion := semaphore.Promotion{
+		Name:                name,
+		PipelineID:          pipelineID,
+		ScheduledPipelineID: uuid.New().String(),
+		Status:              "passed",
+		Parameters:          parameters,
+	}
+
+	s.Pipelines[promotion.ScheduledPipelineID] = Pipeline{ID: promotion.ScheduledPipelineID, Result: semaphore.PipelineResultPassed}
+	s.Promotions[pipelineID] = append([]semaphore.Promotion{promotion}, s.Promotions[pipelineID]...)
+	for _, p := range s.ConcurrentPromotions {
+		s.Promotions[pipelineID] = append([]semaphore.Promotion{p}, s.Promotions[pipelineID]...)
+	}
+
+	s.ConcurrentPromotions = nil
+	s.LastPromotion = request
+	w.Write([]byte(`{"message": "Promotion successfully triggered."}`))
+}
+
+func (s *SemaphoreAPIMock) ListPromotions(w http.ResponseWriter, r *http.Request) {
+	pipelineID := r.URL.Query().Get("pipeline_id")
+	name := r.URL.Query().Get("name")
+
+	promotions := []semaphore.Promotion{}
+	for _, p := range s.Promotions[pipelineID] {
+		if p.Name == name {
+			promotions = append(promotions, p)
+		}
+	}
+
+	data, _ := json.Marshal(promotions)
+	w.Write(data)
+}
+
+func (s *SemaphoreAPIMock) StopPipeline(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(r.URL.Path, "/")
+	pipelineID := path[4]
+
+	log.Infof("Stopping pipeline: %s", pipelineID)
+
+	if _, ok := s.Pipelines[pipelineID]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.StoppedPipelines = append(s.StoppedPipelines, pipelineID)
+	w.WriteHeader(http.StatusOK)
+}
@@ -3,11 +3,13 @@ package support
 import (
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	protos "github.com/superplanehq/superplane/pkg/protos/superplane"
 	"github.com/superplanehq/superplane/test/semaphore"
@@ -58,7 +60,7 @@ func SetupWithOptions(t *testing.T, options SetupOptions) *ResourceRegistry {
 	require.NoError(t, err)
 
 	if options.Source {
-		r.Source, err = r.Canvas.CreateEventSource("gh", []byte("my-key"))
+		r.Source, err = r.Canvas.CreateEventSource("gh", []byte("my-key"), models.EventSourceSpec{})
 		require.NoError(t, err)
 	}
 
@@ -98,6 +100,10 @@ func SetupWithOptions(t *testing.T, options SetupOptions) *ResourceRegistry {
 			},
 			[]models.OutputDefinition{},
 			[]models.ValueDefinition{},
+			1,
+			models.StageQueuePolicyFIFO,
+			0,
+			models.RetryPolicy{},
 		)
 
 		require.NoError(t, err)
@@ -149,6 +155,18 @@ func CreateExecutionWithData(t *testing.T,
 	return execution
 }
 
+// RelayOutbox publishes all the messages in the outbox,
+// like the outbox relay would, so tests can check for them.
+func RelayOutbox(t *testing.T) {
+	outboxMessages, err := models.ListUnsentOutboxMessages()
+	require.NoError(t, err)
+
+	for _, m := range outboxMessages {
+		require.NoError(t, messages.Publish(m.Exchange, m.RoutingKey, m.Body))
+		require.NoError(t, m.MarkAsSentInTransaction(database.Conn(), time.Now()))
+	}
+}
+
 func ExecutorSpec() models.ExecutorSpec {
 	return ExecutorSpecWithURL("http://localhost:8000")
 }